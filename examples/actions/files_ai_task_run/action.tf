@@ -0,0 +1,5 @@
+action "files_ai_task_run" "summarize" {
+  config {
+    ai_task_id = files_ai_task.summarize.id
+  }
+}
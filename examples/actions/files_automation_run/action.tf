@@ -0,0 +1,6 @@
+action "files_automation_run" "cleanup" {
+  config {
+    automation_id = files_automation.cleanup.id
+    wait          = true
+  }
+}
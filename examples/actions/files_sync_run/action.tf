@@ -0,0 +1,22 @@
+resource "files_sync" "nightly" {
+  name                  = "Nightly partner sync"
+  src_path              = "partners/outbound"
+  dest_remote_server_id = 1
+  dest_path             = "inbound"
+  interval              = "day"
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.files_sync_run.first_run]
+    }
+  }
+}
+
+action "files_sync_run" "first_run" {
+  config {
+    sync_id = files_sync.nightly.id
+    wait    = true
+    timeout = 1800
+  }
+}
@@ -0,0 +1,3 @@
+data "files_api_keys" "example_api_keys" {
+  user_id = 1
+}
@@ -1,3 +1,7 @@
 data "files_automation" "example_automation" {
   id = 1
 }
+
+data "files_automation" "example_automation_by_name" {
+  name = "Nightly archive"
+}
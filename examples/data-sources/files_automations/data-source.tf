@@ -0,0 +1,5 @@
+data "files_automations" "example_automations" {
+  filter = {
+    automation = "copy_file"
+  }
+}
@@ -0,0 +1,4 @@
+data "files_behaviors" "example_behaviors" {
+  path               = "partners/acme"
+  ancestor_behaviors = true
+}
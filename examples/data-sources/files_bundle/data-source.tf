@@ -1,3 +1,7 @@
 data "files_bundle" "example_bundle" {
   id = 1
 }
+
+data "files_bundle" "example_bundle_by_code" {
+  code = "quarterly-report"
+}
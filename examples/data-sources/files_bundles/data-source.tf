@@ -0,0 +1,5 @@
+data "files_bundles" "example_bundles" {
+  filter_gteq = {
+    created_at = "2000-01-01T01:00:00Z"
+  }
+}
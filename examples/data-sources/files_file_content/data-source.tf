@@ -0,0 +1,8 @@
+data "files_file_content" "example_config" {
+  path = "config/app.json"
+}
+
+data "files_file_content" "example_archive" {
+  path        = "releases/app.tar.gz"
+  output_path = "${path.module}/build/app.tar.gz"
+}
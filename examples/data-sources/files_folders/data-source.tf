@@ -0,0 +1,4 @@
+data "files_folders" "example_folders" {
+  path = "partners"
+  type = "folder"
+}
@@ -1,3 +1,7 @@
 data "files_group" "example_group" {
   id = 1
 }
+
+data "files_group" "example_group_by_name" {
+  name = "Engineering"
+}
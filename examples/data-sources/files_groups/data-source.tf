@@ -0,0 +1,12 @@
+data "files_groups" "example_groups" {
+  filter_prefix = {
+    name = "partner_"
+  }
+}
+
+resource "files_permission" "example_permission" {
+  for_each   = { for group in data.files_groups.example_groups.groups : group.name => group }
+  path       = "partners"
+  group_id   = each.value.id
+  permission = "readonly"
+}
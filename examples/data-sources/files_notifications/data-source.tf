@@ -0,0 +1,4 @@
+data "files_notifications" "example_notifications" {
+  path              = "partners"
+  include_ancestors = true
+}
@@ -1,3 +1,7 @@
 data "files_partner" "example_partner" {
   id = 1
 }
+
+data "files_partner" "example_partner_by_name" {
+  name = "Acme"
+}
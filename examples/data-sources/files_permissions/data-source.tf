@@ -0,0 +1,3 @@
+data "files_permissions" "example_permissions" {
+  path = "partners"
+}
@@ -1,3 +1,7 @@
 data "files_remote_server" "example_remote_server" {
   id = 1
 }
+
+data "files_remote_server" "example_remote_server_by_name" {
+  name = "Partner SFTP"
+}
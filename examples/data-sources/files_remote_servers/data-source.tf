@@ -0,0 +1,5 @@
+data "files_remote_servers" "example_remote_servers" {
+  filter = {
+    server_type = "s3"
+  }
+}
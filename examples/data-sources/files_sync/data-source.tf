@@ -1,3 +1,7 @@
 data "files_sync" "example_sync" {
   id = 1
 }
+
+data "files_sync" "example_sync_by_name" {
+  name = "Partner inbound"
+}
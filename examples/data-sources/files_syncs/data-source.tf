@@ -0,0 +1,5 @@
+data "files_syncs" "example_syncs" {
+  filter = {
+    disabled = "false"
+  }
+}
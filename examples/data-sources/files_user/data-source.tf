@@ -1,3 +1,11 @@
 data "files_user" "example_user" {
   id = 1
 }
+
+data "files_user" "example_user_by_username" {
+  username = "jsmith"
+}
+
+data "files_user" "example_user_by_email" {
+  email = "jsmith@example.com"
+}
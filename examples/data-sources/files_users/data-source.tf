@@ -0,0 +1,8 @@
+data "files_users" "example_users" {
+  filter_prefix = {
+    username = "partner_"
+  }
+  sort_by = {
+    username = "asc"
+  }
+}
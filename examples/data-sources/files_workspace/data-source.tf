@@ -1,3 +1,7 @@
 data "files_workspace" "example_workspace" {
   id = 1
 }
+
+data "files_workspace" "example_workspace_by_name" {
+  name = "Finance"
+}
@@ -0,0 +1,6 @@
+ephemeral "files_api_key" "deploy" {
+  name           = "terraform-deploy"
+  user_id        = 1
+  permission_set = "files_only"
+  expires_at     = timeadd(plantimestamp(), "1h")
+}
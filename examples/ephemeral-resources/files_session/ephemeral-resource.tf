@@ -0,0 +1,4 @@
+ephemeral "files_session" "service_user" {
+  username = "service-user"
+  password = var.service_user_password
+}
@@ -0,0 +1,4 @@
+# Both resolve to "partners/acme/inbox", so they refer to the same folder.
+output "same_folder" {
+  value = provider::files::normalize_path("/Partners/ACME/Inbox/") == provider::files::normalize_path("partners\\acme\\inbox")
+}
@@ -0,0 +1,4 @@
+# Returns "Partners/Acme/Inbox".
+output "inbox_path" {
+  value = provider::files::path_join("/Partners/", "Acme", "Inbox/")
+}
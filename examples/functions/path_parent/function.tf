@@ -0,0 +1,4 @@
+# Returns "Partners/Acme".
+output "partner_folder" {
+  value = provider::files::path_parent("Partners/Acme/Inbox")
+}
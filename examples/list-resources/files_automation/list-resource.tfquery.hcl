@@ -0,0 +1,3 @@
+list "files_automation" "all" {
+  provider = files
+}
@@ -0,0 +1,9 @@
+list "files_behavior" "webhooks" {
+  provider = files
+
+  config {
+    filter = {
+      behavior = "webhook"
+    }
+  }
+}
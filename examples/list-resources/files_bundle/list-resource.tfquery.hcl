@@ -0,0 +1,3 @@
+list "files_bundle" "all" {
+  provider = files
+}
@@ -0,0 +1,8 @@
+list "files_folder" "projects" {
+  provider = files
+
+  config {
+    path      = "projects"
+    recursive = true
+  }
+}
@@ -0,0 +1,3 @@
+list "files_group" "all" {
+  provider = files
+}
@@ -0,0 +1,7 @@
+list "files_permission" "projects" {
+  provider = files
+
+  config {
+    path = "projects"
+  }
+}
@@ -0,0 +1,3 @@
+list "files_remote_server" "all" {
+  provider = files
+}
@@ -0,0 +1,3 @@
+list "files_sync" "all" {
+  provider = files
+}
@@ -0,0 +1,3 @@
+list "files_user" "all" {
+  provider = files
+}
@@ -0,0 +1,6 @@
+import {
+  to = files_ai_assistant_personality.example_ai_assistant_personality
+  identity = {
+    id = 1
+  }
+}
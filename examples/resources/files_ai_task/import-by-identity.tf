@@ -0,0 +1,6 @@
+import {
+  to = files_ai_task.example_ai_task
+  identity = {
+    id = 1
+  }
+}
@@ -0,0 +1,6 @@
+import {
+  to = files_api_key.example_api_key
+  identity = {
+    id = 1
+  }
+}
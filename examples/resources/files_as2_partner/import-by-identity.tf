@@ -0,0 +1,6 @@
+import {
+  to = files_as2_partner.example_as2_partner
+  identity = {
+    id = 1
+  }
+}
@@ -0,0 +1,6 @@
+import {
+  to = files_as2_station.example_as2_station
+  identity = {
+    id = 1
+  }
+}
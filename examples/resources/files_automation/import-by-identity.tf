@@ -0,0 +1,6 @@
+import {
+  to = files_automation.example_automation
+  identity = {
+    id = 1
+  }
+}
@@ -1,3 +1,5 @@
 # Automations can be imported by specifying the id.
 terraform import files_automation.example_automation 1
 
+# Automations can also be imported by specifying the name.
+terraform import files_automation.example_automation "Copy to archive"
@@ -0,0 +1,6 @@
+import {
+  to = files_behavior.example_behavior
+  identity = {
+    id = 1
+  }
+}
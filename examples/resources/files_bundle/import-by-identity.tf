@@ -0,0 +1,6 @@
+import {
+  to = files_bundle.example_bundle
+  identity = {
+    id = 1
+  }
+}
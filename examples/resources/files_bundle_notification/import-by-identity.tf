@@ -0,0 +1,6 @@
+import {
+  to = files_bundle_notification.example_bundle_notification
+  identity = {
+    id = 1
+  }
+}
@@ -0,0 +1,6 @@
+import {
+  to = files_child_site_management_policy.example_child_site_management_policy
+  identity = {
+    id = 1
+  }
+}
@@ -0,0 +1,6 @@
+import {
+  to = files_clickwrap.example_clickwrap
+  identity = {
+    id = 1
+  }
+}
@@ -0,0 +1,6 @@
+import {
+  to = files_custom_domain.example_custom_domain
+  identity = {
+    id = 1
+  }
+}
@@ -0,0 +1,6 @@
+import {
+  to = files_desktop_configuration_profile.example_desktop_configuration_profile
+  identity = {
+    id = 1
+  }
+}
@@ -0,0 +1,7 @@
+resource "files_directory" "example_directory" {
+  source             = "${path.module}/onboarding"
+  path               = "partners/acme/onboarding"
+  include            = ["*.pdf", "*.json"]
+  exclude            = ["drafts/"]
+  delete_extra_files = true
+}
@@ -0,0 +1,6 @@
+import {
+  to = files_event_channel.example_event_channel
+  identity = {
+    id = 1
+  }
+}
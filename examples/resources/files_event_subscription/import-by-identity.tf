@@ -0,0 +1,6 @@
+import {
+  to = files_event_subscription.example_event_subscription
+  identity = {
+    id = 1
+  }
+}
@@ -0,0 +1,6 @@
+import {
+  to = files_event_target.example_event_target
+  identity = {
+    id = 1
+  }
+}
@@ -3,8 +3,29 @@ resource "files_event_target" "example_event_target" {
   workspace_id            = 1
   apply_to_all_workspaces = true
   enabled                 = true
-  config                  = "example"
-  delivery_policy         = "example"
-  target_type             = "example"
+  target_type             = "webhook"
+  config = {
+    webhook = {
+      url    = "https://example.com/files-events"
+      method = "POST"
+      headers = {
+        "X-Source" = "files"
+      }
+      secret = var.webhook_secret
+    }
+  }
 }
 
+resource "files_event_target" "example_folder_event_target" {
+  name        = "example-folder"
+  target_type = "folder"
+  config = {
+    folder = {
+      path   = "event-logs"
+      format = "csv"
+    }
+  }
+  delivery_policy = {
+    batch_interval = 3600
+  }
+}
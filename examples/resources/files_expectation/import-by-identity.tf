@@ -0,0 +1,6 @@
+import {
+  to = files_expectation.example_expectation
+  identity = {
+    id = 1
+  }
+}
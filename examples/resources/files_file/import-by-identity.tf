@@ -0,0 +1,6 @@
+import {
+  to = files_file.example_file
+  identity = {
+    path = "path"
+  }
+}
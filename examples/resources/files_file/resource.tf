@@ -1,6 +1,5 @@
 resource "files_file" "example_file" {
   source          = "path"
-  md5             = "17c54824e9931a4688ca032d03f6663c"
   path            = "path"
   custom_metadata = {
     key = "value"
@@ -9,3 +8,13 @@ resource "files_file" "example_file" {
   priority_color  = "red"
 }
 
+
+resource "files_file" "example_readme" {
+  path    = "partners/acme/README.txt"
+  content = "Drop files for processing in the inbound folder."
+}
+
+resource "files_file" "example_logo" {
+  path           = "partners/acme/logo.png"
+  content_base64 = filebase64("${path.module}/logo.png")
+}
@@ -0,0 +1,7 @@
+import {
+  to = files_file_comment.example_file_comment
+  identity = {
+    id   = 1
+    path = "path"
+  }
+}
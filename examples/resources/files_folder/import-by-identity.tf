@@ -0,0 +1,6 @@
+import {
+  to = files_folder.example_folder
+  identity = {
+    path = "path"
+  }
+}
@@ -8,3 +8,12 @@ resource "files_folder" "example_folder" {
   priority_color  = "red"
 }
 
+resource "files_folder" "example_partner_inbox" {
+  path                         = "partners/acme/inbox"
+  prevent_destroy_if_not_empty = true
+}
+
+resource "files_folder" "example_scratch" {
+  path          = "scratch"
+  force_destroy = true
+}
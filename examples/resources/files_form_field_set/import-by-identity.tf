@@ -0,0 +1,6 @@
+import {
+  to = files_form_field_set.example_form_field_set
+  identity = {
+    id = 1
+  }
+}
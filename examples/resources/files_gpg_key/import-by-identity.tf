@@ -0,0 +1,6 @@
+import {
+  to = files_gpg_key.example_gpg_key
+  identity = {
+    id = 1
+  }
+}
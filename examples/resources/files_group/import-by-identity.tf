@@ -0,0 +1,6 @@
+import {
+  to = files_group.example_group
+  identity = {
+    id = 1
+  }
+}
@@ -1,3 +1,5 @@
 # Groups can be imported by specifying the id.
 terraform import files_group.example_group 1
 
+# Groups can also be imported by specifying the name.
+terraform import files_group.example_group admins
@@ -0,0 +1,7 @@
+import {
+  to = files_group_user.example_group_user
+  identity = {
+    group_id = 1
+    user_id  = 1
+  }
+}
@@ -0,0 +1,6 @@
+import {
+  to = files_holiday_calendar.example_holiday_calendar
+  identity = {
+    id = 1
+  }
+}
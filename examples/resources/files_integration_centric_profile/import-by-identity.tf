@@ -0,0 +1,6 @@
+import {
+  to = files_integration_centric_profile.example_integration_centric_profile
+  identity = {
+    id = 1
+  }
+}
@@ -0,0 +1,6 @@
+import {
+  to = files_key_lifecycle_rule.example_key_lifecycle_rule
+  identity = {
+    id = 1
+  }
+}
@@ -0,0 +1,6 @@
+import {
+  to = files_lock.example_lock
+  identity = {
+    path = "path"
+  }
+}
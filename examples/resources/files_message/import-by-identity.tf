@@ -0,0 +1,6 @@
+import {
+  to = files_message.example_message
+  identity = {
+    id = 1
+  }
+}
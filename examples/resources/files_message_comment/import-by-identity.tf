@@ -0,0 +1,6 @@
+import {
+  to = files_message_comment.example_message_comment
+  identity = {
+    id = 1
+  }
+}
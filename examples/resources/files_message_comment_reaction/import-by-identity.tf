@@ -0,0 +1,6 @@
+import {
+  to = files_message_comment_reaction.example_message_comment_reaction
+  identity = {
+    id = 1
+  }
+}
@@ -0,0 +1,6 @@
+import {
+  to = files_message_reaction.example_message_reaction
+  identity = {
+    id = 1
+  }
+}
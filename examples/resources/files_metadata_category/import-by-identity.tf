@@ -0,0 +1,6 @@
+import {
+  to = files_metadata_category.example_metadata_category
+  identity = {
+    id = 1
+  }
+}
@@ -0,0 +1,6 @@
+import {
+  to = files_notification.example_notification
+  identity = {
+    id = 1
+  }
+}
@@ -0,0 +1,6 @@
+import {
+  to = files_partner.example_partner
+  identity = {
+    id = 1
+  }
+}
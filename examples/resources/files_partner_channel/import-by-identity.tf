@@ -0,0 +1,6 @@
+import {
+  to = files_partner_channel.example_partner_channel
+  identity = {
+    id = 1
+  }
+}
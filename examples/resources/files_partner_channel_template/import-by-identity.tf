@@ -0,0 +1,6 @@
+import {
+  to = files_partner_channel_template.example_partner_channel_template
+  identity = {
+    id = 1
+  }
+}
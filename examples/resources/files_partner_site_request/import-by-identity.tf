@@ -0,0 +1,6 @@
+import {
+  to = files_partner_site_request.example_partner_site_request
+  identity = {
+    id = 1
+  }
+}
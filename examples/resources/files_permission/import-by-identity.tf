@@ -0,0 +1,8 @@
+import {
+  to = files_permission.example_permission
+  identity = {
+    path       = "path"
+    group_id   = 1
+    permission = "full"
+  }
+}
@@ -1,3 +1,5 @@
 # Permissions can be imported by specifying the id.
 terraform import files_permission.example_permission 1
 
+# Group permissions can also be imported by specifying path:group_name:permission.
+terraform import files_permission.example_permission "Partners/Acme:partners:full"
@@ -0,0 +1,6 @@
+import {
+  to = files_project.example_project
+  identity = {
+    id = 1
+  }
+}
@@ -0,0 +1,6 @@
+import {
+  to = files_public_key.example_public_key
+  identity = {
+    id = 1
+  }
+}
@@ -0,0 +1,6 @@
+import {
+  to = files_remote_mount_backend.example_remote_mount_backend
+  identity = {
+    id = 1
+  }
+}
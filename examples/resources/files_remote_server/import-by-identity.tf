@@ -0,0 +1,6 @@
+import {
+  to = files_remote_server.example_remote_server
+  identity = {
+    id = 1
+  }
+}
@@ -1,3 +1,5 @@
 # Remote Servers can be imported by specifying the id.
 terraform import files_remote_server.example_remote_server 1
 
+# Remote Servers can also be imported by specifying the name.
+terraform import files_remote_server.example_remote_server "My Remote server"
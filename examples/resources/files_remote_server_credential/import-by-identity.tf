@@ -0,0 +1,6 @@
+import {
+  to = files_remote_server_credential.example_remote_server_credential
+  identity = {
+    id = 1
+  }
+}
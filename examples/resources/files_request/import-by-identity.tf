@@ -0,0 +1,6 @@
+import {
+  to = files_request.example_request
+  identity = {
+    id = 1
+  }
+}
@@ -0,0 +1,6 @@
+import {
+  to = files_schedule.example_schedule
+  identity = {
+    id = 1
+  }
+}
@@ -0,0 +1,6 @@
+import {
+  to = files_scheduled_export.example_scheduled_export
+  identity = {
+    id = 1
+  }
+}
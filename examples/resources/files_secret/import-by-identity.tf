@@ -0,0 +1,6 @@
+import {
+  to = files_secret.example_secret
+  identity = {
+    id = 1
+  }
+}
@@ -5,6 +5,9 @@ resource "files_secret" "example_secret" {
   metadata     = {
     key = "example value"
   }
-  workspace_id = 0
+  values = {
+    token = var.production_api_token
+  }
+  values_version = 1
+  workspace_id   = 0
 }
-
@@ -0,0 +1,6 @@
+import {
+  to = files_sftp_host_key.example_sftp_host_key
+  identity = {
+    id = 1
+  }
+}
@@ -0,0 +1,6 @@
+import {
+  to = files_share_group.example_share_group
+  identity = {
+    id = 1
+  }
+}
@@ -0,0 +1,6 @@
+import {
+  to = files_siem_http_destination.example_siem_http_destination
+  identity = {
+    id = 1
+  }
+}
@@ -0,0 +1,6 @@
+import {
+  to = files_site_setting.example_site_setting
+  identity = {
+    name = "welcome_email_enabled"
+  }
+}
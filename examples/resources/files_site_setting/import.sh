@@ -0,0 +1,2 @@
+# Site Settings can be imported by specifying the setting name.
+terraform import files_site_setting.example_site_setting welcome_email_enabled
@@ -0,0 +1,9 @@
+resource "files_site_setting" "welcome_email_enabled" {
+  name  = "welcome_email_enabled"
+  value = "true"
+}
+
+resource "files_site_setting" "additional_text_file_types" {
+  name  = "additional_text_file_types"
+  value = jsonencode(["csv", "log"])
+}
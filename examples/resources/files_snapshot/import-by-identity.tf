@@ -0,0 +1,6 @@
+import {
+  to = files_snapshot.example_snapshot
+  identity = {
+    id = 1
+  }
+}
@@ -0,0 +1,6 @@
+import {
+  to = files_style.example_style
+  identity = {
+    path = "path"
+  }
+}
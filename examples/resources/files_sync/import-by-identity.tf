@@ -0,0 +1,6 @@
+import {
+  to = files_sync.example_sync
+  identity = {
+    id = 1
+  }
+}
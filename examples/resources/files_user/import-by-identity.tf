@@ -0,0 +1,6 @@
+import {
+  to = files_user.example_user
+  identity = {
+    id = 1
+  }
+}
@@ -1,3 +1,5 @@
 # Users can be imported by specifying the id.
 terraform import files_user.example_user 1
 
+# Users can also be imported by specifying the username.
+terraform import files_user.example_user example_user
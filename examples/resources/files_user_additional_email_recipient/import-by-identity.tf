@@ -0,0 +1,6 @@
+import {
+  to = files_user_additional_email_recipient.example_user_additional_email_recipient
+  identity = {
+    id = 1
+  }
+}
@@ -0,0 +1,6 @@
+import {
+  to = files_user_lifecycle_rule.example_user_lifecycle_rule
+  identity = {
+    id = 1
+  }
+}
@@ -0,0 +1,6 @@
+import {
+  to = files_user_request.example_user_request
+  identity = {
+    id = 1
+  }
+}
@@ -0,0 +1,6 @@
+import {
+  to = files_workspace.example_workspace
+  identity = {
+    id = 1
+  }
+}
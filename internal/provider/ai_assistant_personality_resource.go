@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
@@ -25,6 +26,8 @@ var (
 	_ resource.Resource                = &aiAssistantPersonalityResource{}
 	_ resource.ResourceWithConfigure   = &aiAssistantPersonalityResource{}
 	_ resource.ResourceWithImportState = &aiAssistantPersonalityResource{}
+	_ resource.ResourceWithIdentity    = &aiAssistantPersonalityResource{}
+	_ resource.ResourceWithModifyPlan  = &aiAssistantPersonalityResource{}
 )
 
 func NewAiAssistantPersonalityResource() resource.Resource {
@@ -32,7 +35,8 @@ func NewAiAssistantPersonalityResource() resource.Resource {
 }
 
 type aiAssistantPersonalityResource struct {
-	client *ai_assistant_personality.Client
+	client             *ai_assistant_personality.Client
+	defaultWorkspaceId types.Int64
 }
 
 type aiAssistantPersonalityResourceModel struct {
@@ -51,18 +55,19 @@ func (r *aiAssistantPersonalityResource) Configure(_ context.Context, req resour
 		return
 	}
 
-	sdk_config, ok := req.ProviderData.(files_sdk.Config)
+	provider_data, ok := req.ProviderData.(providerResourceData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected files_sdk.Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected providerResourceData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.client = &ai_assistant_personality.Client{Config: sdk_config}
+	r.client = &ai_assistant_personality.Client{Config: provider_data.Config}
+	r.defaultWorkspaceId = provider_data.DefaultWorkspaceId
 }
 
 func (r *aiAssistantPersonalityResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -124,6 +129,21 @@ func (r *aiAssistantPersonalityResource) Schema(_ context.Context, _ resource.Sc
 	}
 }
 
+func (r *aiAssistantPersonalityResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.Int64Attribute{
+				Description:       "AI Assistant Personality ID.",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+func (r *aiAssistantPersonalityResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyPlanDefaultWorkspaceId(ctx, r.defaultWorkspaceId, req, resp)
+}
+
 func (r *aiAssistantPersonalityResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan aiAssistantPersonalityResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -170,6 +190,12 @@ func (r *aiAssistantPersonalityResource) Create(ctx context.Context, req resourc
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.Identity.SetAttribute(ctx, path.Root("id"), plan.Id)
+	resp.Diagnostics.Append(diags...)
 }
 
 func (r *aiAssistantPersonalityResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -205,6 +231,12 @@ func (r *aiAssistantPersonalityResource) Read(ctx context.Context, req resource.
 
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.Identity.SetAttribute(ctx, path.Root("id"), state.Id)
+	resp.Diagnostics.Append(diags...)
 }
 
 func (r *aiAssistantPersonalityResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -262,6 +294,12 @@ func (r *aiAssistantPersonalityResource) Update(ctx context.Context, req resourc
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.Identity.SetAttribute(ctx, path.Root("id"), plan.Id)
+	resp.Diagnostics.Append(diags...)
 }
 
 func (r *aiAssistantPersonalityResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -285,6 +323,13 @@ func (r *aiAssistantPersonalityResource) Delete(ctx context.Context, req resourc
 }
 
 func (r *aiAssistantPersonalityResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID == "" {
+		var id types.Int64
+		resp.Diagnostics.Append(req.Identity.GetAttribute(ctx, path.Root("id"), &id)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+		return
+	}
+
 	idParts := strings.SplitN(req.ID, ",", 1)
 
 	if len(idParts) != 1 || idParts[0] == "" {
@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
@@ -29,6 +30,8 @@ var (
 	_ resource.Resource                = &aiTaskResource{}
 	_ resource.ResourceWithConfigure   = &aiTaskResource{}
 	_ resource.ResourceWithImportState = &aiTaskResource{}
+	_ resource.ResourceWithIdentity    = &aiTaskResource{}
+	_ resource.ResourceWithModifyPlan  = &aiTaskResource{}
 )
 
 func NewAiTaskResource() resource.Resource {
@@ -36,34 +39,35 @@ func NewAiTaskResource() resource.Resource {
 }
 
 type aiTaskResource struct {
-	client *ai_task.Client
+	client             *ai_task.Client
+	defaultWorkspaceId types.Int64
 }
 
 type aiTaskResourceModel struct {
-	Name                  types.String `tfsdk:"name"`
-	Prompt                types.String `tfsdk:"prompt"`
-	WorkspaceId           types.Int64  `tfsdk:"workspace_id"`
-	Description           types.String `tfsdk:"description"`
-	PermissionSet         types.String `tfsdk:"permission_set"`
-	Path                  types.String `tfsdk:"path"`
-	Source                types.String `tfsdk:"source"`
-	Disabled              types.Bool   `tfsdk:"disabled"`
-	Trigger               types.String `tfsdk:"trigger"`
-	TriggerActions        types.List   `tfsdk:"trigger_actions"`
-	Interval              types.String `tfsdk:"interval"`
-	RecurringDay          types.Int64  `tfsdk:"recurring_day"`
-	RecurringDays         types.List   `tfsdk:"recurring_days"`
-	ScheduleId            types.Int64  `tfsdk:"schedule_id"`
-	ScheduleDaysOfWeek    types.List   `tfsdk:"schedule_days_of_week"`
-	ScheduleTimesOfDay    types.List   `tfsdk:"schedule_times_of_day"`
-	ScheduleTimeZone      types.String `tfsdk:"schedule_time_zone"`
-	HolidayRegion         types.String `tfsdk:"holiday_region"`
-	Id                    types.Int64  `tfsdk:"id"`
-	HumanReadableSchedule types.String `tfsdk:"human_readable_schedule"`
-	LastRunAt             types.String `tfsdk:"last_run_at"`
-	MasterAdminUserId     types.Int64  `tfsdk:"master_admin_user_id"`
-	CreatedAt             types.String `tfsdk:"created_at"`
-	UpdatedAt             types.String `tfsdk:"updated_at"`
+	Name                  types.String       `tfsdk:"name"`
+	Prompt                types.String       `tfsdk:"prompt"`
+	WorkspaceId           types.Int64        `tfsdk:"workspace_id"`
+	Description           types.String       `tfsdk:"description"`
+	PermissionSet         types.String       `tfsdk:"permission_set"`
+	Path                  lib.NormalizedPath `tfsdk:"path"`
+	Source                types.String       `tfsdk:"source"`
+	Disabled              types.Bool         `tfsdk:"disabled"`
+	Trigger               types.String       `tfsdk:"trigger"`
+	TriggerActions        types.List         `tfsdk:"trigger_actions"`
+	Interval              types.String       `tfsdk:"interval"`
+	RecurringDay          types.Int64        `tfsdk:"recurring_day"`
+	RecurringDays         types.List         `tfsdk:"recurring_days"`
+	ScheduleId            types.Int64        `tfsdk:"schedule_id"`
+	ScheduleDaysOfWeek    types.List         `tfsdk:"schedule_days_of_week"`
+	ScheduleTimesOfDay    types.List         `tfsdk:"schedule_times_of_day"`
+	ScheduleTimeZone      types.String       `tfsdk:"schedule_time_zone"`
+	HolidayRegion         types.String       `tfsdk:"holiday_region"`
+	Id                    types.Int64        `tfsdk:"id"`
+	HumanReadableSchedule types.String       `tfsdk:"human_readable_schedule"`
+	LastRunAt             types.String       `tfsdk:"last_run_at"`
+	MasterAdminUserId     types.Int64        `tfsdk:"master_admin_user_id"`
+	CreatedAt             types.String       `tfsdk:"created_at"`
+	UpdatedAt             types.String       `tfsdk:"updated_at"`
 }
 
 func (r *aiTaskResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -71,18 +75,19 @@ func (r *aiTaskResource) Configure(_ context.Context, req resource.ConfigureRequ
 		return
 	}
 
-	sdk_config, ok := req.ProviderData.(files_sdk.Config)
+	provider_data, ok := req.ProviderData.(providerResourceData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected files_sdk.Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected providerResourceData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.client = &ai_task.Client{Config: sdk_config}
+	r.client = &ai_task.Client{Config: provider_data.Config}
+	r.defaultWorkspaceId = provider_data.DefaultWorkspaceId
 }
 
 func (r *aiTaskResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -135,6 +140,7 @@ func (r *aiTaskResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
 				},
+				CustomType: lib.NormalizedPathType{},
 			},
 			"source": schema.StringAttribute{
 				Description: "Source glob used with `path` for action-triggered AI Tasks.",
@@ -270,6 +276,21 @@ func (r *aiTaskResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 	}
 }
 
+func (r *aiTaskResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.Int64Attribute{
+				Description:       "AI Task ID.",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+func (r *aiTaskResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyPlanDefaultWorkspaceId(ctx, r.defaultWorkspaceId, req, resp)
+}
+
 func (r *aiTaskResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan aiTaskResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -339,6 +360,12 @@ func (r *aiTaskResource) Create(ctx context.Context, req resource.CreateRequest,
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.Identity.SetAttribute(ctx, path.Root("id"), plan.Id)
+	resp.Diagnostics.Append(diags...)
 }
 
 func (r *aiTaskResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -374,6 +401,12 @@ func (r *aiTaskResource) Read(ctx context.Context, req resource.ReadRequest, res
 
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.Identity.SetAttribute(ctx, path.Root("id"), state.Id)
+	resp.Diagnostics.Append(diags...)
 }
 
 func (r *aiTaskResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -482,6 +515,12 @@ func (r *aiTaskResource) Update(ctx context.Context, req resource.UpdateRequest,
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.Identity.SetAttribute(ctx, path.Root("id"), plan.Id)
+	resp.Diagnostics.Append(diags...)
 }
 
 func (r *aiTaskResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -505,6 +544,13 @@ func (r *aiTaskResource) Delete(ctx context.Context, req resource.DeleteRequest,
 }
 
 func (r *aiTaskResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID == "" {
+		var id types.Int64
+		resp.Diagnostics.Append(req.Identity.GetAttribute(ctx, path.Root("id"), &id)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+		return
+	}
+
 	idParts := strings.SplitN(req.ID, ",", 1)
 
 	if len(idParts) != 1 || idParts[0] == "" {
@@ -536,7 +582,7 @@ func (r *aiTaskResource) populateResourceModel(ctx context.Context, aiTask files
 	state.Description = types.StringValue(aiTask.Description)
 	state.Prompt = types.StringValue(aiTask.Prompt)
 	state.PermissionSet = types.StringValue(aiTask.PermissionSet)
-	state.Path = types.StringValue(aiTask.Path)
+	state.Path = lib.NormalizedPathValue(aiTask.Path)
 	state.Source = types.StringValue(aiTask.Source)
 	state.Disabled = types.BoolPointerValue(aiTask.Disabled)
 	state.Trigger = types.StringValue(aiTask.Trigger)
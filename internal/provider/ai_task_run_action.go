@@ -0,0 +1,85 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	files_sdk "github.com/Files-com/files-sdk-go/v3"
+	ai_task "github.com/Files-com/files-sdk-go/v3/aitask"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ action.Action              = &aiTaskRunAction{}
+	_ action.ActionWithConfigure = &aiTaskRunAction{}
+)
+
+func NewAiTaskRunAction() action.Action {
+	return &aiTaskRunAction{}
+}
+
+type aiTaskRunAction struct {
+	client *ai_task.Client
+}
+
+type aiTaskRunActionModel struct {
+	AiTaskId types.Int64 `tfsdk:"ai_task_id"`
+}
+
+func (a *aiTaskRunAction) Configure(_ context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	sdk_config, ok := req.ProviderData.(files_sdk.Config)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Action Configure Type",
+			fmt.Sprintf("Expected files_sdk.Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	a.client = &ai_task.Client{Config: sdk_config}
+}
+
+func (a *aiTaskRunAction) Metadata(_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ai_task_run"
+}
+
+func (a *aiTaskRunAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Runs an AI Task immediately. The action returns once Files.com has accepted the run; AI Tasks do not record runs that can be waited on.",
+		Attributes: map[string]schema.Attribute{
+			"ai_task_id": schema.Int64Attribute{
+				Description: "ID of the AI Task to run.",
+				Required:    true,
+			},
+		},
+	}
+}
+
+func (a *aiTaskRunAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config aiTaskRunActionModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	paramsAiTaskManualRun := files_sdk.AiTaskManualRunParams{}
+	paramsAiTaskManualRun.Id = config.AiTaskId.ValueInt64()
+
+	err := a.client.ManualRun(paramsAiTaskManualRun, files_sdk.WithContext(ctx))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Running Files AI Task",
+			fmt.Sprintf("Could not run AI task id %d: %s", paramsAiTaskManualRun.Id, err),
+		)
+	}
+}
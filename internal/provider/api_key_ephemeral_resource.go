@@ -0,0 +1,315 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	files_sdk "github.com/Files-com/files-sdk-go/v3"
+	api_key "github.com/Files-com/files-sdk-go/v3/apikey"
+	"github.com/Files-com/terraform-provider-files/lib"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ ephemeral.EphemeralResource              = &apiKeyEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure = &apiKeyEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithClose     = &apiKeyEphemeralResource{}
+)
+
+func NewApiKeyEphemeralResource() ephemeral.EphemeralResource {
+	return &apiKeyEphemeralResource{}
+}
+
+type apiKeyEphemeralResource struct {
+	client *api_key.Client
+}
+
+type apiKeyEphemeralResourceModel struct {
+	Name                types.String `tfsdk:"name"`
+	Description         types.String `tfsdk:"description"`
+	ExpiresAt           types.String `tfsdk:"expires_at"`
+	AwsStyleCredentials types.Bool   `tfsdk:"aws_style_credentials"`
+	PermissionSet       types.String `tfsdk:"permission_set"`
+	UserId              types.Int64  `tfsdk:"user_id"`
+	WorkspaceId         types.Int64  `tfsdk:"workspace_id"`
+	Path                types.String `tfsdk:"path"`
+	Id                  types.Int64  `tfsdk:"id"`
+	DescriptiveLabel    types.String `tfsdk:"descriptive_label"`
+	CreatedAt           types.String `tfsdk:"created_at"`
+	Key                 types.String `tfsdk:"key"`
+	AwsAccessKeyId      types.String `tfsdk:"aws_access_key_id"`
+	AwsSecretKey        types.String `tfsdk:"aws_secret_key"`
+	SiteId              types.Int64  `tfsdk:"site_id"`
+	SiteName            types.String `tfsdk:"site_name"`
+	Url                 types.String `tfsdk:"url"`
+}
+
+// apiKeyEphemeralResourcePrivate is kept in private state between Open and
+// Close so the key can be revoked without exposing it to the configuration.
+type apiKeyEphemeralResourcePrivate struct {
+	Id int64 `json:"id"`
+}
+
+func (r *apiKeyEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	sdk_config, ok := req.ProviderData.(files_sdk.Config)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected files_sdk.Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = &api_key.Client{Config: sdk_config}
+}
+
+func (r *apiKeyEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_api_key"
+}
+
+func (r *apiKeyEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Creates an API Key that only exists for the duration of a single Terraform run. The key is created when Terraform opens the ephemeral resource and deleted again when it is closed, so the `key` is never written to state or plan.\n\n\n\nUse this to hand a short-lived Files.com credential to another provider. Setting `expires_at` is still recommended so the key stops working even if Terraform is interrupted before it can be deleted.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Description: "Internal name for the API Key.  For your use.",
+				Required:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "User-supplied description of API key.",
+				Computed:    true,
+				Optional:    true,
+			},
+			"expires_at": schema.StringAttribute{
+				Description: "API Key expiration date",
+				Computed:    true,
+				Optional:    true,
+			},
+			"aws_style_credentials": schema.BoolAttribute{
+				Description: "If `true`, this API key will be usable with AWS-compatible endpoints, such as our Inbound S3-compatible endpoint.",
+				Computed:    true,
+				Optional:    true,
+			},
+			"permission_set": schema.StringAttribute{
+				Description: "Permissions for this API Key. Keys with the `desktop_app` permission set only have the ability to do the functions provided in our Desktop App (File and Share Link operations). Keys with the `files_only` permission set can perform file operations as a full-access file user in the key's workspace scope, but cannot use site admin, workspace admin, folder admin, group admin, partner admin, or billing privileges from the owning user.",
+				Computed:    true,
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("none", "full", "desktop_app", "sync_app", "office_integration", "mobile_app", "files_only"),
+				},
+			},
+			"user_id": schema.Int64Attribute{
+				Description: "User ID for the owner of this API Key.  May be blank for Site-wide API Keys.",
+				Computed:    true,
+				Optional:    true,
+			},
+			"workspace_id": schema.Int64Attribute{
+				Description: "Workspace ID for this API Key. `0` means the default workspace.",
+				Computed:    true,
+				Optional:    true,
+			},
+			"path": schema.StringAttribute{
+				Description: "Folder path restriction for `office_integration` permission set API keys.",
+				Optional:    true,
+			},
+			"id": schema.Int64Attribute{
+				Description: "API Key ID",
+				Computed:    true,
+			},
+			"descriptive_label": schema.StringAttribute{
+				Description: "Unique label that describes this API key.  Useful for external systems where you may have API keys from multiple accounts and want a human-readable label for each key.",
+				Computed:    true,
+			},
+			"created_at": schema.StringAttribute{
+				Description: "Time which API Key was created",
+				Computed:    true,
+			},
+			"key": schema.StringAttribute{
+				Description: "API Key actual key string",
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"aws_access_key_id": schema.StringAttribute{
+				Description: "AWS Access Key ID to use with AWS-compatible endpoints, such as our Inbound S3-compatible endpoint.",
+				Computed:    true,
+			},
+			"aws_secret_key": schema.StringAttribute{
+				Description: "AWS Secret Key to use with AWS-compatible endpoints, such as our Inbound S3-compatible endpoint.",
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"site_id": schema.Int64Attribute{
+				Description: "Site ID",
+				Computed:    true,
+			},
+			"site_name": schema.StringAttribute{
+				Description: "Site Name",
+				Computed:    true,
+			},
+			"url": schema.StringAttribute{
+				Description: "URL for API host.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (r *apiKeyEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data apiKeyEphemeralResourceModel
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	paramsApiKeyCreate := files_sdk.ApiKeyCreateParams{}
+	paramsApiKeyCreate.UserId = data.UserId.ValueInt64()
+	paramsApiKeyCreate.Description = data.Description.ValueString()
+	if !data.ExpiresAt.IsNull() && data.ExpiresAt.ValueString() != "" {
+		createExpiresAt, err := time.Parse(time.RFC3339, data.ExpiresAt.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("expires_at"),
+				"Error Parsing expires_at Time",
+				"Could not parse expires_at time: "+err.Error(),
+			)
+		} else {
+			paramsApiKeyCreate.ExpiresAt = &createExpiresAt
+		}
+	}
+	paramsApiKeyCreate.Name = data.Name.ValueString()
+	if !data.AwsStyleCredentials.IsNull() && !data.AwsStyleCredentials.IsUnknown() {
+		paramsApiKeyCreate.AwsStyleCredentials = data.AwsStyleCredentials.ValueBoolPointer()
+	}
+	paramsApiKeyCreate.Path = data.Path.ValueString()
+	paramsApiKeyCreate.PermissionSet = paramsApiKeyCreate.PermissionSet.Enum()[data.PermissionSet.ValueString()]
+	paramsApiKeyCreate.WorkspaceId = data.WorkspaceId.ValueInt64()
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiKey, err := r.client.Create(paramsApiKeyCreate, files_sdk.WithContext(ctx))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Opening Files ApiKey",
+			"Could not create api_key, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	// Close is only called once Open succeeds, so the key is deleted here if
+	// it can't be returned.
+	defer func() {
+		if !resp.Diagnostics.HasError() {
+			return
+		}
+		if err := r.delete(ctx, apiKey.Id); err != nil {
+			resp.Diagnostics.AddError(
+				"Error Opening Files ApiKey",
+				"Could not delete api_key id "+fmt.Sprint(apiKey.Id)+": "+err.Error(),
+			)
+		}
+	}()
+
+	private, err := json.Marshal(apiKeyEphemeralResourcePrivate{Id: apiKey.Id})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Opening Files ApiKey",
+			"Could not encode private state: "+err.Error(),
+		)
+		return
+	}
+	diags = resp.Private.SetKey(ctx, "api_key", private)
+	resp.Diagnostics.Append(diags...)
+
+	diags = r.populateEphemeralResourceModel(ctx, apiKey, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.Result.Set(ctx, data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *apiKeyEphemeralResource) Close(ctx context.Context, req ephemeral.CloseRequest, resp *ephemeral.CloseResponse) {
+	privateBytes, diags := req.Private.GetKey(ctx, "api_key")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || privateBytes == nil {
+		return
+	}
+
+	var private apiKeyEphemeralResourcePrivate
+	if err := json.Unmarshal(privateBytes, &private); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Closing Files ApiKey",
+			"Could not decode private state: "+err.Error(),
+		)
+		return
+	}
+
+	if err := r.delete(ctx, private.Id); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Closing Files ApiKey",
+			"Could not delete api_key id "+fmt.Sprint(private.Id)+": "+err.Error(),
+		)
+	}
+}
+
+// delete deletes the API key, which may already be gone.
+func (r *apiKeyEphemeralResource) delete(ctx context.Context, id int64) error {
+	paramsApiKeyDelete := files_sdk.ApiKeyDeleteParams{}
+	paramsApiKeyDelete.Id = id
+
+	err := r.client.Delete(paramsApiKeyDelete, files_sdk.WithContext(ctx))
+	if files_sdk.IsNotExist(err) {
+		return nil
+	}
+	return err
+}
+
+func (r *apiKeyEphemeralResource) populateEphemeralResourceModel(ctx context.Context, apiKey files_sdk.ApiKey, state *apiKeyEphemeralResourceModel) (diags diag.Diagnostics) {
+	state.Id = types.Int64Value(apiKey.Id)
+	state.DescriptiveLabel = types.StringValue(apiKey.DescriptiveLabel)
+	state.Description = types.StringValue(apiKey.Description)
+	if err := lib.TimeToStringType(ctx, path.Root("created_at"), apiKey.CreatedAt, &state.CreatedAt); err != nil {
+		diags.AddError(
+			"Error Opening Files ApiKey",
+			"Could not convert state created_at to string: "+err.Error(),
+		)
+	}
+	if err := lib.TimeToStringType(ctx, path.Root("expires_at"), apiKey.ExpiresAt, &state.ExpiresAt); err != nil {
+		diags.AddError(
+			"Error Opening Files ApiKey",
+			"Could not convert state expires_at to string: "+err.Error(),
+		)
+	}
+	state.Key = types.StringValue(apiKey.Key)
+	state.AwsStyleCredentials = types.BoolPointerValue(apiKey.AwsStyleCredentials)
+	state.AwsAccessKeyId = types.StringValue(apiKey.AwsAccessKeyId)
+	state.AwsSecretKey = types.StringValue(apiKey.AwsSecretKey)
+	state.Name = types.StringValue(apiKey.Name)
+	state.PermissionSet = types.StringValue(apiKey.PermissionSet)
+	state.SiteId = types.Int64Value(apiKey.SiteId)
+	state.SiteName = types.StringValue(apiKey.SiteName)
+	state.Url = types.StringValue(apiKey.Url)
+	state.UserId = types.Int64Value(apiKey.UserId)
+	state.WorkspaceId = types.Int64Value(apiKey.WorkspaceId)
+
+	return
+}
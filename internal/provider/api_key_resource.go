@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
@@ -29,6 +30,8 @@ var (
 	_ resource.Resource                = &apiKeyResource{}
 	_ resource.ResourceWithConfigure   = &apiKeyResource{}
 	_ resource.ResourceWithImportState = &apiKeyResource{}
+	_ resource.ResourceWithIdentity    = &apiKeyResource{}
+	_ resource.ResourceWithModifyPlan  = &apiKeyResource{}
 )
 
 func NewApiKeyResource() resource.Resource {
@@ -36,29 +39,30 @@ func NewApiKeyResource() resource.Resource {
 }
 
 type apiKeyResource struct {
-	client *api_key.Client
+	client             *api_key.Client
+	defaultWorkspaceId types.Int64
 }
 
 type apiKeyResourceModel struct {
-	Name                types.String `tfsdk:"name"`
-	Description         types.String `tfsdk:"description"`
-	ExpiresAt           types.String `tfsdk:"expires_at"`
-	AwsStyleCredentials types.Bool   `tfsdk:"aws_style_credentials"`
-	PermissionSet       types.String `tfsdk:"permission_set"`
-	UserId              types.Int64  `tfsdk:"user_id"`
-	WorkspaceId         types.Int64  `tfsdk:"workspace_id"`
-	Path                types.String `tfsdk:"path"`
-	Id                  types.Int64  `tfsdk:"id"`
-	DescriptiveLabel    types.String `tfsdk:"descriptive_label"`
-	CreatedAt           types.String `tfsdk:"created_at"`
-	Key                 types.String `tfsdk:"key"`
-	AwsAccessKeyId      types.String `tfsdk:"aws_access_key_id"`
-	AwsSecretKey        types.String `tfsdk:"aws_secret_key"`
-	LastUseAt           types.String `tfsdk:"last_use_at"`
-	Platform            types.String `tfsdk:"platform"`
-	SiteId              types.Int64  `tfsdk:"site_id"`
-	SiteName            types.String `tfsdk:"site_name"`
-	Url                 types.String `tfsdk:"url"`
+	Name                types.String       `tfsdk:"name"`
+	Description         types.String       `tfsdk:"description"`
+	ExpiresAt           types.String       `tfsdk:"expires_at"`
+	AwsStyleCredentials types.Bool         `tfsdk:"aws_style_credentials"`
+	PermissionSet       types.String       `tfsdk:"permission_set"`
+	UserId              types.Int64        `tfsdk:"user_id"`
+	WorkspaceId         types.Int64        `tfsdk:"workspace_id"`
+	Path                lib.NormalizedPath `tfsdk:"path"`
+	Id                  types.Int64        `tfsdk:"id"`
+	DescriptiveLabel    types.String       `tfsdk:"descriptive_label"`
+	CreatedAt           types.String       `tfsdk:"created_at"`
+	Key                 types.String       `tfsdk:"key"`
+	AwsAccessKeyId      types.String       `tfsdk:"aws_access_key_id"`
+	AwsSecretKey        types.String       `tfsdk:"aws_secret_key"`
+	LastUseAt           types.String       `tfsdk:"last_use_at"`
+	Platform            types.String       `tfsdk:"platform"`
+	SiteId              types.Int64        `tfsdk:"site_id"`
+	SiteName            types.String       `tfsdk:"site_name"`
+	Url                 types.String       `tfsdk:"url"`
 }
 
 func (r *apiKeyResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -66,18 +70,19 @@ func (r *apiKeyResource) Configure(_ context.Context, req resource.ConfigureRequ
 		return
 	}
 
-	sdk_config, ok := req.ProviderData.(files_sdk.Config)
+	provider_data, ok := req.ProviderData.(providerResourceData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected files_sdk.Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected providerResourceData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.client = &api_key.Client{Config: sdk_config}
+	r.client = &api_key.Client{Config: provider_data.Config}
+	r.defaultWorkspaceId = provider_data.DefaultWorkspaceId
 }
 
 func (r *apiKeyResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -154,6 +159,7 @@ func (r *apiKeyResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
+				CustomType: lib.NormalizedPathType{},
 			},
 			"id": schema.Int64Attribute{
 				Description: "API Key ID",
@@ -208,6 +214,21 @@ func (r *apiKeyResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 	}
 }
 
+func (r *apiKeyResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.Int64Attribute{
+				Description:       "API Key ID",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+func (r *apiKeyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyPlanDefaultWorkspaceId(ctx, r.defaultWorkspaceId, req, resp)
+}
+
 func (r *apiKeyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan apiKeyResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -270,6 +291,12 @@ func (r *apiKeyResource) Create(ctx context.Context, req resource.CreateRequest,
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.Identity.SetAttribute(ctx, path.Root("id"), plan.Id)
+	resp.Diagnostics.Append(diags...)
 }
 
 func (r *apiKeyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -305,6 +332,12 @@ func (r *apiKeyResource) Read(ctx context.Context, req resource.ReadRequest, res
 
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.Identity.SetAttribute(ctx, path.Root("id"), state.Id)
+	resp.Diagnostics.Append(diags...)
 }
 
 func (r *apiKeyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -369,6 +402,12 @@ func (r *apiKeyResource) Update(ctx context.Context, req resource.UpdateRequest,
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.Identity.SetAttribute(ctx, path.Root("id"), plan.Id)
+	resp.Diagnostics.Append(diags...)
 }
 
 func (r *apiKeyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -392,6 +431,13 @@ func (r *apiKeyResource) Delete(ctx context.Context, req resource.DeleteRequest,
 }
 
 func (r *apiKeyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID == "" {
+		var id types.Int64
+		resp.Diagnostics.Append(req.Identity.GetAttribute(ctx, path.Root("id"), &id)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+		return
+	}
+
 	idParts := strings.SplitN(req.ID, ",", 1)
 
 	if len(idParts) != 1 || idParts[0] == "" {
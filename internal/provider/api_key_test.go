@@ -0,0 +1,48 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	files_sdk "github.com/Files-com/files-sdk-go/v3"
+	api_key "github.com/Files-com/files-sdk-go/v3/apikey"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestApiKeyEphemeralOpenFailure(t *testing.T) {
+	ctx := context.Background()
+	requests := []string{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		requests = append(requests, req.Method+" "+req.URL.Path)
+		if req.Method == http.MethodDelete {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(files_sdk.ApiKey{Id: 5, Name: "ephemeral", Key: "secret"})
+	}))
+	defer server.Close()
+
+	r := &apiKeyEphemeralResource{client: &api_key.Client{Config: files_sdk.Config{APIKey: "api-key", EndpointOverride: server.URL}.Init()}}
+	schemaResp := &ephemeral.SchemaResponse{}
+	r.Schema(ctx, ephemeral.SchemaRequest{}, schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+
+	// Without private state to record the key in, Open fails after creating
+	// it and has to delete it again.
+	resp := &ephemeral.OpenResponse{Result: tfsdk.EphemeralResultData{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)}}
+	r.Open(ctx, ephemeral.OpenRequest{
+		Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: objectValue(objectType, map[string]tftypes.Value{
+			"name": tftypes.NewValue(tftypes.String, "ephemeral"),
+		})},
+	}, resp)
+
+	assert.True(t, resp.Diagnostics.HasError())
+	assert.Equal(t, []string{"POST /api/rest/v1/api_keys", "DELETE /api/rest/v1/api_keys/5"}, requests)
+}
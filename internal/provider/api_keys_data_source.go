@@ -0,0 +1,166 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	files_sdk "github.com/Files-com/files-sdk-go/v3"
+	api_key "github.com/Files-com/files-sdk-go/v3/apikey"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &apiKeysDataSource{}
+	_ datasource.DataSourceWithConfigure = &apiKeysDataSource{}
+)
+
+func NewApiKeysDataSource() datasource.DataSource {
+	return &apiKeysDataSource{}
+}
+
+type apiKeysDataSource struct {
+	client *api_key.Client
+}
+
+type apiKeysDataSourceModel struct {
+	SortBy     types.Map   `tfsdk:"sort_by"`
+	Filter     types.Map   `tfsdk:"filter"`
+	FilterGt   types.Map   `tfsdk:"filter_gt"`
+	FilterGteq types.Map   `tfsdk:"filter_gteq"`
+	FilterLt   types.Map   `tfsdk:"filter_lt"`
+	FilterLteq types.Map   `tfsdk:"filter_lteq"`
+	UserId     types.Int64 `tfsdk:"user_id"`
+	ApiKeys    types.List  `tfsdk:"api_keys"`
+}
+
+func (r *apiKeysDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	sdk_config, ok := req.ProviderData.(files_sdk.Config)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected files_sdk.Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = &api_key.Client{Config: sdk_config}
+}
+
+func (r *apiKeysDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_api_keys"
+}
+
+func (r *apiKeysDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists every API Key matching the given arguments. Filtering and sorting are performed by the Files.com API and all pages of results are returned.",
+		Attributes: map[string]schema.Attribute{
+			"sort_by": schema.MapAttribute{
+				Description: "If set, sort records by the specified field in either `asc` or `desc` direction.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"filter": schema.MapAttribute{
+				Description: "If set, return records where the specified field is equal to the supplied value.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"filter_gt": schema.MapAttribute{
+				Description: "If set, return records where the specified field is greater than the supplied value.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"filter_gteq": schema.MapAttribute{
+				Description: "If set, return records where the specified field is greater than or equal the supplied value.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"filter_lt": schema.MapAttribute{
+				Description: "If set, return records where the specified field is less than the supplied value.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"filter_lteq": schema.MapAttribute{
+				Description: "If set, return records where the specified field is less than or equal the supplied value.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"user_id": schema.Int64Attribute{
+				Description: "User ID.  Provide a value of `0` to operate the current session's user.",
+				Optional:    true,
+			},
+			"api_keys": schema.ListNestedAttribute{
+				Description: "API Keys matching the given arguments.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: listItemAttributes(dataSourceSchema(ctx, NewApiKeyDataSource()).Attributes),
+				},
+			},
+		},
+	}
+}
+
+func (r *apiKeysDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data apiKeysDataSourceModel
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var propDiags diag.Diagnostics
+	paramsApiKeyList := files_sdk.ApiKeyListParams{}
+	paramsApiKeyList.SortBy, propDiags = listFilterValue(ctx, path.Root("sort_by"), data.SortBy)
+	resp.Diagnostics.Append(propDiags...)
+	paramsApiKeyList.Filter, propDiags = listFilterValue(ctx, path.Root("filter"), data.Filter)
+	resp.Diagnostics.Append(propDiags...)
+	paramsApiKeyList.FilterGt, propDiags = listFilterValue(ctx, path.Root("filter_gt"), data.FilterGt)
+	resp.Diagnostics.Append(propDiags...)
+	paramsApiKeyList.FilterGteq, propDiags = listFilterValue(ctx, path.Root("filter_gteq"), data.FilterGteq)
+	resp.Diagnostics.Append(propDiags...)
+	paramsApiKeyList.FilterLt, propDiags = listFilterValue(ctx, path.Root("filter_lt"), data.FilterLt)
+	resp.Diagnostics.Append(propDiags...)
+	paramsApiKeyList.FilterLteq, propDiags = listFilterValue(ctx, path.Root("filter_lteq"), data.FilterLteq)
+	resp.Diagnostics.Append(propDiags...)
+	paramsApiKeyList.UserId = data.UserId.ValueInt64()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiKeyIt, err := r.client.List(paramsApiKeyList, files_sdk.WithContext(ctx))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Listing Files ApiKeys",
+			"Could not list api_keys: "+err.Error(),
+		)
+		return
+	}
+
+	apikeys, err := listAll[files_sdk.ApiKey](apiKeyIt)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Listing Files ApiKeys",
+			"Could not list api_keys: "+err.Error(),
+		)
+		return
+	}
+
+	data.ApiKeys, diags = listItems(ctx, dataSourceSchema(ctx, NewApiKeyDataSource()).Attributes, apikeys, (&apiKeyDataSource{}).populateDataSourceModel)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, data)
+	resp.Diagnostics.Append(diags...)
+}
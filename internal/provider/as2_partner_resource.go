@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/dynamicplanmodifier"
@@ -29,6 +30,7 @@ var (
 	_ resource.Resource                = &as2PartnerResource{}
 	_ resource.ResourceWithConfigure   = &as2PartnerResource{}
 	_ resource.ResourceWithImportState = &as2PartnerResource{}
+	_ resource.ResourceWithIdentity    = &as2PartnerResource{}
 )
 
 func NewAs2PartnerResource() resource.Resource {
@@ -68,18 +70,18 @@ func (r *as2PartnerResource) Configure(_ context.Context, req resource.Configure
 		return
 	}
 
-	sdk_config, ok := req.ProviderData.(files_sdk.Config)
+	provider_data, ok := req.ProviderData.(providerResourceData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected files_sdk.Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected providerResourceData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.client = &as2_partner.Client{Config: sdk_config}
+	r.client = &as2_partner.Client{Config: provider_data.Config}
 }
 
 func (r *as2PartnerResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -222,6 +224,17 @@ func (r *as2PartnerResource) Schema(_ context.Context, _ resource.SchemaRequest,
 	}
 }
 
+func (r *as2PartnerResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.Int64Attribute{
+				Description:       "ID of the AS2 Partner.",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
 func (r *as2PartnerResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan as2PartnerResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -275,6 +288,12 @@ func (r *as2PartnerResource) Create(ctx context.Context, req resource.CreateRequ
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.Identity.SetAttribute(ctx, path.Root("id"), plan.Id)
+	resp.Diagnostics.Append(diags...)
 }
 
 func (r *as2PartnerResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -310,6 +329,12 @@ func (r *as2PartnerResource) Read(ctx context.Context, req resource.ReadRequest,
 
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.Identity.SetAttribute(ctx, path.Root("id"), state.Id)
+	resp.Diagnostics.Append(diags...)
 }
 
 func (r *as2PartnerResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -385,6 +410,12 @@ func (r *as2PartnerResource) Update(ctx context.Context, req resource.UpdateRequ
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.Identity.SetAttribute(ctx, path.Root("id"), plan.Id)
+	resp.Diagnostics.Append(diags...)
 }
 
 func (r *as2PartnerResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -408,6 +439,13 @@ func (r *as2PartnerResource) Delete(ctx context.Context, req resource.DeleteRequ
 }
 
 func (r *as2PartnerResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID == "" {
+		var id types.Int64
+		resp.Diagnostics.Append(req.Identity.GetAttribute(ctx, path.Root("id"), &id)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+		return
+	}
+
 	idParts := strings.SplitN(req.ID, ",", 1)
 
 	if len(idParts) != 1 || idParts[0] == "" {
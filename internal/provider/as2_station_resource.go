@@ -9,13 +9,16 @@ import (
 	files_sdk "github.com/Files-com/files-sdk-go/v3"
 	as2_station "github.com/Files-com/files-sdk-go/v3/as2station"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -23,6 +26,8 @@ var (
 	_ resource.Resource                = &as2StationResource{}
 	_ resource.ResourceWithConfigure   = &as2StationResource{}
 	_ resource.ResourceWithImportState = &as2StationResource{}
+	_ resource.ResourceWithIdentity    = &as2StationResource{}
+	_ resource.ResourceWithModifyPlan  = &as2StationResource{}
 )
 
 func NewAs2StationResource() resource.Resource {
@@ -30,15 +35,18 @@ func NewAs2StationResource() resource.Resource {
 }
 
 type as2StationResource struct {
-	client *as2_station.Client
+	client             *as2_station.Client
+	defaultWorkspaceId types.Int64
 }
 
 type as2StationResourceModel struct {
 	Name                       types.String `tfsdk:"name"`
 	PublicCertificate          types.String `tfsdk:"public_certificate"`
 	PrivateKey                 types.String `tfsdk:"private_key"`
+	PrivateKeyVersion          types.Int64  `tfsdk:"private_key_version"`
 	WorkspaceId                types.Int64  `tfsdk:"workspace_id"`
 	PrivateKeyPassword         types.String `tfsdk:"private_key_password"`
+	PrivateKeyPasswordVersion  types.Int64  `tfsdk:"private_key_password_version"`
 	Id                         types.Int64  `tfsdk:"id"`
 	Uri                        types.String `tfsdk:"uri"`
 	Domain                     types.String `tfsdk:"domain"`
@@ -58,18 +66,19 @@ func (r *as2StationResource) Configure(_ context.Context, req resource.Configure
 		return
 	}
 
-	sdk_config, ok := req.ProviderData.(files_sdk.Config)
+	provider_data, ok := req.ProviderData.(providerResourceData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected files_sdk.Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected providerResourceData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.client = &as2_station.Client{Config: sdk_config}
+	r.client = &as2_station.Client{Config: provider_data.Config}
+	r.defaultWorkspaceId = provider_data.DefaultWorkspaceId
 }
 
 func (r *as2StationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -92,6 +101,13 @@ func (r *as2StationResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				Required:  true,
 				WriteOnly: true,
 			},
+			"private_key_version": schema.Int64Attribute{
+				Description: "Change this value to send the write-only `private_key` to Files.com again, for example after rotating it.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRoot("private_key")),
+				},
+			},
 			"workspace_id": schema.Int64Attribute{
 				Description: "ID of the Workspace associated with this AS2 Station.",
 				Computed:    true,
@@ -105,6 +121,13 @@ func (r *as2StationResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				Optional:  true,
 				WriteOnly: true,
 			},
+			"private_key_password_version": schema.Int64Attribute{
+				Description: "Change this value to send the write-only `private_key_password` to Files.com again, for example after rotating it.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRoot("private_key_password")),
+				},
+			},
 			"id": schema.Int64Attribute{
 				Description: "Id of the AS2 Station.",
 				Computed:    true,
@@ -160,6 +183,21 @@ func (r *as2StationResource) Schema(_ context.Context, _ resource.SchemaRequest,
 	}
 }
 
+func (r *as2StationResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.Int64Attribute{
+				Description:       "Id of the AS2 Station.",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+func (r *as2StationResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyPlanDefaultWorkspaceId(ctx, r.defaultWorkspaceId, req, resp)
+}
+
 func (r *as2StationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan as2StationResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -202,6 +240,12 @@ func (r *as2StationResource) Create(ctx context.Context, req resource.CreateRequ
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.Identity.SetAttribute(ctx, path.Root("id"), plan.Id)
+	resp.Diagnostics.Append(diags...)
 }
 
 func (r *as2StationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -237,6 +281,12 @@ func (r *as2StationResource) Read(ctx context.Context, req resource.ReadRequest,
 
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.Identity.SetAttribute(ctx, path.Root("id"), state.Id)
+	resp.Diagnostics.Append(diags...)
 }
 
 func (r *as2StationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -291,6 +341,12 @@ func (r *as2StationResource) Update(ctx context.Context, req resource.UpdateRequ
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.Identity.SetAttribute(ctx, path.Root("id"), plan.Id)
+	resp.Diagnostics.Append(diags...)
 }
 
 func (r *as2StationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -314,6 +370,13 @@ func (r *as2StationResource) Delete(ctx context.Context, req resource.DeleteRequ
 }
 
 func (r *as2StationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID == "" {
+		var id types.Int64
+		resp.Diagnostics.Append(req.Identity.GetAttribute(ctx, path.Root("id"), &id)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+		return
+	}
+
 	idParts := strings.SplitN(req.ID, ",", 1)
 
 	if len(idParts) != 1 || idParts[0] == "" {
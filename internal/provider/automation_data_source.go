@@ -7,6 +7,7 @@ import (
 	files_sdk "github.com/Files-com/files-sdk-go/v3"
 	automation "github.com/Files-com/files-sdk-go/v3/automation"
 	"github.com/Files-com/terraform-provider-files/lib"
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -15,8 +16,9 @@ import (
 )
 
 var (
-	_ datasource.DataSource              = &automationDataSource{}
-	_ datasource.DataSourceWithConfigure = &automationDataSource{}
+	_ datasource.DataSource                     = &automationDataSource{}
+	_ datasource.DataSourceWithConfigure        = &automationDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &automationDataSource{}
 )
 
 func NewAutomationDataSource() datasource.DataSource {
@@ -101,11 +103,12 @@ func (r *automationDataSource) Metadata(_ context.Context, req datasource.Metada
 
 func (r *automationDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Description: "An Automation is an automated process of controlling workflows on your Files.com site.\n\n\n\nAutomations are different from Behaviors because Behaviors are associated with a current folder, while Automations apply across your entire site.\n\n\n\nAutomations are never removed when folders are removed, while Behaviors are removed when the associated folder is removed.\n\n\n\n## Path Matching\n\n\n\nThe `path` attribute specifies which folders this automation applies to.\n\nIt gets combined with the `source` attribute to determine which files are actually affected by the automation.\n\nNote that the `path` attribute supports globs, and only refers to _folders_.\n\nIt's the `source` attribute, which also supports globs, combined with the `path` attribute that determines which files are affected, and automations only operate on the files themselves.\n\nAdditionally, paths in Automations can refer to folders which don't yet exist.\n\n\n\n### Path Globs\n\n\n\nAlthough Automations may have a `path` specified, it can be a glob (which includes wildcards), which affects multiple folders.\n\n\n\n`*` matches any folder at that level of the path, but not subfolders. For example, `path/to/*` matches `path/to/folder1` and `path/to/folder2`, but not `path/to/folder1/subfolder`.\n\n\n\n`**` matches subfolders recursively. For example, `path/to/**` matches `path/to/folder1`, `path/to/folder1/subfolder`, `path/to/folder2`, `path/to/folder2/subfolder`, etc.\n\n\n\n`?` matches any one character.\n\n\n\nUse square brackets `[]` to match any character from a set. This works like a regular expression, including negation using `^`.\n\n\n\nCurly brackets `{}` can be used to denote parts of a pattern which will accept a number of alternatives, separated by commas `,`.\n\nThese alternatives can either be literal text or include special characters including nested curly brackets.\n\nFor example `{Mon,Tue,Wed,Thu,Fri}` would match abbreviated weekdays, and `202{3-{0[7-9],1?},4-0[1-6]}-*` would match dates from `2023-07-01` through `2024-06-30`.\n\n\n\nTo match any of the special characters literally, precede it with a backslash and enclose that pair with square brackets. For example to match a literal `?`, use `[\\?]`.\n\n\n\nGlobs are supported on `path`, `source`, and `exclude_pattern` fields. Globs are not supported on remote paths of any kind or for any field.\n\n\n\nBy default, Copy and Move automations that use globs will implicitly replicate matched folder structures at the destination. If you want to flatten the folder structure, set `flatten_destination_structure` to `true`.\n\n\n\n## Automation Triggers\n\n\n\nAutomations can be triggered in the following ways:\n\n\n\n* `custom_schedule` : The automation will run according to either the reusable Site-level Schedule selected by `schedule_id` or its own custom schedule fields for `days_of_week` (0-based) and `times_of_day`. A time zone may be specified via `time_zone` in Rails TimeZone name format.\n\n* `daily` : The automation will run in a picked `interval`. You can specify `recurring_day` or `recurring_days` to select one or more day numbers inside the interval.\n\n* `webhook` : the automation will run when a request is sent to the corresponding webhook URL.\n\n* `action` : The automation will run when a specific action happens, e.g. a file is created or downloaded.\n\n\n\nFuture enhancements will allow Automations to be triggered by an incoming email, or by other services.\n\n\n\nCurrently, all Automation types support all triggers, with the following exceptions: `Create Folder` and `Run Remote Server Sync` are not supported by the `action` trigger.\n\n\n\nAutomations can be triggered manually if trigger is not set to `action`.\n\n\n\n## Destinations\n\n\n\nThe `destinations` parameter is a list of paths where files will be copied, moved, or created. It may include formatting parameters to dynamically determine the destination at runtime.\n\n\n\n### Relative vs. Absolute Paths\n\n\n\nIn order to specify a relative path, it must start with either `./` or `../`. All other paths are considered absolute. In general, leading slashes should never be used on Files.com paths, including here. Paths are interpreted as absolute in all contexts, even without a leading slash.\n\n\n\n### Files vs. Folders\n\n\n\nIf the destination path ends with a `/`, the filename from the source path will be preserved and put into the folder of this name. If the destination path does not end with a `/`, it will be interpreted as a filename and will override the source file's filename entirely.\n\n\n\n### Formatting Parameters\n\n\n\n**Action-Triggered Automations**\n\n\n\n* `%tf` : The name of the file that triggered the automation.\n\n* `%tp` : The path of the file that triggered the automation.\n\n* `%td` : The directory of the file that triggered the automation.\n\n* `%tb` : The name of the file (without extension) that triggered the automation.\n\n* `%te` : The extension of the file that triggered the automation.\n\n\n\nFor example, if the triggering file is at `path/to/file.txt`, then the automation destination `path/to/dest/incoming-%tf` will result in the actual destination being `path/to/dest/incoming-file.txt`.\n\n\n\n**Parent Folders**\n\n\n\nTo reference the parent folder of a source file, use `%p1`, `%p2`, `%p3`, etc. for the first, second, third, etc. parent folder, respectively.\n\n\n\nTo reference path components from the root downward, use `%P1`, `%P2`, `%P3`, etc. for the first, second, third, etc. path component, respectively.\n\n\n\nFor example, if the source file is at `accounts/file.txt`, then the automation destination `path/to/dest/%p1/some_file_name.txt` will result in the actual destination being `path/to/dest/accounts/some_file_name.txt`.\n\n\n\nIf the source file is at `partner/app/team/inbound/file.txt`, then the automation destination `path/to/dest/%P1/%P3/%P4/file.txt` will result in the actual destination being `path/to/dest/partner/team/inbound/file.txt`.\n\n\n\n**Source File Name**\n\n\n\nTo reference the name of the source file being processed, use the following tokens:\n\n\n\n* `%Ff` : The name of the source file, with extension.\n\n* `%Fb` : The name of the source file, without extension.\n\n* `%Fe` : The extension of the source file.\n\n* `%Fl` : The name of the source file, with extension, converted to lowercase.\n\n* `%Fn` : The name of the source file, without non-alphanumeric characters, with extension.\n\n* `%Fp` : The name of the source file, with extension, spaces removed, lowercase, non-ASCII normalized.\n\n\n\nFor example, if the source file is `Daily Report.xlsx` and the destination is `archive/%Y-%m-%d/%Fb.xlsx`, the resolved destination will be `archive/2024-01-15/Daily Report.xlsx`.\n\n\n\n**Dates and Times**\n\n\n\n* `%Y` : The current year (4 digits)\n\n* `%m` : The current month (2 digits)\n\n* `%B` : The current month (full name)\n\n* `%d` : The current day (2 digits)\n\n* `%H` : The current hour (2 digits, 24-hour clock)\n\n* `%M` : The current minute (2 digits)\n\n* `%S` : The current second (2 digits)\n\n* `%z` : UTC Time Zone (e.g. -0900)\n\n\n\nFor example, if the current date is June 23, 2023 and the source file is named `daily_sales.csv`, then the following automation destination `path/to/dest/%Y/%m/%d/` will result in the actual destination being `path/to/dest/2023/06/23/daily_sales.csv`.\n\n\n\n### Replacing Text\n\n\n\nTo replace text in the source filename, use the `destination_replace_from` and `destination_replace_to` parameters. This will perform a simple text replacement on the source filename before inserting it into the destination path.\n\n\n\nFor example, if the `destination_replace_from` is `incoming` and the `destination_replace_to` is `outgoing`, then `path/to/incoming.txt` will translate to `path/to/outgoing.txt`.\n\n\n\n\n\n## Automation Types\n\n\n\nThere are several types of automations: Create Folder, Copy File, Move File, Delete File and, Run Remote Server Sync.\n\n\n\n\n\n### Create Folder\n\n\n\nCreates the folder with named by `destinations` in the path named by `path`.\n\nDestination may include formatting parameters to insert the date/time into the destination name.\n\n\n\nExample Use case: Our business files sales tax for each division in 11 states every quarter.\n\nI want to create the folders where those sales tax forms and data will be collected.\n\n\n\nI could create a Create Folder automation as follows:\n\n\n\n* Trigger: `daily`\n\n* Interval: `quarter_end`\n\n* Path: `AccountingAndTax/SalesTax/State/*/`\n\n* Destinations: `%Y/Quarter-ending-%m-%d`\n\n\n\nNote this assumes you have folders in `AccountingAndTax/SalesTax/State/` already created for each state, e.g. `AccountingAndTax/SalesTax/State/CA/`.\n\n\n\n\n\n### Delete File\n\n\n\nDeletes the file with path matching `source` (wildcards allowed) in the path named by `path`.\n\n\n\n\n\n### Copy File\n\n\n\nCopies files in the folder named by `path` to the path specified in `destinations`.\n\nThe automation will only fire on files matching the `source` (wildcards allowed). In the case of an action-triggered automation, it will only operate on the actual file that triggered the automation.\n\nIf the parameter `limit` exists, the automation will only copy the newest `limit` files in each matching folder.\n\n\n\n\n\n### Move File\n\n\n\nMoves files in the folder named by `path` to the path specified in `destinations`.\n\nThe automation will only fire on files matching the `source` (wildcards allowed). In the case of an action-triggered automation, it will only operate on the actual file that triggered the automation.\n\nIf the parameter `limit` exists, the automation will only move the newest `limit` files in each matching folder.\n\nNote that for a move with multiple destinations, all but one destination is treated as a copy.\n\n\n\n\n\n### Run Remote Server Sync\n\n\n\nThe Run Remote Server Sync automation runs the remote server syncs specified by the `sync_ids`.\n\n\n\nTypically when this automation is used, the remote server syncs in question are set to the manual\n\nscheduling mode (`manual` to `true` via the API) to disable the built in sync scheduler.\n\n\n\n\n\n### Import File\n\n\n\nRetrieves files from one or more URLs and saves the results under the path specified in `destinations`.\n\n\n\nThe URLs to retrieve are specified as a JSON array in the `import_urls` property.\n\n\n\n```json\n\n[\n\n {\n\n \"name\": \"response.json\",\n\n \"url\": \"https://example.com/api\",\n\n \"method\": \"post\",\n\n \"headers\": {\n\n \"Content-Type\": \"application/json\"\n\n },\n\n \"content\": { \"trigger-file\": \"%tp\" }\n\n }\n\n]\n\n```\n\n\n\nThe recognized keys are:\n\n\n\n* `name`: The file name which will be used to save the returned content. Required. `%` tokens will be replaced as described under Formatting Parameters.\n\n* `url`: The URL which will be requested. Required.\n\n* `method`: The HTTP method to be used for the request. May be either `get` or `post` (case insensitive). Defaults to `get`.\n\n* `headers`: Optional headers to be included in the request. `%` tokens in the values will be replaced as described under Formatting Parameters.\n\n* `content`: Optional body to send for POST request. If supplied as a string, `%` tokens will be expanded. If supplied as a JSON Object, `%` tokens will be expanded for top-level values. Other JSON types will be sent as-is.\n\n\n\n\n\n### Help us build the future of Automations\n\n\n\nDo you have an idea for something that would work well as a Files.com Automation? Let us know!\n\nWe are actively improving the types of automations offered on our platform.\n\n\n\n\n\n## Retrying Failures\n\n\n\nAutomations will automatically retry individual action steps up to 3 times, with pauses between retries that increase from 15 seconds to 1 minute. If individual action steps fail after our 3rd attempt, that action will fail. If every action step in an Automation Run fails, that automation run will move to a `failure` status. If at least one step succeeds and one step fails, that automation run will move to a `partial_failure` status.\n\n\n\nAutomation Runs can be retried automatically when they enter a `failure` or `partial_failure` status as described above. A retry will re-run the automation from scratch, including the \"planning\" phase, which expands globs (wildcards) and identifies which files to transfer or skip.\n\n\n\nRetrying of entire Automation Runs must be explicitly enabled by setting the `retry_on_failure_interval_in_minutes` and `retry_on_failure_number_of_attempts` values on the Automation.",
+		Description: "An Automation is an automated process of controlling workflows on your Files.com site.\n\n\n\nAutomations are different from Behaviors because Behaviors are associated with a current folder, while Automations apply across your entire site.\n\n\n\nAutomations are never removed when folders are removed, while Behaviors are removed when the associated folder is removed.\n\n\n\n## Path Matching\n\n\n\nThe `path` attribute specifies which folders this automation applies to.\n\nIt gets combined with the `source` attribute to determine which files are actually affected by the automation.\n\nNote that the `path` attribute supports globs, and only refers to _folders_.\n\nIt's the `source` attribute, which also supports globs, combined with the `path` attribute that determines which files are affected, and automations only operate on the files themselves.\n\nAdditionally, paths in Automations can refer to folders which don't yet exist.\n\n\n\n### Path Globs\n\n\n\nAlthough Automations may have a `path` specified, it can be a glob (which includes wildcards), which affects multiple folders.\n\n\n\n`*` matches any folder at that level of the path, but not subfolders. For example, `path/to/*` matches `path/to/folder1` and `path/to/folder2`, but not `path/to/folder1/subfolder`.\n\n\n\n`**` matches subfolders recursively. For example, `path/to/**` matches `path/to/folder1`, `path/to/folder1/subfolder`, `path/to/folder2`, `path/to/folder2/subfolder`, etc.\n\n\n\n`?` matches any one character.\n\n\n\nUse square brackets `[]` to match any character from a set. This works like a regular expression, including negation using `^`.\n\n\n\nCurly brackets `{}` can be used to denote parts of a pattern which will accept a number of alternatives, separated by commas `,`.\n\nThese alternatives can either be literal text or include special characters including nested curly brackets.\n\nFor example `{Mon,Tue,Wed,Thu,Fri}` would match abbreviated weekdays, and `202{3-{0[7-9],1?},4-0[1-6]}-*` would match dates from `2023-07-01` through `2024-06-30`.\n\n\n\nTo match any of the special characters literally, precede it with a backslash and enclose that pair with square brackets. For example to match a literal `?`, use `[\\?]`.\n\n\n\nGlobs are supported on `path`, `source`, and `exclude_pattern` fields. Globs are not supported on remote paths of any kind or for any field.\n\n\n\nBy default, Copy and Move automations that use globs will implicitly replicate matched folder structures at the destination. If you want to flatten the folder structure, set `flatten_destination_structure` to `true`.\n\n\n\n## Automation Triggers\n\n\n\nAutomations can be triggered in the following ways:\n\n\n\n* `custom_schedule` : The automation will run according to either the reusable Site-level Schedule selected by `schedule_id` or its own custom schedule fields for `days_of_week` (0-based) and `times_of_day`. A time zone may be specified via `time_zone` in Rails TimeZone name format.\n\n* `daily` : The automation will run in a picked `interval`. You can specify `recurring_day` or `recurring_days` to select one or more day numbers inside the interval.\n\n* `webhook` : the automation will run when a request is sent to the corresponding webhook URL.\n\n* `action` : The automation will run when a specific action happens, e.g. a file is created or downloaded.\n\n\n\nFuture enhancements will allow Automations to be triggered by an incoming email, or by other services.\n\n\n\nCurrently, all Automation types support all triggers, with the following exceptions: `Create Folder` and `Run Remote Server Sync` are not supported by the `action` trigger.\n\n\n\nAutomations can be triggered manually if trigger is not set to `action`.\n\n\n\n## Destinations\n\n\n\nThe `destinations` parameter is a list of paths where files will be copied, moved, or created. It may include formatting parameters to dynamically determine the destination at runtime.\n\n\n\n### Relative vs. Absolute Paths\n\n\n\nIn order to specify a relative path, it must start with either `./` or `../`. All other paths are considered absolute. In general, leading slashes should never be used on Files.com paths, including here. Paths are interpreted as absolute in all contexts, even without a leading slash.\n\n\n\n### Files vs. Folders\n\n\n\nIf the destination path ends with a `/`, the filename from the source path will be preserved and put into the folder of this name. If the destination path does not end with a `/`, it will be interpreted as a filename and will override the source file's filename entirely.\n\n\n\n### Formatting Parameters\n\n\n\n**Action-Triggered Automations**\n\n\n\n* `%tf` : The name of the file that triggered the automation.\n\n* `%tp` : The path of the file that triggered the automation.\n\n* `%td` : The directory of the file that triggered the automation.\n\n* `%tb` : The name of the file (without extension) that triggered the automation.\n\n* `%te` : The extension of the file that triggered the automation.\n\n\n\nFor example, if the triggering file is at `path/to/file.txt`, then the automation destination `path/to/dest/incoming-%tf` will result in the actual destination being `path/to/dest/incoming-file.txt`.\n\n\n\n**Parent Folders**\n\n\n\nTo reference the parent folder of a source file, use `%p1`, `%p2`, `%p3`, etc. for the first, second, third, etc. parent folder, respectively.\n\n\n\nTo reference path components from the root downward, use `%P1`, `%P2`, `%P3`, etc. for the first, second, third, etc. path component, respectively.\n\n\n\nFor example, if the source file is at `accounts/file.txt`, then the automation destination `path/to/dest/%p1/some_file_name.txt` will result in the actual destination being `path/to/dest/accounts/some_file_name.txt`.\n\n\n\nIf the source file is at `partner/app/team/inbound/file.txt`, then the automation destination `path/to/dest/%P1/%P3/%P4/file.txt` will result in the actual destination being `path/to/dest/partner/team/inbound/file.txt`.\n\n\n\n**Source File Name**\n\n\n\nTo reference the name of the source file being processed, use the following tokens:\n\n\n\n* `%Ff` : The name of the source file, with extension.\n\n* `%Fb` : The name of the source file, without extension.\n\n* `%Fe` : The extension of the source file.\n\n* `%Fl` : The name of the source file, with extension, converted to lowercase.\n\n* `%Fn` : The name of the source file, without non-alphanumeric characters, with extension.\n\n* `%Fp` : The name of the source file, with extension, spaces removed, lowercase, non-ASCII normalized.\n\n\n\nFor example, if the source file is `Daily Report.xlsx` and the destination is `archive/%Y-%m-%d/%Fb.xlsx`, the resolved destination will be `archive/2024-01-15/Daily Report.xlsx`.\n\n\n\n**Dates and Times**\n\n\n\n* `%Y` : The current year (4 digits)\n\n* `%m` : The current month (2 digits)\n\n* `%B` : The current month (full name)\n\n* `%d` : The current day (2 digits)\n\n* `%H` : The current hour (2 digits, 24-hour clock)\n\n* `%M` : The current minute (2 digits)\n\n* `%S` : The current second (2 digits)\n\n* `%z` : UTC Time Zone (e.g. -0900)\n\n\n\nFor example, if the current date is June 23, 2023 and the source file is named `daily_sales.csv`, then the following automation destination `path/to/dest/%Y/%m/%d/` will result in the actual destination being `path/to/dest/2023/06/23/daily_sales.csv`.\n\n\n\n### Replacing Text\n\n\n\nTo replace text in the source filename, use the `destination_replace_from` and `destination_replace_to` parameters. This will perform a simple text replacement on the source filename before inserting it into the destination path.\n\n\n\nFor example, if the `destination_replace_from` is `incoming` and the `destination_replace_to` is `outgoing`, then `path/to/incoming.txt` will translate to `path/to/outgoing.txt`.\n\n\n\n\n\n## Automation Types\n\n\n\nThere are several types of automations: Create Folder, Copy File, Move File, Delete File and, Run Remote Server Sync.\n\n\n\n\n\n### Create Folder\n\n\n\nCreates the folder with named by `destinations` in the path named by `path`.\n\nDestination may include formatting parameters to insert the date/time into the destination name.\n\n\n\nExample Use case: Our business files sales tax for each division in 11 states every quarter.\n\nI want to create the folders where those sales tax forms and data will be collected.\n\n\n\nI could create a Create Folder automation as follows:\n\n\n\n* Trigger: `daily`\n\n* Interval: `quarter_end`\n\n* Path: `AccountingAndTax/SalesTax/State/*/`\n\n* Destinations: `%Y/Quarter-ending-%m-%d`\n\n\n\nNote this assumes you have folders in `AccountingAndTax/SalesTax/State/` already created for each state, e.g. `AccountingAndTax/SalesTax/State/CA/`.\n\n\n\n\n\n### Delete File\n\n\n\nDeletes the file with path matching `source` (wildcards allowed) in the path named by `path`.\n\n\n\n\n\n### Copy File\n\n\n\nCopies files in the folder named by `path` to the path specified in `destinations`.\n\nThe automation will only fire on files matching the `source` (wildcards allowed). In the case of an action-triggered automation, it will only operate on the actual file that triggered the automation.\n\nIf the parameter `limit` exists, the automation will only copy the newest `limit` files in each matching folder.\n\n\n\n\n\n### Move File\n\n\n\nMoves files in the folder named by `path` to the path specified in `destinations`.\n\nThe automation will only fire on files matching the `source` (wildcards allowed). In the case of an action-triggered automation, it will only operate on the actual file that triggered the automation.\n\nIf the parameter `limit` exists, the automation will only move the newest `limit` files in each matching folder.\n\nNote that for a move with multiple destinations, all but one destination is treated as a copy.\n\n\n\n\n\n### Run Remote Server Sync\n\n\n\nThe Run Remote Server Sync automation runs the remote server syncs specified by the `sync_ids`.\n\n\n\nTypically when this automation is used, the remote server syncs in question are set to the manual\n\nscheduling mode (`manual` to `true` via the API) to disable the built in sync scheduler.\n\n\n\n\n\n### Import File\n\n\n\nRetrieves files from one or more URLs and saves the results under the path specified in `destinations`.\n\n\n\nThe URLs to retrieve are specified as a JSON array in the `import_urls` property.\n\n\n\n```json\n\n[\n\n {\n\n \"name\": \"response.json\",\n\n \"url\": \"https://example.com/api\",\n\n \"method\": \"post\",\n\n \"headers\": {\n\n \"Content-Type\": \"application/json\"\n\n },\n\n \"content\": { \"trigger-file\": \"%tp\" }\n\n }\n\n]\n\n```\n\n\n\nThe recognized keys are:\n\n\n\n* `name`: The file name which will be used to save the returned content. Required. `%` tokens will be replaced as described under Formatting Parameters.\n\n* `url`: The URL which will be requested. Required.\n\n* `method`: The HTTP method to be used for the request. May be either `get` or `post` (case insensitive). Defaults to `get`.\n\n* `headers`: Optional headers to be included in the request. `%` tokens in the values will be replaced as described under Formatting Parameters.\n\n* `content`: Optional body to send for POST request. If supplied as a string, `%` tokens will be expanded. If supplied as a JSON Object, `%` tokens will be expanded for top-level values. Other JSON types will be sent as-is.\n\n\n\n\n\n### Help us build the future of Automations\n\n\n\nDo you have an idea for something that would work well as a Files.com Automation? Let us know!\n\nWe are actively improving the types of automations offered on our platform.\n\n\n\n\n\n## Retrying Failures\n\n\n\nAutomations will automatically retry individual action steps up to 3 times, with pauses between retries that increase from 15 seconds to 1 minute. If individual action steps fail after our 3rd attempt, that action will fail. If every action step in an Automation Run fails, that automation run will move to a `failure` status. If at least one step succeeds and one step fails, that automation run will move to a `partial_failure` status.\n\n\n\nAutomation Runs can be retried automatically when they enter a `failure` or `partial_failure` status as described above. A retry will re-run the automation from scratch, including the \"planning\" phase, which expands globs (wildcards) and identifies which files to transfer or skip.\n\n\n\nRetrying of entire Automation Runs must be explicitly enabled by setting the `retry_on_failure_interval_in_minutes` and `retry_on_failure_number_of_attempts` values on the Automation.\n\nLook up a automation either by `id` or by `name`. A `name` lookup fails if no automation, or more than one automation, has that name.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.Int64Attribute{
 				Description: "Automation ID",
-				Required:    true,
+				Optional:    true,
+				Computed:    true,
 			},
 			"workspace_id": schema.Int64Attribute{
 				Description: "Workspace ID",
@@ -1494,6 +1497,7 @@ func (r *automationDataSource) Schema(_ context.Context, _ datasource.SchemaRequ
 			},
 			"name": schema.StringAttribute{
 				Description: "Name for this automation.",
+				Optional:    true,
 				Computed:    true,
 			},
 			"overwrite_files": schema.BoolAttribute{
@@ -1603,6 +1607,15 @@ func (r *automationDataSource) Schema(_ context.Context, _ datasource.SchemaRequ
 	}
 }
 
+func (r *automationDataSource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("id"),
+			path.MatchRoot("name"),
+		),
+	}
+}
+
 func (r *automationDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	var data automationDataSourceModel
 	diags := req.Config.Get(ctx, &data)
@@ -1611,6 +1624,29 @@ func (r *automationDataSource) Read(ctx context.Context, req datasource.ReadRequ
 		return
 	}
 
+	if data.Id.IsNull() {
+		paramsAutomationList := files_sdk.AutomationListParams{}
+
+		automationIt, err := r.client.List(paramsAutomationList, files_sdk.WithContext(ctx))
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Reading Files Automation",
+				"Could not list automations: "+err.Error(),
+			)
+			return
+		}
+
+		match, diags := lookupByKey(automationIt, "Error Reading Files Automation", "automation", "name", data.Name.ValueString(),
+			func(a files_sdk.Automation) string { return a.Name },
+			func(a files_sdk.Automation) int64 { return a.Id },
+		)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		data.Id = types.Int64Value(match.Id)
+	}
+
 	paramsAutomationFind := files_sdk.AutomationFindParams{}
 	paramsAutomationFind.Id = data.Id.ValueInt64()
 
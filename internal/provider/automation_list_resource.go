@@ -0,0 +1,134 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	files_sdk "github.com/Files-com/files-sdk-go/v3"
+	automation "github.com/Files-com/files-sdk-go/v3/automation"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ list.ListResource              = &automationListResource{}
+	_ list.ListResourceWithConfigure = &automationListResource{}
+)
+
+func NewAutomationListResource() list.ListResource {
+	return &automationListResource{}
+}
+
+type automationListResource struct {
+	client *automation.Client
+}
+
+type automationListResourceModel struct {
+	Filter     types.Map `tfsdk:"filter"`
+	FilterGt   types.Map `tfsdk:"filter_gt"`
+	FilterGteq types.Map `tfsdk:"filter_gteq"`
+	FilterLt   types.Map `tfsdk:"filter_lt"`
+	FilterLteq types.Map `tfsdk:"filter_lteq"`
+}
+
+func (r *automationListResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	sdk_config, ok := req.ProviderData.(files_sdk.Config)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected List Resource Configure Type",
+			fmt.Sprintf("Expected files_sdk.Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = &automation.Client{Config: sdk_config}
+}
+
+func (r *automationListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_automation"
+}
+
+func (r *automationListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists every Automation matching the given arguments so `terraform query` can import them.",
+		Attributes: map[string]schema.Attribute{
+			"filter": schema.MapAttribute{
+				Description: "If set, return records where the specified field is equal to the supplied value.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"filter_gt": schema.MapAttribute{
+				Description: "If set, return records where the specified field is greater than the supplied value.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"filter_gteq": schema.MapAttribute{
+				Description: "If set, return records where the specified field is greater than or equal the supplied value.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"filter_lt": schema.MapAttribute{
+				Description: "If set, return records where the specified field is less than the supplied value.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"filter_lteq": schema.MapAttribute{
+				Description: "If set, return records where the specified field is less than or equal the supplied value.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+func (r *automationListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var data automationListResourceModel
+	diags := req.Config.Get(ctx, &data)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	var propDiags diag.Diagnostics
+	paramsAutomationList := files_sdk.AutomationListParams{}
+	paramsAutomationList.Filter, propDiags = listFilterValue(ctx, path.Root("filter"), data.Filter)
+	diags.Append(propDiags...)
+	paramsAutomationList.FilterGt, propDiags = listFilterValue(ctx, path.Root("filter_gt"), data.FilterGt)
+	diags.Append(propDiags...)
+	paramsAutomationList.FilterGteq, propDiags = listFilterValue(ctx, path.Root("filter_gteq"), data.FilterGteq)
+	diags.Append(propDiags...)
+	paramsAutomationList.FilterLt, propDiags = listFilterValue(ctx, path.Root("filter_lt"), data.FilterLt)
+	diags.Append(propDiags...)
+	paramsAutomationList.FilterLteq, propDiags = listFilterValue(ctx, path.Root("filter_lteq"), data.FilterLteq)
+	diags.Append(propDiags...)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	automationIt, err := r.client.List(paramsAutomationList, files_sdk.WithContext(ctx))
+	if err != nil {
+		diags.AddError(
+			"Error Listing Files Automations",
+			"Could not list automations: "+err.Error(),
+		)
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	stream.Results = listResults(ctx, req, automationIt, "Error Listing Files Automations", "Could not list automations: ", func(automation files_sdk.Automation, result *list.ListResult) {
+		result.DisplayName = automation.Name
+		result.Diagnostics.Append(result.Identity.SetAttribute(ctx, path.Root("id"), automation.Id)...)
+	}, (&automationResource{}).populateResourceModel)
+}
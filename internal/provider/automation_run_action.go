@@ -0,0 +1,240 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	files_sdk "github.com/Files-com/files-sdk-go/v3"
+	automation "github.com/Files-com/files-sdk-go/v3/automation"
+	automation_log "github.com/Files-com/files-sdk-go/v3/automationlog"
+	automation_run "github.com/Files-com/files-sdk-go/v3/automationrun"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ action.Action              = &automationRunAction{}
+	_ action.ActionWithConfigure = &automationRunAction{}
+)
+
+func NewAutomationRunAction() action.Action {
+	return &automationRunAction{}
+}
+
+type automationRunAction struct {
+	client    *automation.Client
+	runClient *automation_run.Client
+	logClient *automation_log.Client
+}
+
+type automationRunActionModel struct {
+	AutomationId types.Int64 `tfsdk:"automation_id"`
+	Wait         types.Bool  `tfsdk:"wait"`
+	Timeout      types.Int64 `tfsdk:"timeout"`
+}
+
+func (a *automationRunAction) Configure(_ context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	sdk_config, ok := req.ProviderData.(files_sdk.Config)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Action Configure Type",
+			fmt.Sprintf("Expected files_sdk.Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	a.client = &automation.Client{Config: sdk_config}
+	a.runClient = &automation_run.Client{Config: sdk_config}
+	a.logClient = &automation_log.Client{Config: sdk_config}
+}
+
+func (a *automationRunAction) Metadata(_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_automation_run"
+}
+
+func (a *automationRunAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Runs an Automation immediately, the same as the Run Now button in the web interface.\n\n\n\nSet `wait` to block until the resulting Automation Run finishes. A run that ends in `failure` or `canceled` is reported as an error, and a `partial_failure` or `skipped` run as a warning. Failed runs include the first 10 log messages of the operations that didn't succeed, and the link to the run's status messages.",
+		Attributes: map[string]schema.Attribute{
+			"automation_id": schema.Int64Attribute{
+				Description: "ID of the Automation to run.",
+				Required:    true,
+			},
+			"wait": schema.BoolAttribute{
+				Description: "Wait for the Automation Run to finish and report its status. Defaults to `false`.",
+				Optional:    true,
+			},
+			"timeout": schema.Int64Attribute{
+				Description: "The maximum number of seconds to wait for the Automation Run to finish when `wait` is set. The run carries on in Files.com if the timeout passes. Defaults to `3600`.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+func (a *automationRunAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config automationRunActionModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	automationId := config.AutomationId.ValueInt64()
+
+	// Manual runs don't return the run they start, so the newest run from
+	// before the request is remembered to recognize the new one.
+	var previousRun files_sdk.AutomationRun
+	if config.Wait.ValueBool() {
+		var err error
+		previousRun, err = a.latestRun(ctx, automationId)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Running Files Automation",
+				fmt.Sprintf("Could not list runs for automation id %d: %s", automationId, err),
+			)
+			return
+		}
+	}
+
+	paramsAutomationManualRun := files_sdk.AutomationManualRunParams{}
+	paramsAutomationManualRun.Id = automationId
+
+	err := a.client.ManualRun(paramsAutomationManualRun, files_sdk.WithContext(ctx))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Running Files Automation",
+			fmt.Sprintf("Could not run automation id %d: %s", automationId, err),
+		)
+		return
+	}
+
+	if !config.Wait.ValueBool() {
+		return
+	}
+
+	sendProgress(resp, fmt.Sprintf("Started automation %d, waiting for it to finish", automationId))
+
+	var run files_sdk.AutomationRun
+	err = waitForRun(ctx, runTimeout(config.Timeout), func(ctx context.Context) (bool, error) {
+		status := run.Status
+		if run.Id == 0 {
+			latestRun, err := a.latestRun(ctx, automationId)
+			if err != nil || latestRun.Id <= previousRun.Id {
+				return false, err
+			}
+			run = latestRun
+		} else {
+			paramsAutomationRunFind := files_sdk.AutomationRunFindParams{}
+			paramsAutomationRunFind.Id = run.Id
+
+			var err error
+			run, err = a.runClient.Find(paramsAutomationRunFind, files_sdk.WithContext(ctx))
+			if err != nil {
+				return false, err
+			}
+		}
+
+		if run.Status != status {
+			sendProgress(resp, fmt.Sprintf("Automation run %d is %s", run.Id, run.Status))
+		}
+		return automationRunFinished(run.Status), nil
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Waiting for Files Automation Run",
+			fmt.Sprintf("Could not wait for automation id %d to finish: %s", automationId, err),
+		)
+		return
+	}
+
+	detail := fmt.Sprintf("Automation run %d for automation id %d finished with status %s: %d successful and %d failed operations.", run.Id, automationId, run.Status, run.SuccessfulOperations, run.FailedOperations)
+	if run.Status == "failure" || run.Status == "partial_failure" {
+		lines, err := a.failedLogLines(ctx, run.Id)
+		if err != nil {
+			tflog.Warn(ctx, "Could not read automation run logs", map[string]interface{}{"automation_run_id": run.Id, "error": err.Error()})
+		}
+		detail += runLogDetail(lines)
+	}
+	if run.StatusMessagesUrl != "" {
+		detail += "\n\nStatus messages: " + run.StatusMessagesUrl
+	}
+
+	switch run.Status {
+	case "failure", "canceled":
+		resp.Diagnostics.AddError("Files Automation Run Failed", detail)
+	case "partial_failure":
+		resp.Diagnostics.AddWarning("Files Automation Run Partially Failed", detail)
+	case "skipped":
+		resp.Diagnostics.AddWarning("Files Automation Run Skipped", detail)
+	}
+}
+
+// latestRun returns the most recently created run of the automation, or an
+// empty run if it has never run.
+func (a *automationRunAction) latestRun(ctx context.Context, automationId int64) (files_sdk.AutomationRun, error) {
+	paramsAutomationRunList := files_sdk.AutomationRunListParams{}
+	paramsAutomationRunList.AutomationId = automationId
+	paramsAutomationRunList.SortBy = map[string]interface{}{"created_at": "desc"}
+	paramsAutomationRunList.PerPage = 1
+	paramsAutomationRunList.MaxPages = 1
+
+	it, err := a.runClient.List(paramsAutomationRunList, files_sdk.WithContext(ctx))
+	if err != nil {
+		return files_sdk.AutomationRun{}, err
+	}
+	runs, err := listAll[files_sdk.AutomationRun](it)
+	if err != nil || len(runs) == 0 {
+		return files_sdk.AutomationRun{}, err
+	}
+	return runs[0], nil
+}
+
+// failedLogLines returns the log messages of the operations that didn't
+// succeed in the first page of the run's logs.
+func (a *automationRunAction) failedLogLines(ctx context.Context, runId int64) ([]string, error) {
+	paramsAutomationLogList := files_sdk.AutomationLogListParams{}
+	paramsAutomationLogList.Filter = map[string]interface{}{"automation_run_id": runId}
+	paramsAutomationLogList.PerPage = runLogPageSize
+	paramsAutomationLogList.MaxPages = 1
+
+	it, err := a.logClient.List(paramsAutomationLogList, files_sdk.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	logs, err := listAll[files_sdk.AutomationLog](it)
+	if err != nil {
+		return nil, err
+	}
+
+	lines := []string{}
+	for _, log := range logs {
+		if log.Status == "success" || log.Message == "" {
+			continue
+		}
+		lines = append(lines, runLogLine(log.Path, log.Message))
+	}
+	return lines, nil
+}
+
+func automationRunFinished(status string) bool {
+	switch status {
+	case "success", "partial_failure", "failure", "skipped", "canceled":
+		return true
+	}
+	return false
+}
@@ -0,0 +1,160 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	files_sdk "github.com/Files-com/files-sdk-go/v3"
+	automation "github.com/Files-com/files-sdk-go/v3/automation"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &automationsDataSource{}
+	_ datasource.DataSourceWithConfigure = &automationsDataSource{}
+)
+
+func NewAutomationsDataSource() datasource.DataSource {
+	return &automationsDataSource{}
+}
+
+type automationsDataSource struct {
+	client *automation.Client
+}
+
+type automationsDataSourceModel struct {
+	SortBy      types.Map  `tfsdk:"sort_by"`
+	Filter      types.Map  `tfsdk:"filter"`
+	FilterGt    types.Map  `tfsdk:"filter_gt"`
+	FilterGteq  types.Map  `tfsdk:"filter_gteq"`
+	FilterLt    types.Map  `tfsdk:"filter_lt"`
+	FilterLteq  types.Map  `tfsdk:"filter_lteq"`
+	Automations types.List `tfsdk:"automations"`
+}
+
+func (r *automationsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	sdk_config, ok := req.ProviderData.(files_sdk.Config)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected files_sdk.Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = &automation.Client{Config: sdk_config}
+}
+
+func (r *automationsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_automations"
+}
+
+func (r *automationsDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists every Automation matching the given arguments. Filtering and sorting are performed by the Files.com API and all pages of results are returned. The free-form `import_urls`, `schedule` and `value` attributes are not included; read them with the `files_automation` data source.",
+		Attributes: map[string]schema.Attribute{
+			"sort_by": schema.MapAttribute{
+				Description: "If set, sort records by the specified field in either `asc` or `desc` direction.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"filter": schema.MapAttribute{
+				Description: "If set, return records where the specified field is equal to the supplied value.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"filter_gt": schema.MapAttribute{
+				Description: "If set, return records where the specified field is greater than the supplied value.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"filter_gteq": schema.MapAttribute{
+				Description: "If set, return records where the specified field is greater than or equal the supplied value.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"filter_lt": schema.MapAttribute{
+				Description: "If set, return records where the specified field is less than the supplied value.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"filter_lteq": schema.MapAttribute{
+				Description: "If set, return records where the specified field is less than or equal the supplied value.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"automations": schema.ListNestedAttribute{
+				Description: "Automations matching the given arguments.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: listItemAttributes(dataSourceSchema(ctx, NewAutomationDataSource()).Attributes),
+				},
+			},
+		},
+	}
+}
+
+func (r *automationsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data automationsDataSourceModel
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var propDiags diag.Diagnostics
+	paramsAutomationList := files_sdk.AutomationListParams{}
+	paramsAutomationList.SortBy, propDiags = listFilterValue(ctx, path.Root("sort_by"), data.SortBy)
+	resp.Diagnostics.Append(propDiags...)
+	paramsAutomationList.Filter, propDiags = listFilterValue(ctx, path.Root("filter"), data.Filter)
+	resp.Diagnostics.Append(propDiags...)
+	paramsAutomationList.FilterGt, propDiags = listFilterValue(ctx, path.Root("filter_gt"), data.FilterGt)
+	resp.Diagnostics.Append(propDiags...)
+	paramsAutomationList.FilterGteq, propDiags = listFilterValue(ctx, path.Root("filter_gteq"), data.FilterGteq)
+	resp.Diagnostics.Append(propDiags...)
+	paramsAutomationList.FilterLt, propDiags = listFilterValue(ctx, path.Root("filter_lt"), data.FilterLt)
+	resp.Diagnostics.Append(propDiags...)
+	paramsAutomationList.FilterLteq, propDiags = listFilterValue(ctx, path.Root("filter_lteq"), data.FilterLteq)
+	resp.Diagnostics.Append(propDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	automationIt, err := r.client.List(paramsAutomationList, files_sdk.WithContext(ctx))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Listing Files Automations",
+			"Could not list automations: "+err.Error(),
+		)
+		return
+	}
+
+	automations, err := listAll[files_sdk.Automation](automationIt)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Listing Files Automations",
+			"Could not list automations: "+err.Error(),
+		)
+		return
+	}
+
+	data.Automations, diags = listItems(ctx, dataSourceSchema(ctx, NewAutomationDataSource()).Attributes, automations, (&automationDataSource{}).populateDataSourceModel)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, data)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,125 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	files_sdk "github.com/Files-com/files-sdk-go/v3"
+	behavior "github.com/Files-com/files-sdk-go/v3/behavior"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ list.ListResource              = &behaviorListResource{}
+	_ list.ListResourceWithConfigure = &behaviorListResource{}
+)
+
+func NewBehaviorListResource() list.ListResource {
+	return &behaviorListResource{}
+}
+
+type behaviorListResource struct {
+	client *behavior.Client
+}
+
+type behaviorListResourceModel struct {
+	Filter            types.Map    `tfsdk:"filter"`
+	Path              types.String `tfsdk:"path"`
+	AncestorBehaviors types.Bool   `tfsdk:"ancestor_behaviors"`
+}
+
+func (r *behaviorListResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	sdk_config, ok := req.ProviderData.(files_sdk.Config)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected List Resource Configure Type",
+			fmt.Sprintf("Expected files_sdk.Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = &behavior.Client{Config: sdk_config}
+}
+
+func (r *behaviorListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_behavior"
+}
+
+func (r *behaviorListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists every Behavior matching the given arguments so `terraform query` can import them.",
+		Attributes: map[string]schema.Attribute{
+			"filter": schema.MapAttribute{
+				Description: "If set, return records where the specified field is equal to the supplied value.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"path": schema.StringAttribute{
+				Description: "If set, only list behaviors on this path.",
+				Optional:    true,
+			},
+			"ancestor_behaviors": schema.BoolAttribute{
+				Description: "If `true`, behaviors above this path are shown. Ignored if `path` is not specified.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func (r *behaviorListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var data behaviorListResourceModel
+	diags := req.Config.Get(ctx, &data)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	filter, propDiags := listFilterValue(ctx, path.Root("filter"), data.Filter)
+	diags.Append(propDiags...)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	var behaviorIt *behavior.Iter
+	var err error
+	if data.Path.IsNull() {
+		paramsBehaviorList := files_sdk.BehaviorListParams{}
+		paramsBehaviorList.Filter = filter
+
+		behaviorIt, err = r.client.List(paramsBehaviorList, files_sdk.WithContext(ctx))
+	} else {
+		paramsBehaviorListFor := files_sdk.BehaviorListForParams{}
+		paramsBehaviorListFor.Filter = filter
+		paramsBehaviorListFor.Path = data.Path.ValueString()
+		if !data.AncestorBehaviors.IsNull() && !data.AncestorBehaviors.IsUnknown() {
+			paramsBehaviorListFor.AncestorBehaviors = data.AncestorBehaviors.ValueBoolPointer()
+		}
+
+		behaviorIt, err = r.client.ListFor(paramsBehaviorListFor, files_sdk.WithContext(ctx))
+	}
+	if err != nil {
+		diags.AddError(
+			"Error Listing Files Behaviors",
+			"Could not list behaviors: "+err.Error(),
+		)
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	stream.Results = listResults(ctx, req, behaviorIt, "Error Listing Files Behaviors", "Could not list behaviors: ", func(behavior files_sdk.Behavior, result *list.ListResult) {
+		result.DisplayName = fmt.Sprintf("%s on /%s", behavior.Behavior, behavior.Path)
+		result.Diagnostics.Append(result.Identity.SetAttribute(ctx, path.Root("id"), behavior.Id)...)
+	}, (&behaviorResource{}).populateResourceModel)
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/dynamicplanmodifier"
@@ -26,9 +27,11 @@ import (
 )
 
 var (
-	_ resource.Resource                = &behaviorResource{}
-	_ resource.ResourceWithConfigure   = &behaviorResource{}
-	_ resource.ResourceWithImportState = &behaviorResource{}
+	_ resource.Resource                   = &behaviorResource{}
+	_ resource.ResourceWithConfigure      = &behaviorResource{}
+	_ resource.ResourceWithImportState    = &behaviorResource{}
+	_ resource.ResourceWithIdentity       = &behaviorResource{}
+	_ resource.ResourceWithValidateConfig = &behaviorResource{}
 )
 
 func NewBehaviorResource() resource.Resource {
@@ -40,19 +43,207 @@ type behaviorResource struct {
 }
 
 type behaviorResourceModel struct {
-	Path                        types.String  `tfsdk:"path"`
-	Behavior                    types.String  `tfsdk:"behavior"`
-	Name                        types.String  `tfsdk:"name"`
-	Description                 types.String  `tfsdk:"description"`
-	Value                       types.Dynamic `tfsdk:"value"`
-	DisableParentFolderBehavior types.Bool    `tfsdk:"disable_parent_folder_behavior"`
-	Recursive                   types.Bool    `tfsdk:"recursive"`
-	Id                          types.Int64   `tfsdk:"id"`
-	AttachmentUrl               types.String  `tfsdk:"attachment_url"`
-	PublicHostingUrl            types.String  `tfsdk:"public_hosting_url"`
-	Inherited                   types.Bool    `tfsdk:"inherited"`
-	Managed                     types.Bool    `tfsdk:"managed"`
-	RootBehaviorSiteAdminOnly   types.Bool    `tfsdk:"root_behavior_site_admin_only"`
+	Path                        lib.NormalizedPath `tfsdk:"path"`
+	Behavior                    types.String       `tfsdk:"behavior"`
+	Name                        types.String       `tfsdk:"name"`
+	Description                 types.String       `tfsdk:"description"`
+	Value                       types.Dynamic      `tfsdk:"value"`
+	DisableParentFolderBehavior types.Bool         `tfsdk:"disable_parent_folder_behavior"`
+	Recursive                   types.Bool         `tfsdk:"recursive"`
+	Id                          types.Int64        `tfsdk:"id"`
+	AttachmentUrl               types.String       `tfsdk:"attachment_url"`
+	PublicHostingUrl            types.String       `tfsdk:"public_hosting_url"`
+	Inherited                   types.Bool         `tfsdk:"inherited"`
+	Managed                     types.Bool         `tfsdk:"managed"`
+	RootBehaviorSiteAdminOnly   types.Bool         `tfsdk:"root_behavior_site_admin_only"`
+}
+
+var (
+	behaviorValueString   = lib.JSONSchema{Type: "string"}
+	behaviorValueNumber   = lib.JSONSchema{Type: "number"}
+	behaviorValueBoolean  = lib.JSONSchema{Type: "boolean"}
+	behaviorValueObject   = lib.JSONSchema{Type: "object"}
+	behaviorValueStrings  = lib.JSONSchema{Type: "array", Items: &behaviorValueString}
+	behaviorValueNumbers  = lib.JSONSchema{Type: "array", Items: &behaviorValueNumber}
+	behaviorValueTriggers = lib.JSONSchema{Type: "array", Items: &lib.JSONSchema{Type: "string", Enum: []string{"create", "read", "update", "destroy", "move", "copy"}}}
+)
+
+// behaviorValueSchemas describes the value accepted by each behavior type, as
+// documented in the behavior type table. Types that are not listed accept any
+// value.
+var behaviorValueSchemas = map[string]lib.JSONSchema{
+	"webhook": {Type: "object", Properties: map[string]lib.JSONSchema{
+		"urls":                 behaviorValueStrings,
+		"method":               behaviorValueString,
+		"triggers":             behaviorValueTriggers,
+		"triggering_filenames": behaviorValueStrings,
+		"exclude_filenames":    behaviorValueStrings,
+		"encoding":             behaviorValueString,
+		"headers":              behaviorValueObject,
+		"body":                 behaviorValueObject,
+		"verification_token":   behaviorValueString,
+		"file_form_field":      behaviorValueString,
+		"file_as_body":         behaviorValueString,
+		"use_dedicated_ips":    behaviorValueBoolean,
+	}},
+	"file_expiration": {OneOf: []lib.JSONSchema{
+		behaviorValueNumber,
+		{Type: "object", Properties: map[string]lib.JSONSchema{
+			"days_to_retain":       behaviorValueNumber,
+			"delete_empty_folders": behaviorValueBoolean,
+		}},
+	}},
+	"auto_encrypt": {Type: "object", Properties: map[string]lib.JSONSchema{
+		"gpg_key_id":         behaviorValueNumber,
+		"gpg_key_ids":        behaviorValueNumbers,
+		"algorithm":          behaviorValueString,
+		"signing_key_id":     behaviorValueNumber,
+		"suffix":             behaviorValueString,
+		"armor":              behaviorValueBoolean,
+		"gpg_key_partner_id": behaviorValueNumber,
+	}},
+	"lock_subfolders": {Type: "object", Properties: map[string]lib.JSONSchema{
+		"level": behaviorValueString,
+	}},
+	"storage_region": behaviorValueString,
+	"serve_publicly": {Type: "object", Properties: map[string]lib.JSONSchema{
+		"key":                         behaviorValueString,
+		"show_index":                  behaviorValueBoolean,
+		"force_download":              behaviorValueBoolean,
+		"cors_enabled":                behaviorValueBoolean,
+		"require_site_authentication": behaviorValueBoolean,
+	}},
+	"create_user_folders": {Type: "object", Properties: map[string]lib.JSONSchema{
+		"permission":            behaviorValueString,
+		"additional_permission": behaviorValueString,
+		"existing_users":        behaviorValueBoolean,
+		"group_id":              behaviorValueNumber,
+		"new_folder_name":       behaviorValueString,
+		"subfolders":            behaviorValueStrings,
+	}},
+	"inbox": {Type: "object", Properties: map[string]lib.JSONSchema{
+		"key":                                 behaviorValueString,
+		"dont_separate_submissions_by_folder": behaviorValueBoolean,
+		"dont_separate_submissions_by_folder_for_inbound_email": behaviorValueBoolean,
+		"dont_allow_folders_in_uploads":                         behaviorValueBoolean,
+		"require_inbox_recipient":                               behaviorValueBoolean,
+		"show_on_login_page":                                    behaviorValueBoolean,
+		"title":                                                 behaviorValueString,
+		"description":                                           behaviorValueString,
+		"help_text":                                             behaviorValueString,
+		"require_registration":                                  behaviorValueBoolean,
+		"password":                                              behaviorValueString,
+		"path_template":                                         behaviorValueString,
+		"path_template_time_zone":                               behaviorValueString,
+		"enable_inbound_email_address":                          behaviorValueBoolean,
+		"notify_senders_on_successful_uploads_via_email": behaviorValueBoolean,
+		"notify_senders_on_successful_uploads_via_web":   behaviorValueBoolean,
+		"allow_whitelisting":                             behaviorValueBoolean,
+		"whitelist":                                      behaviorValueStrings,
+		"disable_web_upload":                             behaviorValueBoolean,
+		"capture_email_body_filename":                    behaviorValueString,
+		"requested_upload_slots": {Type: "array", Items: &lib.JSONSchema{Type: "object", Properties: map[string]lib.JSONSchema{
+			"name": behaviorValueString,
+		}}},
+	}},
+	"limit_file_extensions": {Type: "object", Properties: map[string]lib.JSONSchema{
+		"extensions": behaviorValueStrings,
+		"mode":       behaviorValueString,
+	}},
+	"limit_file_regex": behaviorValueStrings,
+	"amazon_sns": {Type: "object", Properties: map[string]lib.JSONSchema{
+		"arns":     behaviorValueStrings,
+		"triggers": behaviorValueTriggers,
+		"aws_credentials": {Type: "object", Properties: map[string]lib.JSONSchema{
+			"access_key_id":     behaviorValueString,
+			"region":            behaviorValueString,
+			"secret_access_key": behaviorValueString,
+		}},
+	}},
+	"watermark": {Type: "object", Properties: map[string]lib.JSONSchema{
+		"gravity":             behaviorValueString,
+		"max_height_or_width": behaviorValueNumber,
+		"transparency":        behaviorValueNumber,
+		"dynamic_text":        behaviorValueString,
+	}},
+	"remote_server_mount": {Type: "object", Properties: map[string]lib.JSONSchema{
+		"remote_server_id": behaviorValueNumber,
+		"remote_path":      behaviorValueString,
+	}},
+	"slack_webhook": {Type: "object", Properties: map[string]lib.JSONSchema{
+		"url":        behaviorValueString,
+		"username":   behaviorValueString,
+		"channel":    behaviorValueString,
+		"icon_emoji": behaviorValueString,
+		"triggers":   behaviorValueTriggers,
+	}},
+	"auto_decrypt": {Type: "object", Properties: map[string]lib.JSONSchema{
+		"gpg_key_id":           behaviorValueNumber,
+		"gpg_key_ids":          behaviorValueNumbers,
+		"algorithm":            behaviorValueString,
+		"suffix":               behaviorValueString,
+		"ignore_mdc_error":     behaviorValueBoolean,
+		"gpg_key_partner_id":   behaviorValueNumber,
+		"use_all_private_keys": behaviorValueBoolean,
+	}},
+	"override_upload_filename": {Type: "object", Properties: map[string]lib.JSONSchema{
+		"filename_override_pattern":   behaviorValueString,
+		"filename_replace_from":       behaviorValueString,
+		"filename_replace_to":         behaviorValueString,
+		"filename_regex_replace_from": behaviorValueString,
+		"filename_regex_replace_to":   behaviorValueString,
+		"time_zone":                   behaviorValueString,
+	}},
+	"permission_fence": {Type: "object", Properties: map[string]lib.JSONSchema{
+		"fenced_permissions": behaviorValueString,
+	}},
+	"limit_filename_length": {Type: "object", Properties: map[string]lib.JSONSchema{
+		"max_length": behaviorValueNumber,
+		"shorten":    behaviorValueBoolean,
+	}},
+	"organize_files_into_subfolders": {Type: "object", Properties: map[string]lib.JSONSchema{
+		"subfolder_name_type": behaviorValueString,
+		"regex":               behaviorValueString,
+		"strftime_format":     behaviorValueString,
+		"time_zone":           behaviorValueString,
+		"apply_behavior":      behaviorValueBoolean,
+	}},
+	"teams_webhook": {Type: "object", Properties: map[string]lib.JSONSchema{
+		"url":      behaviorValueString,
+		"triggers": behaviorValueTriggers,
+	}},
+	"google_pub_sub": {Type: "object", Properties: map[string]lib.JSONSchema{
+		"projects_topics": {Type: "array", Items: &lib.JSONSchema{Type: "object", Properties: map[string]lib.JSONSchema{
+			"project_id": behaviorValueString,
+			"topic_id":   behaviorValueString,
+		}}},
+		"triggers":           behaviorValueTriggers,
+		"google_credentials": behaviorValueObject,
+	}},
+	"archive_overwritten_or_deleted_files": {Type: "object", Properties: map[string]lib.JSONSchema{
+		"archive_path": behaviorValueString,
+	}},
+	"auto_recrypt": {Type: "object", Properties: map[string]lib.JSONSchema{
+		"decrypt_gpg_key_ids":        behaviorValueNumbers,
+		"encrypt_gpg_key_ids":        behaviorValueNumbers,
+		"decrypt_gpg_key_partner_id": behaviorValueNumber,
+		"encrypt_gpg_key_partner_id": behaviorValueNumber,
+		"ignore_mdc_error":           behaviorValueBoolean,
+		"signing_key_id":             behaviorValueNumber,
+		"armor":                      behaviorValueBoolean,
+	}},
+	"metadata_category": {Type: "object", Properties: map[string]lib.JSONSchema{
+		"metadata_category_id": behaviorValueNumber,
+	}},
+	"auto_unzip": {Type: "object", Properties: map[string]lib.JSONSchema{
+		"destination_path": behaviorValueString,
+		"path_time_zone":   behaviorValueString,
+	}},
+	"remote_server_metadata_index": {Type: "object", Properties: map[string]lib.JSONSchema{
+		"interval_minutes":       behaviorValueNumber,
+		"initial_scan_completed": behaviorValueBoolean,
+	}},
+	"malware_scanning": {Type: "object", Properties: map[string]lib.JSONSchema{}},
 }
 
 func (r *behaviorResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -60,18 +251,18 @@ func (r *behaviorResource) Configure(_ context.Context, req resource.ConfigureRe
 		return
 	}
 
-	sdk_config, ok := req.ProviderData.(files_sdk.Config)
+	provider_data, ok := req.ProviderData.(providerResourceData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected files_sdk.Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected providerResourceData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.client = &behavior.Client{Config: sdk_config}
+	r.client = &behavior.Client{Config: provider_data.Config}
 }
 
 func (r *behaviorResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -88,6 +279,7 @@ func (r *behaviorResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
+				CustomType: lib.NormalizedPathType{},
 			},
 			"behavior": schema.StringAttribute{
 				Description: "Behavior type.",
@@ -116,7 +308,7 @@ func (r *behaviorResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 				},
 			},
 			"value": schema.DynamicAttribute{
-				Description: "Settings for this behavior.  See the section above for an example value to provide here.  Formatting is different for each Behavior type.  May be sent as nested JSON or a single JSON-encoded string.  If using XML encoding for the API call, this data must be sent as a JSON-encoded string.",
+				Description: "Settings for this behavior.  See the section above for an example value to provide here.  Formatting is different for each Behavior type.  May be sent as nested JSON or a single JSON-encoded string.  If using XML encoding for the API call, this data must be sent as a JSON-encoded string. Terraform checks the keys and types of the value against its behavior type before applying.",
 				Computed:    true,
 				Optional:    true,
 				PlanModifiers: []planmodifier.Dynamic{
@@ -170,6 +362,38 @@ func (r *behaviorResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 	}
 }
 
+func (r *behaviorResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.Int64Attribute{
+				Description:       "Folder behavior ID",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+func (r *behaviorResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config behaviorResourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.Behavior.IsNull() || config.Behavior.IsUnknown() {
+		return
+	}
+
+	schema, ok := behaviorValueSchemas[config.Behavior.ValueString()]
+	if !ok {
+		return
+	}
+
+	diags = lib.ValidateJSONSchema(ctx, path.Root("value"), config.Value, schema, "Invalid Behavior Value")
+	resp.Diagnostics.Append(diags...)
+}
+
 func (r *behaviorResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan behaviorResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -220,6 +444,12 @@ func (r *behaviorResource) Create(ctx context.Context, req resource.CreateReques
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.Identity.SetAttribute(ctx, path.Root("id"), plan.Id)
+	resp.Diagnostics.Append(diags...)
 }
 
 func (r *behaviorResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -255,6 +485,12 @@ func (r *behaviorResource) Read(ctx context.Context, req resource.ReadRequest, r
 
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.Identity.SetAttribute(ctx, path.Root("id"), state.Id)
+	resp.Diagnostics.Append(diags...)
 }
 
 func (r *behaviorResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -312,6 +548,12 @@ func (r *behaviorResource) Update(ctx context.Context, req resource.UpdateReques
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.Identity.SetAttribute(ctx, path.Root("id"), plan.Id)
+	resp.Diagnostics.Append(diags...)
 }
 
 func (r *behaviorResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -335,6 +577,13 @@ func (r *behaviorResource) Delete(ctx context.Context, req resource.DeleteReques
 }
 
 func (r *behaviorResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID == "" {
+		var id types.Int64
+		resp.Diagnostics.Append(req.Identity.GetAttribute(ctx, path.Root("id"), &id)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+		return
+	}
+
 	idParts := strings.SplitN(req.ID, ",", 1)
 
 	if len(idParts) != 1 || idParts[0] == "" {
@@ -361,7 +610,7 @@ func (r *behaviorResource) populateResourceModel(ctx context.Context, behavior f
 	var propDiags diag.Diagnostics
 
 	state.Id = types.Int64Value(behavior.Id)
-	state.Path = types.StringValue(behavior.Path)
+	state.Path = lib.NormalizedPathValue(behavior.Path)
 	state.AttachmentUrl = types.StringValue(behavior.AttachmentUrl)
 	state.Behavior = types.StringValue(behavior.Behavior)
 	state.Name = types.StringValue(behavior.Name)
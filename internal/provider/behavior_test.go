@@ -1,8 +1,13 @@
 package provider
 
 import (
+	"context"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	frameworkresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
 
@@ -82,3 +87,50 @@ resource "files_behavior" "primitive_file_expiration" {
 		},
 	})
 }
+
+func TestBehaviorValidateConfig(t *testing.T) {
+	ctx := context.Background()
+	r := &behaviorResource{}
+	behaviorSchema, objectType := resourceSchema(r)
+
+	config := func(behavior string, value tftypes.Value) tfsdk.Config {
+		return tfsdk.Config{Schema: behaviorSchema, Raw: objectValue(objectType, map[string]tftypes.Value{
+			"path":     tftypes.NewValue(tftypes.String, "Bar"),
+			"behavior": tftypes.NewValue(tftypes.String, behavior),
+			"value":    value,
+		})}
+	}
+	object := func(attributes map[string]tftypes.Value) tftypes.Value {
+		attributeTypes := map[string]tftypes.Type{}
+		for name, value := range attributes {
+			attributeTypes[name] = value.Type()
+		}
+		return tftypes.NewValue(tftypes.Object{AttributeTypes: attributeTypes}, attributes)
+	}
+
+	tests := []struct {
+		name     string
+		behavior string
+		value    tftypes.Value
+		path     path.Path
+	}{
+		{name: "primitive", behavior: "file_expiration", value: tftypes.NewValue(tftypes.Number, 14)},
+		{name: "object", behavior: "serve_publicly", value: object(map[string]tftypes.Value{"key": tftypes.NewValue(tftypes.String, "Bar"), "show_index": tftypes.NewValue(tftypes.Bool, true)})},
+		{name: "json string", behavior: "serve_publicly", value: tftypes.NewValue(tftypes.String, `{"key":"Bar","show_index":true}`)},
+		{name: "numeric string", behavior: "auto_encrypt", value: object(map[string]tftypes.Value{"gpg_key_id": tftypes.NewValue(tftypes.String, "123")})},
+		{name: "unknown", behavior: "serve_publicly", value: tftypes.NewValue(tftypes.DynamicPseudoType, tftypes.UnknownValue)},
+		{name: "typo", behavior: "file_expiration", value: object(map[string]tftypes.Value{"day_to_retain": tftypes.NewValue(tftypes.Number, 30)}), path: path.Root("value").AtName("day_to_retain")},
+		{name: "non-numeric string", behavior: "auto_encrypt", value: object(map[string]tftypes.Value{"gpg_key_id": tftypes.NewValue(tftypes.String, "my key")}), path: path.Root("value").AtName("gpg_key_id")},
+		{name: "wrong type", behavior: "serve_publicly", value: object(map[string]tftypes.Value{"show_index": tftypes.NewValue(tftypes.String, "yes")}), path: path.Root("value").AtName("show_index")},
+		{name: "wrong shape", behavior: "storage_region", value: object(map[string]tftypes.Value{"region": tftypes.NewValue(tftypes.String, "us-east-1")}), path: path.Root("value")},
+		{name: "wrong trigger", behavior: "teams_webhook", value: object(map[string]tftypes.Value{"triggers": tftypes.NewValue(tftypes.Tuple{ElementTypes: []tftypes.Type{tftypes.String}}, []tftypes.Value{tftypes.NewValue(tftypes.String, "delete")})}), path: path.Root("value").AtName("triggers").AtListIndex(0)},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			resp := &frameworkresource.ValidateConfigResponse{}
+			r.ValidateConfig(ctx, frameworkresource.ValidateConfigRequest{Config: config(test.behavior, test.value)}, resp)
+			assertAttributeError(t, resp.Diagnostics, "Invalid Behavior Value", test.path)
+		})
+	}
+}
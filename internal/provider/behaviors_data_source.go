@@ -0,0 +1,156 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	files_sdk "github.com/Files-com/files-sdk-go/v3"
+	behavior "github.com/Files-com/files-sdk-go/v3/behavior"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &behaviorsDataSource{}
+	_ datasource.DataSourceWithConfigure = &behaviorsDataSource{}
+)
+
+func NewBehaviorsDataSource() datasource.DataSource {
+	return &behaviorsDataSource{}
+}
+
+type behaviorsDataSource struct {
+	client *behavior.Client
+}
+
+type behaviorsDataSourceModel struct {
+	SortBy            types.Map    `tfsdk:"sort_by"`
+	Filter            types.Map    `tfsdk:"filter"`
+	Path              types.String `tfsdk:"path"`
+	AncestorBehaviors types.Bool   `tfsdk:"ancestor_behaviors"`
+	Behaviors         types.List   `tfsdk:"behaviors"`
+}
+
+func (r *behaviorsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	sdk_config, ok := req.ProviderData.(files_sdk.Config)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected files_sdk.Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = &behavior.Client{Config: sdk_config}
+}
+
+func (r *behaviorsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_behaviors"
+}
+
+func (r *behaviorsDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists every Behavior matching the given arguments. Filtering and sorting are performed by the Files.com API and all pages of results are returned. The free-form `value` attribute is not included; read it with the `files_behavior` data source.",
+		Attributes: map[string]schema.Attribute{
+			"sort_by": schema.MapAttribute{
+				Description: "If set, sort records by the specified field in either `asc` or `desc` direction.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"filter": schema.MapAttribute{
+				Description: "If set, return records where the specified field is equal to the supplied value.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"path": schema.StringAttribute{
+				Description: "If set, only list behaviors on this path.",
+				Optional:    true,
+			},
+			"ancestor_behaviors": schema.BoolAttribute{
+				Description: "If `true`, behaviors above this path are shown. Ignored if `path` is not specified.",
+				Optional:    true,
+			},
+			"behaviors": schema.ListNestedAttribute{
+				Description: "Behaviors matching the given arguments.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: listItemAttributes(dataSourceSchema(ctx, NewBehaviorDataSource()).Attributes),
+				},
+			},
+		},
+	}
+}
+
+func (r *behaviorsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data behaviorsDataSourceModel
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var sortBy, filter interface{}
+	var propDiags diag.Diagnostics
+	sortBy, propDiags = listFilterValue(ctx, path.Root("sort_by"), data.SortBy)
+	resp.Diagnostics.Append(propDiags...)
+	filter, propDiags = listFilterValue(ctx, path.Root("filter"), data.Filter)
+	resp.Diagnostics.Append(propDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var behaviorIt *behavior.Iter
+	var err error
+	if data.Path.IsNull() {
+		paramsBehaviorList := files_sdk.BehaviorListParams{}
+		paramsBehaviorList.SortBy = sortBy
+		paramsBehaviorList.Filter = filter
+
+		behaviorIt, err = r.client.List(paramsBehaviorList, files_sdk.WithContext(ctx))
+	} else {
+		paramsBehaviorListFor := files_sdk.BehaviorListForParams{}
+		paramsBehaviorListFor.SortBy = sortBy
+		paramsBehaviorListFor.Filter = filter
+		paramsBehaviorListFor.Path = data.Path.ValueString()
+		if !data.AncestorBehaviors.IsNull() && !data.AncestorBehaviors.IsUnknown() {
+			paramsBehaviorListFor.AncestorBehaviors = data.AncestorBehaviors.ValueBoolPointer()
+		}
+
+		behaviorIt, err = r.client.ListFor(paramsBehaviorListFor, files_sdk.WithContext(ctx))
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Listing Files Behaviors",
+			"Could not list behaviors: "+err.Error(),
+		)
+		return
+	}
+
+	behaviors, err := listAll[files_sdk.Behavior](behaviorIt)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Listing Files Behaviors",
+			"Could not list behaviors: "+err.Error(),
+		)
+		return
+	}
+
+	data.Behaviors, diags = listItems(ctx, dataSourceSchema(ctx, NewBehaviorDataSource()).Attributes, behaviors, (&behaviorDataSource{}).populateDataSourceModel)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, data)
+	resp.Diagnostics.Append(diags...)
+}
@@ -9,6 +9,7 @@ import (
 	bundle "github.com/Files-com/files-sdk-go/v3/bundle"
 	"github.com/Files-com/terraform-provider-files/lib"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -17,8 +18,9 @@ import (
 )
 
 var (
-	_ datasource.DataSource              = &bundleDataSource{}
-	_ datasource.DataSourceWithConfigure = &bundleDataSource{}
+	_ datasource.DataSource                     = &bundleDataSource{}
+	_ datasource.DataSourceWithConfigure        = &bundleDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &bundleDataSource{}
 )
 
 func NewBundleDataSource() datasource.DataSource {
@@ -105,14 +107,16 @@ func (r *bundleDataSource) Metadata(_ context.Context, req datasource.MetadataRe
 
 func (r *bundleDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Description: "A Bundle is the API/SDK term for the feature called Share Links in the web interface.\n\nThe API provides the full set of actions related to Share Links, including sending them via E-Mail.\n\n\n\nPlease note that we very closely monitor the E-Mailing feature and any abuse will result in disabling of your site.",
+		Description: "A Bundle is the API/SDK term for the feature called Share Links in the web interface.\n\nThe API provides the full set of actions related to Share Links, including sending them via E-Mail.\n\n\n\nPlease note that we very closely monitor the E-Mailing feature and any abuse will result in disabling of your site.\n\nLook up a bundle either by `id` or by `code`. A `code` lookup fails if no bundle, or more than one bundle, has that code.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.Int64Attribute{
 				Description: "Bundle ID",
-				Required:    true,
+				Optional:    true,
+				Computed:    true,
 			},
 			"code": schema.StringAttribute{
 				Description: "Bundle code.  This code forms the end part of the Public URL.",
+				Optional:    true,
 				Computed:    true,
 			},
 			"color_left": schema.StringAttribute{
@@ -303,6 +307,15 @@ func (r *bundleDataSource) Schema(_ context.Context, _ datasource.SchemaRequest,
 	}
 }
 
+func (r *bundleDataSource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("id"),
+			path.MatchRoot("code"),
+		),
+	}
+}
+
 func (r *bundleDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	var data bundleDataSourceModel
 	diags := req.Config.Get(ctx, &data)
@@ -311,6 +324,29 @@ func (r *bundleDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		return
 	}
 
+	if data.Id.IsNull() {
+		paramsBundleList := files_sdk.BundleListParams{}
+
+		bundleIt, err := r.client.List(paramsBundleList, files_sdk.WithContext(ctx))
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Reading Files Bundle",
+				"Could not list bundles: "+err.Error(),
+			)
+			return
+		}
+
+		match, diags := lookupByKey(bundleIt, "Error Reading Files Bundle", "bundle", "code", data.Code.ValueString(),
+			func(b files_sdk.Bundle) string { return b.Code },
+			func(b files_sdk.Bundle) int64 { return b.Id },
+		)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		data.Id = types.Int64Value(match.Id)
+	}
+
 	paramsBundleFind := files_sdk.BundleFindParams{}
 	paramsBundleFind.Id = data.Id.ValueInt64()
 
@@ -0,0 +1,150 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	files_sdk "github.com/Files-com/files-sdk-go/v3"
+	bundle "github.com/Files-com/files-sdk-go/v3/bundle"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ list.ListResource              = &bundleListResource{}
+	_ list.ListResourceWithConfigure = &bundleListResource{}
+)
+
+func NewBundleListResource() list.ListResource {
+	return &bundleListResource{}
+}
+
+type bundleListResource struct {
+	client *bundle.Client
+}
+
+type bundleListResourceModel struct {
+	Filter       types.Map  `tfsdk:"filter"`
+	FilterGt     types.Map  `tfsdk:"filter_gt"`
+	FilterGteq   types.Map  `tfsdk:"filter_gteq"`
+	FilterPrefix types.Map  `tfsdk:"filter_prefix"`
+	FilterLt     types.Map  `tfsdk:"filter_lt"`
+	FilterLteq   types.Map  `tfsdk:"filter_lteq"`
+	Deleted      types.Bool `tfsdk:"deleted"`
+}
+
+func (r *bundleListResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	sdk_config, ok := req.ProviderData.(files_sdk.Config)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected List Resource Configure Type",
+			fmt.Sprintf("Expected files_sdk.Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = &bundle.Client{Config: sdk_config}
+}
+
+func (r *bundleListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_bundle"
+}
+
+func (r *bundleListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists every Bundle matching the given arguments so `terraform query` can import them.",
+		Attributes: map[string]schema.Attribute{
+			"filter": schema.MapAttribute{
+				Description: "If set, return records where the specified field is equal to the supplied value.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"filter_gt": schema.MapAttribute{
+				Description: "If set, return records where the specified field is greater than the supplied value.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"filter_gteq": schema.MapAttribute{
+				Description: "If set, return records where the specified field is greater than or equal the supplied value.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"filter_prefix": schema.MapAttribute{
+				Description: "If set, return records where the specified field is prefixed by the supplied value.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"filter_lt": schema.MapAttribute{
+				Description: "If set, return records where the specified field is less than the supplied value.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"filter_lteq": schema.MapAttribute{
+				Description: "If set, return records where the specified field is less than or equal the supplied value.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"deleted": schema.BoolAttribute{
+				Description: "If true, only return deleted bundles.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func (r *bundleListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var data bundleListResourceModel
+	diags := req.Config.Get(ctx, &data)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	var propDiags diag.Diagnostics
+	paramsBundleList := files_sdk.BundleListParams{}
+	paramsBundleList.Filter, propDiags = listFilterValue(ctx, path.Root("filter"), data.Filter)
+	diags.Append(propDiags...)
+	paramsBundleList.FilterGt, propDiags = listFilterValue(ctx, path.Root("filter_gt"), data.FilterGt)
+	diags.Append(propDiags...)
+	paramsBundleList.FilterGteq, propDiags = listFilterValue(ctx, path.Root("filter_gteq"), data.FilterGteq)
+	diags.Append(propDiags...)
+	paramsBundleList.FilterPrefix, propDiags = listFilterValue(ctx, path.Root("filter_prefix"), data.FilterPrefix)
+	diags.Append(propDiags...)
+	paramsBundleList.FilterLt, propDiags = listFilterValue(ctx, path.Root("filter_lt"), data.FilterLt)
+	diags.Append(propDiags...)
+	paramsBundleList.FilterLteq, propDiags = listFilterValue(ctx, path.Root("filter_lteq"), data.FilterLteq)
+	diags.Append(propDiags...)
+	if !data.Deleted.IsNull() && !data.Deleted.IsUnknown() {
+		paramsBundleList.Deleted = data.Deleted.ValueBoolPointer()
+	}
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	bundleIt, err := r.client.List(paramsBundleList, files_sdk.WithContext(ctx))
+	if err != nil {
+		diags.AddError(
+			"Error Listing Files Bundles",
+			"Could not list bundles: "+err.Error(),
+		)
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	stream.Results = listResults(ctx, req, bundleIt, "Error Listing Files Bundles", "Could not list bundles: ", func(bundle files_sdk.Bundle, result *list.ListResult) {
+		result.DisplayName = bundle.Code
+		result.Diagnostics.Append(result.Identity.SetAttribute(ctx, path.Root("id"), bundle.Id)...)
+	}, (&bundleResource{}).populateResourceModel)
+}
@@ -12,10 +12,12 @@ import (
 	bundle "github.com/Files-com/files-sdk-go/v3/bundle"
 	"github.com/Files-com/terraform-provider-files/lib"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
@@ -30,6 +32,8 @@ var (
 	_ resource.Resource                = &bundleResource{}
 	_ resource.ResourceWithConfigure   = &bundleResource{}
 	_ resource.ResourceWithImportState = &bundleResource{}
+	_ resource.ResourceWithIdentity    = &bundleResource{}
+	_ resource.ResourceWithModifyPlan  = &bundleResource{}
 )
 
 func NewBundleResource() resource.Resource {
@@ -37,7 +41,8 @@ func NewBundleResource() resource.Resource {
 }
 
 type bundleResource struct {
-	client *bundle.Client
+	client             *bundle.Client
+	defaultWorkspaceId types.Int64
 }
 
 type bundleResourceModel struct {
@@ -68,6 +73,7 @@ type bundleResourceModel struct {
 	SendOneTimePasswordToRecipientAtRegistration types.Bool    `tfsdk:"send_one_time_password_to_recipient_at_registration"`
 	WorkspaceId                                  types.Int64   `tfsdk:"workspace_id"`
 	Password                                     types.String  `tfsdk:"password"`
+	PasswordVersion                              types.Int64   `tfsdk:"password_version"`
 	FormFieldSetId                               types.Int64   `tfsdk:"form_field_set_id"`
 	CreateSnapshot                               types.Bool    `tfsdk:"create_snapshot"`
 	FinalizeSnapshot                             types.Bool    `tfsdk:"finalize_snapshot"`
@@ -100,18 +106,19 @@ func (r *bundleResource) Configure(_ context.Context, req resource.ConfigureRequ
 		return
 	}
 
-	sdk_config, ok := req.ProviderData.(files_sdk.Config)
+	provider_data, ok := req.ProviderData.(providerResourceData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected files_sdk.Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected providerResourceData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.client = &bundle.Client{Config: sdk_config}
+	r.client = &bundle.Client{Config: provider_data.Config}
+	r.defaultWorkspaceId = provider_data.DefaultWorkspaceId
 }
 
 func (r *bundleResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -336,6 +343,13 @@ func (r *bundleResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 				Optional:    true,
 				WriteOnly:   true,
 			},
+			"password_version": schema.Int64Attribute{
+				Description: "Change this value to send the write-only `password` to Files.com again, for example after rotating it.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRoot("password")),
+				},
+			},
 			"form_field_set_id": schema.Int64Attribute{
 				Description: "Id of Form Field Set to use with this bundle",
 				Optional:    true,
@@ -445,6 +459,21 @@ func (r *bundleResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 	}
 }
 
+func (r *bundleResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.Int64Attribute{
+				Description:       "Bundle ID",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+func (r *bundleResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyPlanDefaultWorkspaceId(ctx, r.defaultWorkspaceId, req, resp)
+}
+
 func (r *bundleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan bundleResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -567,6 +596,12 @@ func (r *bundleResource) Create(ctx context.Context, req resource.CreateRequest,
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.Identity.SetAttribute(ctx, path.Root("id"), plan.Id)
+	resp.Diagnostics.Append(diags...)
 }
 
 func (r *bundleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -602,6 +637,12 @@ func (r *bundleResource) Read(ctx context.Context, req resource.ReadRequest, res
 
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.Identity.SetAttribute(ctx, path.Root("id"), state.Id)
+	resp.Diagnostics.Append(diags...)
 }
 
 func (r *bundleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -760,6 +801,12 @@ func (r *bundleResource) Update(ctx context.Context, req resource.UpdateRequest,
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.Identity.SetAttribute(ctx, path.Root("id"), plan.Id)
+	resp.Diagnostics.Append(diags...)
 }
 
 func (r *bundleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -783,6 +830,13 @@ func (r *bundleResource) Delete(ctx context.Context, req resource.DeleteRequest,
 }
 
 func (r *bundleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID == "" {
+		var id types.Int64
+		resp.Diagnostics.Append(req.Identity.GetAttribute(ctx, path.Root("id"), &id)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+		return
+	}
+
 	idParts := strings.SplitN(req.ID, ",", 1)
 
 	if len(idParts) != 1 || idParts[0] == "" {
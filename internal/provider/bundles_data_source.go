@@ -0,0 +1,176 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	files_sdk "github.com/Files-com/files-sdk-go/v3"
+	bundle "github.com/Files-com/files-sdk-go/v3/bundle"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &bundlesDataSource{}
+	_ datasource.DataSourceWithConfigure = &bundlesDataSource{}
+)
+
+func NewBundlesDataSource() datasource.DataSource {
+	return &bundlesDataSource{}
+}
+
+type bundlesDataSource struct {
+	client *bundle.Client
+}
+
+type bundlesDataSourceModel struct {
+	SortBy       types.Map  `tfsdk:"sort_by"`
+	Filter       types.Map  `tfsdk:"filter"`
+	FilterGt     types.Map  `tfsdk:"filter_gt"`
+	FilterGteq   types.Map  `tfsdk:"filter_gteq"`
+	FilterPrefix types.Map  `tfsdk:"filter_prefix"`
+	FilterLt     types.Map  `tfsdk:"filter_lt"`
+	FilterLteq   types.Map  `tfsdk:"filter_lteq"`
+	Deleted      types.Bool `tfsdk:"deleted"`
+	Bundles      types.List `tfsdk:"bundles"`
+}
+
+func (r *bundlesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	sdk_config, ok := req.ProviderData.(files_sdk.Config)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected files_sdk.Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = &bundle.Client{Config: sdk_config}
+}
+
+func (r *bundlesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_bundles"
+}
+
+func (r *bundlesDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists every Bundle matching the given arguments. Filtering and sorting are performed by the Files.com API and all pages of results are returned. The free-form `watermark_value`, `requested_upload_slots` and `bundlepaths` attributes are not included; read them with the `files_bundle` data source.",
+		Attributes: map[string]schema.Attribute{
+			"sort_by": schema.MapAttribute{
+				Description: "If set, sort records by the specified field in either `asc` or `desc` direction.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"filter": schema.MapAttribute{
+				Description: "If set, return records where the specified field is equal to the supplied value.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"filter_gt": schema.MapAttribute{
+				Description: "If set, return records where the specified field is greater than the supplied value.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"filter_gteq": schema.MapAttribute{
+				Description: "If set, return records where the specified field is greater than or equal the supplied value.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"filter_prefix": schema.MapAttribute{
+				Description: "If set, return records where the specified field is prefixed by the supplied value.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"filter_lt": schema.MapAttribute{
+				Description: "If set, return records where the specified field is less than the supplied value.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"filter_lteq": schema.MapAttribute{
+				Description: "If set, return records where the specified field is less than or equal the supplied value.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"deleted": schema.BoolAttribute{
+				Description: "If true, only return deleted bundles.",
+				Optional:    true,
+			},
+			"bundles": schema.ListNestedAttribute{
+				Description: "Bundles matching the given arguments.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: listItemAttributes(dataSourceSchema(ctx, NewBundleDataSource()).Attributes),
+				},
+			},
+		},
+	}
+}
+
+func (r *bundlesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data bundlesDataSourceModel
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var propDiags diag.Diagnostics
+	paramsBundleList := files_sdk.BundleListParams{}
+	paramsBundleList.SortBy, propDiags = listFilterValue(ctx, path.Root("sort_by"), data.SortBy)
+	resp.Diagnostics.Append(propDiags...)
+	paramsBundleList.Filter, propDiags = listFilterValue(ctx, path.Root("filter"), data.Filter)
+	resp.Diagnostics.Append(propDiags...)
+	paramsBundleList.FilterGt, propDiags = listFilterValue(ctx, path.Root("filter_gt"), data.FilterGt)
+	resp.Diagnostics.Append(propDiags...)
+	paramsBundleList.FilterGteq, propDiags = listFilterValue(ctx, path.Root("filter_gteq"), data.FilterGteq)
+	resp.Diagnostics.Append(propDiags...)
+	paramsBundleList.FilterPrefix, propDiags = listFilterValue(ctx, path.Root("filter_prefix"), data.FilterPrefix)
+	resp.Diagnostics.Append(propDiags...)
+	paramsBundleList.FilterLt, propDiags = listFilterValue(ctx, path.Root("filter_lt"), data.FilterLt)
+	resp.Diagnostics.Append(propDiags...)
+	paramsBundleList.FilterLteq, propDiags = listFilterValue(ctx, path.Root("filter_lteq"), data.FilterLteq)
+	resp.Diagnostics.Append(propDiags...)
+	if !data.Deleted.IsNull() && !data.Deleted.IsUnknown() {
+		paramsBundleList.Deleted = data.Deleted.ValueBoolPointer()
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	bundleIt, err := r.client.List(paramsBundleList, files_sdk.WithContext(ctx))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Listing Files Bundles",
+			"Could not list bundles: "+err.Error(),
+		)
+		return
+	}
+
+	bundles, err := listAll[files_sdk.Bundle](bundleIt)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Listing Files Bundles",
+			"Could not list bundles: "+err.Error(),
+		)
+		return
+	}
+
+	data.Bundles, diags = listItems(ctx, dataSourceSchema(ctx, NewBundleDataSource()).Attributes, bundles, (&bundleDataSource{}).populateDataSourceModel)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, data)
+	resp.Diagnostics.Append(diags...)
+}
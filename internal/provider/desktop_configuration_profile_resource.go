@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
@@ -25,6 +26,8 @@ var (
 	_ resource.Resource                = &desktopConfigurationProfileResource{}
 	_ resource.ResourceWithConfigure   = &desktopConfigurationProfileResource{}
 	_ resource.ResourceWithImportState = &desktopConfigurationProfileResource{}
+	_ resource.ResourceWithIdentity    = &desktopConfigurationProfileResource{}
+	_ resource.ResourceWithModifyPlan  = &desktopConfigurationProfileResource{}
 )
 
 func NewDesktopConfigurationProfileResource() resource.Resource {
@@ -32,7 +35,8 @@ func NewDesktopConfigurationProfileResource() resource.Resource {
 }
 
 type desktopConfigurationProfileResource struct {
-	client *desktop_configuration_profile.Client
+	client             *desktop_configuration_profile.Client
+	defaultWorkspaceId types.Int64
 }
 
 type desktopConfigurationProfileResourceModel struct {
@@ -49,18 +53,19 @@ func (r *desktopConfigurationProfileResource) Configure(_ context.Context, req r
 		return
 	}
 
-	sdk_config, ok := req.ProviderData.(files_sdk.Config)
+	provider_data, ok := req.ProviderData.(providerResourceData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected files_sdk.Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected providerResourceData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.client = &desktop_configuration_profile.Client{Config: sdk_config}
+	r.client = &desktop_configuration_profile.Client{Config: provider_data.Config}
+	r.defaultWorkspaceId = provider_data.DefaultWorkspaceId
 }
 
 func (r *desktopConfigurationProfileResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -114,6 +119,21 @@ func (r *desktopConfigurationProfileResource) Schema(_ context.Context, _ resour
 	}
 }
 
+func (r *desktopConfigurationProfileResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.Int64Attribute{
+				Description:       "Desktop Configuration Profile ID",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+func (r *desktopConfigurationProfileResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyPlanDefaultWorkspaceId(ctx, r.defaultWorkspaceId, req, resp)
+}
+
 func (r *desktopConfigurationProfileResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan desktopConfigurationProfileResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -162,6 +182,12 @@ func (r *desktopConfigurationProfileResource) Create(ctx context.Context, req re
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.Identity.SetAttribute(ctx, path.Root("id"), plan.Id)
+	resp.Diagnostics.Append(diags...)
 }
 
 func (r *desktopConfigurationProfileResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -197,6 +223,12 @@ func (r *desktopConfigurationProfileResource) Read(ctx context.Context, req reso
 
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.Identity.SetAttribute(ctx, path.Root("id"), state.Id)
+	resp.Diagnostics.Append(diags...)
 }
 
 func (r *desktopConfigurationProfileResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -254,6 +286,12 @@ func (r *desktopConfigurationProfileResource) Update(ctx context.Context, req re
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.Identity.SetAttribute(ctx, path.Root("id"), plan.Id)
+	resp.Diagnostics.Append(diags...)
 }
 
 func (r *desktopConfigurationProfileResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -277,6 +315,13 @@ func (r *desktopConfigurationProfileResource) Delete(ctx context.Context, req re
 }
 
 func (r *desktopConfigurationProfileResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID == "" {
+		var id types.Int64
+		resp.Diagnostics.Append(req.Identity.GetAttribute(ctx, path.Root("id"), &id)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+		return
+	}
+
 	idParts := strings.SplitN(req.ID, ",", 1)
 
 	if len(idParts) != 1 || idParts[0] == "" {
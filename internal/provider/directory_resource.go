@@ -0,0 +1,572 @@
+package provider
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	files_sdk "github.com/Files-com/files-sdk-go/v3"
+	"github.com/Files-com/files-sdk-go/v3/file"
+	"github.com/Files-com/files-sdk-go/v3/ignore"
+	"github.com/Files-com/terraform-provider-files/lib"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource               = &directoryResource{}
+	_ resource.ResourceWithConfigure  = &directoryResource{}
+	_ resource.ResourceWithModifyPlan = &directoryResource{}
+)
+
+// directoryParallelTransfers is the number of files uploaded or deleted at once.
+const directoryParallelTransfers = 8
+
+func NewDirectoryResource() resource.Resource {
+	return &directoryResource{}
+}
+
+type directoryResource struct {
+	client *file.Client
+}
+
+type directoryResourceModel struct {
+	Source           types.String       `tfsdk:"source"`
+	Path             lib.NormalizedPath `tfsdk:"path"`
+	Include          types.List         `tfsdk:"include"`
+	Exclude          types.List         `tfsdk:"exclude"`
+	DeleteExtraFiles types.Bool         `tfsdk:"delete_extra_files"`
+	Files            types.Map          `tfsdk:"files"`
+}
+
+func (r *directoryResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	provider_data, ok := req.ProviderData.(providerResourceData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected providerResourceData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = &file.Client{Config: provider_data.Config}
+}
+
+func (r *directoryResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_directory"
+}
+
+func (r *directoryResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "A Directory uploads a local directory tree to a folder, and keeps the folder in step with it.\n\n\n\nEvery file is hashed at plan time, and the MD5 checksums are kept in one `files` manifest, so the plan lists the files that will be uploaded or deleted. Files that changed are uploaded again in place, in parallel. Use it instead of a `files_file` for each file of a large tree.\n\n\n\nFiles that are removed from the local directory are deleted from the folder. Destroying a Directory deletes the files it uploaded, and leaves the folders.",
+		Attributes: map[string]schema.Attribute{
+			"source": schema.StringAttribute{
+				Description: "Path to the local directory that will be uploaded.",
+				Required:    true,
+			},
+			"path": schema.StringAttribute{
+				Description: "Path of the folder to upload to. This must be slash-delimited, but it must neither start nor end with a slash. Maximum of 5000 characters.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				CustomType: lib.NormalizedPathType{},
+			},
+			"include": schema.ListAttribute{
+				Description: "Only upload the files matching one of these patterns, in `.gitignore` format, for example `*.json` or `templates/`. By default, every file is uploaded.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"exclude": schema.ListAttribute{
+				Description: "Don't upload the files matching one of these patterns, in `.gitignore` format, for example `.DS_Store` or `drafts/`.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"delete_extra_files": schema.BoolAttribute{
+				Description: "If true, files in the folder that are not in the local directory are deleted, even if they were not uploaded by Terraform. Files that don't match `include`, or that match `exclude`, are left alone.",
+				Optional:    true,
+			},
+			"files": schema.MapAttribute{
+				Description: "MD5 checksum of each file, by its path relative to `path`.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+func (r *directoryResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan directoryResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.Source.IsUnknown() || plan.Include.IsUnknown() || plan.Exclude.IsUnknown() {
+		plan.Files = types.MapUnknown(types.StringType)
+	} else {
+		files, diags := r.localFiles(ctx, plan)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		plan.Files, diags = types.MapValueFrom(ctx, types.StringType, files)
+		resp.Diagnostics.Append(diags...)
+	}
+
+	diags = resp.Plan.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *directoryResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan directoryResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = r.sync(ctx, &plan, map[string]string{})
+	resp.Diagnostics.Append(diags...)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *directoryResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state directoryResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	prior := map[string]string{}
+	diags = state.Files.ElementsAs(ctx, &prior, false)
+	resp.Diagnostics.Append(diags...)
+	remote, diags := r.remoteFiles(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	files := directoryRefreshFiles(prior, remote, state.DeleteExtraFiles.ValueBool())
+	state.Files, diags = types.MapValueFrom(ctx, types.StringType, files)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *directoryResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan directoryResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state directoryResourceModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	prior := map[string]string{}
+	diags = state.Files.ElementsAs(ctx, &prior, false)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = r.sync(ctx, &plan, prior)
+	resp.Diagnostics.Append(diags...)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *directoryResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state directoryResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	files := map[string]string{}
+	diags = state.Files.ElementsAs(ctx, &files, false)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deletes := make([]string, 0, len(files))
+	for name := range files {
+		deletes = append(deletes, name)
+	}
+	sort.Strings(deletes)
+
+	errs := directoryParallel(deletes, func(name string) error {
+		return r.deleteFile(ctx, state, name)
+	})
+	for _, name := range deletes {
+		if errs[name] != nil {
+			resp.Diagnostics.AddError(
+				"Error Deleting Files Directory",
+				"Could not delete file path "+directoryRemotePath(state.Path.ValueString(), name)+": "+errs[name].Error(),
+			)
+		}
+	}
+}
+
+// sync uploads the files of the local directory that differ from the folder,
+// and deletes the files that are no longer in the local directory. prior is
+// the manifest of the last apply. model.Files is set to the files that are
+// now in the folder, even when some uploads or deletes fail.
+func (r *directoryResource) sync(ctx context.Context, model *directoryResourceModel, prior map[string]string) (diags diag.Diagnostics) {
+	local := map[string]string{}
+	if model.Files.IsUnknown() {
+		var localDiags diag.Diagnostics
+		local, localDiags = r.localFiles(ctx, *model)
+		diags.Append(localDiags...)
+	} else {
+		diags.Append(model.Files.ElementsAs(ctx, &local, false)...)
+	}
+	remote, remoteDiags := r.remoteFiles(ctx, *model)
+	diags.Append(remoteDiags...)
+	if diags.HasError() {
+		var filesDiags diag.Diagnostics
+		model.Files, filesDiags = types.MapValueFrom(ctx, types.StringType, prior)
+		diags.Append(filesDiags...)
+		return
+	}
+
+	uploads, deletes := directoryChanges(local, remote, prior, model.DeleteExtraFiles.ValueBool())
+	tflog.Info(ctx, "Syncing directory", map[string]interface{}{
+		"path":    model.Path.ValueString(),
+		"uploads": len(uploads),
+		"deletes": len(deletes),
+	})
+
+	source := model.Source.ValueString()
+	uploadErrs := directoryParallel(uploads, func(name string) error {
+		return r.client.Upload(
+			file.UploadWithContext(ctx),
+			file.UploadWithFile(filepath.Join(source, filepath.FromSlash(name))),
+			file.UploadWithDestinationPath(directoryRemotePath(model.Path.ValueString(), name)),
+		)
+	})
+	deleteErrs := directoryParallel(deletes, func(name string) error {
+		return r.deleteFile(ctx, *model, name)
+	})
+
+	files := map[string]string{}
+	for name, md5 := range local {
+		files[name] = md5
+	}
+	for _, name := range uploads {
+		if uploadErrs[name] == nil {
+			continue
+		}
+		diags.AddError(
+			"Error Uploading Files Directory",
+			"Could not upload file path "+directoryRemotePath(model.Path.ValueString(), name)+": "+uploadErrs[name].Error(),
+		)
+		if md5, ok := prior[name]; ok {
+			files[name] = md5
+		} else {
+			delete(files, name)
+		}
+	}
+	for _, name := range deletes {
+		if deleteErrs[name] == nil {
+			continue
+		}
+		diags.AddError(
+			"Error Deleting Files Directory",
+			"Could not delete file path "+directoryRemotePath(model.Path.ValueString(), name)+": "+deleteErrs[name].Error(),
+		)
+		if md5, ok := remote[name]; ok && md5 != "" {
+			files[name] = md5
+		} else if md5, ok := prior[name]; ok {
+			files[name] = md5
+		}
+	}
+
+	var filesDiags diag.Diagnostics
+	model.Files, filesDiags = types.MapValueFrom(ctx, types.StringType, files)
+	diags.Append(filesDiags...)
+	return
+}
+
+func (r *directoryResource) deleteFile(ctx context.Context, model directoryResourceModel, name string) error {
+	paramsFileDelete := files_sdk.FileDeleteParams{
+		Path: directoryRemotePath(model.Path.ValueString(), name),
+	}
+	err := r.client.Delete(paramsFileDelete, files_sdk.WithContext(ctx))
+	if files_sdk.IsNotExist(err) {
+		return nil
+	}
+	return err
+}
+
+// localFiles hashes the files of the local directory that match the include
+// and exclude patterns, by their slash-delimited path relative to it.
+func (r *directoryResource) localFiles(ctx context.Context, model directoryResourceModel) (files map[string]string, diags diag.Diagnostics) {
+	ignored, diags := directoryPatterns(ctx, model)
+	if diags.HasError() {
+		return
+	}
+
+	source := model.Source.ValueString()
+	files = map[string]string{}
+	err := filepath.WalkDir(source, func(localPath string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if entry.IsDir() {
+			return nil
+		}
+		relativePath, err := filepath.Rel(source, localPath)
+		if err != nil {
+			return err
+		}
+		name := filepath.ToSlash(relativePath)
+		if ignored(name) {
+			return nil
+		}
+		info, err := os.Stat(localPath)
+		if err != nil {
+			return err
+		}
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+		files[name], err = directoryFileMd5(localPath)
+		return err
+	})
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("source"),
+			"Error Reading Files Directory Source",
+			"Could not read source directory: "+err.Error(),
+		)
+	}
+	return
+}
+
+// remoteFiles lists the files of the folder that match the include and
+// exclude patterns, with their MD5 checksums. The checksum is empty when the
+// site hasn't computed it. A folder that doesn't exist has no files.
+func (r *directoryResource) remoteFiles(ctx context.Context, model directoryResourceModel) (files map[string]string, diags diag.Diagnostics) {
+	ignored, diags := directoryPatterns(ctx, model)
+	if diags.HasError() {
+		return
+	}
+
+	files = map[string]string{}
+	paramsFolderListFor := files_sdk.FolderListForParams{}
+	paramsFolderListFor.Path = model.Path.ValueString()
+	it, err := r.client.ListForRecursive(paramsFolderListFor, files_sdk.WithContext(ctx))
+	if files_sdk.IsNotExist(err) {
+		return
+	}
+	if err != nil {
+		diags.AddError(
+			"Error Reading Files Directory",
+			"Could not list folder path "+fmt.Sprint(model.Path.ValueString())+": "+err.Error(),
+		)
+		return
+	}
+
+	prefix := directoryRemotePath(model.Path.ValueString(), "")
+	for it.Next() {
+		item := it.Resource()
+		if item.Err() != nil {
+			err = item.Err()
+			break
+		}
+		if item.Type != "file" || len(item.Path) <= len(prefix) || !strings.EqualFold(item.Path[:len(prefix)], prefix) {
+			continue
+		}
+		name := item.Path[len(prefix):]
+		if ignored(name) {
+			continue
+		}
+		files[name] = item.Md5
+	}
+	if err == nil {
+		err = it.Err()
+	}
+	if err != nil && !files_sdk.IsNotExist(err) {
+		diags.AddError(
+			"Error Reading Files Directory",
+			"Could not list folder path "+fmt.Sprint(model.Path.ValueString())+": "+err.Error(),
+		)
+	}
+	return
+}
+
+// directoryPatterns returns whether a file is left out of the directory by
+// the include and exclude patterns, which are matched as the SDK uploader
+// matches its Include and Ignore patterns.
+func directoryPatterns(ctx context.Context, model directoryResourceModel) (ignored func(name string) bool, diags diag.Diagnostics) {
+	var includePatterns, excludePatterns []string
+	if !model.Include.IsNull() {
+		diags.Append(model.Include.ElementsAs(ctx, &includePatterns, false)...)
+	}
+	if !model.Exclude.IsNull() {
+		diags.Append(model.Exclude.ElementsAs(ctx, &excludePatterns, false)...)
+	}
+
+	// ignore.New falls back to the operating system's default patterns when
+	// given none, so it is only used for patterns that are set.
+	ignored = func(name string) bool {
+		return false
+	}
+	if len(includePatterns) > 0 {
+		include, _ := ignore.New(includePatterns...)
+		ignored = func(name string) bool {
+			return !include.MatchesPath(name)
+		}
+	}
+	if len(excludePatterns) > 0 {
+		exclude, _ := ignore.New(excludePatterns...)
+		included := ignored
+		ignored = func(name string) bool {
+			return included(name) || exclude.MatchesPath(name)
+		}
+	}
+	return
+}
+
+// directoryChanges returns the files to upload, because they are missing
+// from the folder or differ from it, and the files to delete, because they
+// were uploaded before or are extra files, and are no longer in the local
+// directory. Files whose remote checksum is not known yet are compared with
+// prior instead.
+func directoryChanges(local, remote, prior map[string]string, deleteExtraFiles bool) (uploads []string, deletes []string) {
+	for name, md5 := range local {
+		remoteMd5, ok := remote[name]
+		if !ok {
+			uploads = append(uploads, name)
+			continue
+		}
+		if remoteMd5 == "" {
+			remoteMd5 = prior[name]
+		}
+		if remoteMd5 != md5 {
+			uploads = append(uploads, name)
+		}
+	}
+	for name := range remote {
+		if _, ok := local[name]; ok {
+			continue
+		}
+		if _, ok := prior[name]; ok || deleteExtraFiles {
+			deletes = append(deletes, name)
+		}
+	}
+	sort.Strings(uploads)
+	sort.Strings(deletes)
+	return
+}
+
+// directoryRefreshFiles returns the manifest as it is in the folder. Files
+// that were deleted from the folder are left out, so that they are uploaded
+// again, and extra files are added when they are to be deleted.
+func directoryRefreshFiles(prior, remote map[string]string, deleteExtraFiles bool) map[string]string {
+	files := map[string]string{}
+	for name, md5 := range prior {
+		remoteMd5, ok := remote[name]
+		if !ok {
+			continue
+		}
+		if remoteMd5 == "" {
+			remoteMd5 = md5
+		}
+		files[name] = remoteMd5
+	}
+	if deleteExtraFiles {
+		for name, md5 := range remote {
+			if _, ok := files[name]; !ok {
+				files[name] = md5
+			}
+		}
+	}
+	return files
+}
+
+// directoryParallel calls fn for each name, directoryParallelTransfers at a
+// time, and returns the errors by name.
+func directoryParallel(names []string, fn func(name string) error) map[string]error {
+	errs := map[string]error{}
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	slots := make(chan struct{}, directoryParallelTransfers)
+	for _, name := range names {
+		wg.Add(1)
+		slots <- struct{}{}
+		go func() {
+			defer wg.Done()
+			defer func() { <-slots }()
+			err := fn(name)
+			mu.Lock()
+			errs[name] = err
+			mu.Unlock()
+		}()
+	}
+	wg.Wait()
+	return errs
+}
+
+func directoryRemotePath(folderPath string, name string) string {
+	if folderPath == "" {
+		return name
+	}
+	return folderPath + "/" + name
+}
+
+func directoryFileMd5(localPath string) (string, error) {
+	localFile, err := os.Open(localPath)
+	if err != nil {
+		return "", err
+	}
+	defer localFile.Close()
+
+	hash := md5.New()
+	if _, err := io.Copy(hash, localFile); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
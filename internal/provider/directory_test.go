@@ -0,0 +1,182 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	files_sdk "github.com/Files-com/files-sdk-go/v3"
+	"github.com/Files-com/files-sdk-go/v3/file"
+	"github.com/Files-com/terraform-provider-files/lib"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	frameworkresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDirectoryLocalFiles(t *testing.T) {
+	source := t.TempDir()
+	for name, content := range map[string]string{
+		"README.txt":              "hello",
+		"templates/welcome.json":  "{}",
+		"templates/drafts/a.json": "{}",
+		"templates/notes.txt":     "notes",
+	} {
+		localPath := filepath.Join(source, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(localPath), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(localPath, []byte(content), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	patterns := func(values ...string) types.List {
+		elements := []attr.Value{}
+		for _, value := range values {
+			elements = append(elements, types.StringValue(value))
+		}
+		return types.ListValueMust(types.StringType, elements)
+	}
+
+	tests := []struct {
+		name     string
+		include  types.List
+		exclude  types.List
+		expected []string
+	}{
+		{name: "all", include: types.ListNull(types.StringType), exclude: types.ListNull(types.StringType), expected: []string{"README.txt", "templates/drafts/a.json", "templates/notes.txt", "templates/welcome.json"}},
+		{name: "include", include: patterns("*.json"), exclude: types.ListNull(types.StringType), expected: []string{"templates/drafts/a.json", "templates/welcome.json"}},
+		{name: "exclude", include: types.ListNull(types.StringType), exclude: patterns("drafts/", "*.txt"), expected: []string{"templates/welcome.json"}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			files, diags := (&directoryResource{}).localFiles(context.Background(), directoryResourceModel{
+				Source:  types.StringValue(source),
+				Include: test.include,
+				Exclude: test.exclude,
+			})
+			assert.False(t, diags.HasError(), diags)
+			names := []string{}
+			for name := range files {
+				names = append(names, name)
+			}
+			assert.ElementsMatch(t, test.expected, names)
+		})
+	}
+
+	files, _ := (&directoryResource{}).localFiles(context.Background(), directoryResourceModel{Source: types.StringValue(source), Include: patterns("README.txt"), Exclude: types.ListNull(types.StringType)})
+	assert.Equal(t, map[string]string{"README.txt": "5d41402abc4b2a76b9719d911017c592"}, files)
+}
+
+func TestDirectoryChanges(t *testing.T) {
+	local := map[string]string{"new.txt": "1", "same.txt": "2", "changed.txt": "3", "pending.txt": "4"}
+	remote := map[string]string{"same.txt": "2", "changed.txt": "0", "pending.txt": "", "removed.txt": "5", "extra.txt": "6"}
+	prior := map[string]string{"same.txt": "2", "changed.txt": "0", "pending.txt": "4", "removed.txt": "5"}
+
+	uploads, deletes := directoryChanges(local, remote, prior, false)
+	assert.Equal(t, []string{"changed.txt", "new.txt"}, uploads)
+	assert.Equal(t, []string{"removed.txt"}, deletes)
+
+	_, deletes = directoryChanges(local, remote, prior, true)
+	assert.Equal(t, []string{"extra.txt", "removed.txt"}, deletes)
+}
+
+func TestDirectoryRefreshFiles(t *testing.T) {
+	prior := map[string]string{"same.txt": "1", "changed.txt": "2", "pending.txt": "3", "deleted.txt": "4"}
+	remote := map[string]string{"same.txt": "1", "changed.txt": "0", "pending.txt": "", "extra.txt": "5"}
+
+	assert.Equal(t, map[string]string{"same.txt": "1", "changed.txt": "0", "pending.txt": "3"}, directoryRefreshFiles(prior, remote, false))
+	assert.Equal(t, map[string]string{"same.txt": "1", "changed.txt": "0", "pending.txt": "3", "extra.txt": "5"}, directoryRefreshFiles(prior, remote, true))
+}
+
+func TestDirectoryPartialFailure(t *testing.T) {
+	ctx := context.Background()
+	var lock sync.Mutex
+	requests := []string{}
+	remote := []files_sdk.File{
+		{Path: "dest/changed.txt", Type: "file", Md5: "old"},
+		{Path: "dest/gone.txt", Type: "file", Md5: "gone"},
+		{Path: "dest/removed.txt", Type: "file", Md5: "removed"},
+	}
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		lock.Lock()
+		requests = append(requests, req.Method+" "+req.URL.Path)
+		lock.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case req.URL.Path == "/api/rest/v1/file_actions/begin_upload/dest/changed.txt", req.URL.Path == "/api/rest/v1/files/dest/gone.txt", req.URL.Path == "/api/rest/v1/files/b.txt":
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(map[string]interface{}{"type": "processing-failure", "http-code": 422, "error": "Processing failure"})
+		case strings.HasPrefix(req.URL.Path, "/api/rest/v1/file_actions/begin_upload/"):
+			destination := strings.TrimPrefix(req.URL.Path, "/api/rest/v1/file_actions/begin_upload/")
+			json.NewEncoder(w).Encode([]map[string]interface{}{{"upload_uri": server.URL + "/upload/" + destination, "ref": "ref", "http_method": "PUT", "action": "put/write", "parts": 1, "part_number": 1, "parallel_parts": false, "path": destination}})
+		case strings.HasPrefix(req.URL.Path, "/upload/"):
+			w.WriteHeader(http.StatusOK)
+		case strings.HasPrefix(req.URL.Path, "/api/rest/v1/folders/"):
+			json.NewEncoder(w).Encode(remote)
+		case req.Method == http.MethodDelete:
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			json.NewEncoder(w).Encode(files_sdk.File{Path: strings.TrimPrefix(req.URL.Path, "/api/rest/v1/files/"), Type: "file"})
+		}
+	}))
+	defer server.Close()
+	r := &directoryResource{client: &file.Client{Config: files_sdk.Config{APIKey: "api-key", EndpointOverride: server.URL}.Init()}}
+
+	source := t.TempDir()
+	for name, content := range map[string]string{"changed.txt": "changed", "new.txt": "new"} {
+		if err := os.WriteFile(filepath.Join(source, name), []byte(content), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	model := directoryResourceModel{
+		Source:           types.StringValue(source),
+		Path:             lib.NormalizedPathValue("dest"),
+		Include:          types.ListNull(types.StringType),
+		Exclude:          types.ListNull(types.StringType),
+		DeleteExtraFiles: types.BoolValue(false),
+		Files:            types.MapUnknown(types.StringType),
+	}
+
+	// A failed upload keeps the checksum of the last apply and a failed delete
+	// keeps the file, so both are retried by the next apply.
+	diags := r.sync(ctx, &model, map[string]string{"changed.txt": "old", "gone.txt": "gone", "removed.txt": "removed"})
+	if assert.Len(t, diags, 2) {
+		assert.Equal(t, "Error Uploading Files Directory", diags[0].Summary())
+		assert.Contains(t, diags[0].Detail(), "dest/changed.txt")
+		assert.Equal(t, "Error Deleting Files Directory", diags[1].Summary())
+		assert.Contains(t, diags[1].Detail(), "dest/gone.txt")
+	}
+	files := map[string]string{}
+	model.Files.ElementsAs(ctx, &files, false)
+	assert.Equal(t, map[string]string{"changed.txt": "old", "new.txt": "22af645d1859cb5ca6da0c484f1f37ea", "gone.txt": "gone"}, files)
+	assert.Contains(t, requests, "DELETE /api/rest/v1/files/dest/removed.txt")
+
+	// Delete deletes every other file when one of them fails.
+	directorySchema, objectType := resourceSchema(r)
+	requests = requests[:0]
+	resp := &frameworkresource.DeleteResponse{}
+	r.Delete(ctx, frameworkresource.DeleteRequest{
+		State: tfsdk.State{Schema: directorySchema, Raw: objectValue(objectType, map[string]tftypes.Value{
+			"files": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{
+				"a.txt": tftypes.NewValue(tftypes.String, "a"),
+				"b.txt": tftypes.NewValue(tftypes.String, "b"),
+			}),
+		})},
+	}, resp)
+	assert.ElementsMatch(t, []string{"DELETE /api/rest/v1/files/a.txt", "DELETE /api/rest/v1/files/b.txt"}, requests)
+	if assert.Len(t, resp.Diagnostics, 1) {
+		assert.Equal(t, "Error Deleting Files Directory", resp.Diagnostics[0].Summary())
+		assert.Contains(t, resp.Diagnostics[0].Detail(), "Could not delete file path b.txt")
+	}
+}
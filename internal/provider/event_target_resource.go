@@ -3,6 +3,8 @@ package provider
 import (
 	"context"
 	"fmt"
+	"maps"
+	"slices"
 	"strconv"
 	"strings"
 
@@ -10,14 +12,17 @@ import (
 	event_target "github.com/Files-com/files-sdk-go/v3/eventtarget"
 	"github.com/Files-com/terraform-provider-files/lib"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/dynamicplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
 
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -26,20 +31,55 @@ import (
 )
 
 var (
-	_ resource.Resource                = &eventTargetResource{}
-	_ resource.ResourceWithConfigure   = &eventTargetResource{}
-	_ resource.ResourceWithImportState = &eventTargetResource{}
+	_ resource.Resource                   = &eventTargetResource{}
+	_ resource.ResourceWithConfigure      = &eventTargetResource{}
+	_ resource.ResourceWithImportState    = &eventTargetResource{}
+	_ resource.ResourceWithIdentity       = &eventTargetResource{}
+	_ resource.ResourceWithModifyPlan     = &eventTargetResource{}
+	_ resource.ResourceWithUpgradeState   = &eventTargetResource{}
+	_ resource.ResourceWithValidateConfig = &eventTargetResource{}
 )
 
+// eventTargetConfigVariants names the attribute of config that holds the
+// settings for each target_type.
+var eventTargetConfigVariants = []lib.JSONSchemaVariant{{Name: "email", Value: "email"}, {Name: "webhook", Value: "webhook"}, {Name: "slack_webhook", Value: "slack_webhook"}, {Name: "teams_webhook", Value: "teams_webhook"}, {Name: "amazon_sns", Value: "amazon_sns"}, {Name: "google_pubsub", Value: "google_pubsub"}, {Name: "folder", Value: "folder"}}
+
+// eventTargetConfigSecrets lists the sensitive config fields of each target
+// type. The API does not return them, so they are kept from the prior state.
+var eventTargetConfigSecrets = map[string][][]string{
+	"webhook":       {{"secret"}, {"headers"}},
+	"slack_webhook": {{"url"}},
+	"teams_webhook": {{"url"}},
+	"amazon_sns":    {{"aws_credentials", "secret_access_key"}},
+	"google_pubsub": {{"google_credentials"}},
+}
+
+// eventTargetBatchedTypes lists the target types that support delivery_policy.batch_interval.
+var eventTargetBatchedTypes = []string{"email", "folder"}
+
 func NewEventTargetResource() resource.Resource {
 	return &eventTargetResource{}
 }
 
 type eventTargetResource struct {
-	client *event_target.Client
+	client             *event_target.Client
+	defaultWorkspaceId types.Int64
 }
 
 type eventTargetResourceModel struct {
+	Name                 types.String `tfsdk:"name"`
+	TargetType           types.String `tfsdk:"target_type"`
+	Config               types.Object `tfsdk:"config"`
+	WorkspaceId          types.Int64  `tfsdk:"workspace_id"`
+	ApplyToAllWorkspaces types.Bool   `tfsdk:"apply_to_all_workspaces"`
+	Enabled              types.Bool   `tfsdk:"enabled"`
+	DeliveryPolicy       types.Object `tfsdk:"delivery_policy"`
+	Id                   types.Int64  `tfsdk:"id"`
+	CreatedAt            types.String `tfsdk:"created_at"`
+	UpdatedAt            types.String `tfsdk:"updated_at"`
+}
+
+type eventTargetResourceModelV0 struct {
 	Name                 types.String  `tfsdk:"name"`
 	TargetType           types.String  `tfsdk:"target_type"`
 	Config               types.Dynamic `tfsdk:"config"`
@@ -57,18 +97,19 @@ func (r *eventTargetResource) Configure(_ context.Context, req resource.Configur
 		return
 	}
 
-	sdk_config, ok := req.ProviderData.(files_sdk.Config)
+	provider_data, ok := req.ProviderData.(providerResourceData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected files_sdk.Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected providerResourceData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.client = &event_target.Client{Config: sdk_config}
+	r.client = &event_target.Client{Config: provider_data.Config}
+	r.defaultWorkspaceId = provider_data.DefaultWorkspaceId
 }
 
 func (r *eventTargetResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -76,7 +117,22 @@ func (r *eventTargetResource) Metadata(_ context.Context, req resource.MetadataR
 }
 
 func (r *eventTargetResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
-	resp.Schema = schema.Schema{
+	resp.Schema = r.resourceSchema()
+}
+
+func (r *eventTargetResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.Int64Attribute{
+				Description:       "Event Target ID",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+func (r *eventTargetResource) resourceSchema() schema.Schema {
+	return schema.Schema{
 		Description: "An EventTarget is a delivery destination for EventRecords.",
 		Attributes: map[string]schema.Attribute{
 			"name": schema.StringAttribute{
@@ -93,9 +149,157 @@ func (r *eventTargetResource) Schema(_ context.Context, _ resource.SchemaRequest
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
-			"config": schema.DynamicAttribute{
-				Description: "Event Target configuration. Folder targets accept path and format (json or csv).",
+			"config": schema.SingleNestedAttribute{
+				Description: "Event Target configuration. Set exactly one attribute, named after the `target_type`.",
 				Required:    true,
+				Validators:  []validator.Object{lib.ExactlyOneOfAttributes("email", "webhook", "slack_webhook", "teams_webhook", "amazon_sns", "google_pubsub", "folder")},
+				Attributes: map[string]schema.Attribute{
+					"email": schema.SingleNestedAttribute{
+						Description: "Configuration for `email` targets.",
+						Optional:    true,
+						Attributes: map[string]schema.Attribute{
+							"emails": schema.ListAttribute{
+								Description: "Email addresses to deliver events to.",
+								Required:    true,
+								ElementType: types.StringType,
+							},
+						},
+					},
+					"webhook": schema.SingleNestedAttribute{
+						Description: "Configuration for `webhook` targets.",
+						Optional:    true,
+						Attributes: map[string]schema.Attribute{
+							"url": schema.StringAttribute{
+								Description: "URL to deliver events to.",
+								Required:    true,
+							},
+							"method": schema.StringAttribute{
+								Description: "HTTP method used to deliver events.",
+								Computed:    true,
+								Optional:    true,
+								Validators:  []validator.String{stringvalidator.OneOf("GET", "POST")},
+								PlanModifiers: []planmodifier.String{
+									stringplanmodifier.UseStateForUnknown(),
+								},
+							},
+							"headers": schema.MapAttribute{
+								Description: "Additional HTTP headers sent with each delivery.",
+								Optional:    true,
+								Sensitive:   true,
+								ElementType: types.StringType,
+							},
+							"secret": schema.StringAttribute{
+								Description: "Shared secret used to sign each delivery, so the receiver can verify that it came from Files.com.",
+								Optional:    true,
+								Sensitive:   true,
+							},
+						},
+					},
+					"slack_webhook": schema.SingleNestedAttribute{
+						Description: "Configuration for `slack_webhook` targets.",
+						Optional:    true,
+						Attributes: map[string]schema.Attribute{
+							"url": schema.StringAttribute{
+								Description: "Slack incoming webhook URL.",
+								Required:    true,
+								Sensitive:   true,
+							},
+							"channel": schema.StringAttribute{
+								Description: "Slack channel to post to, instead of the webhook's default channel.",
+								Optional:    true,
+							},
+							"username": schema.StringAttribute{
+								Description: "Name to post as.",
+								Optional:    true,
+							},
+							"icon_emoji": schema.StringAttribute{
+								Description: "Emoji to use as the icon, for example `:robot_face:`.",
+								Optional:    true,
+							},
+						},
+					},
+					"teams_webhook": schema.SingleNestedAttribute{
+						Description: "Configuration for `teams_webhook` targets.",
+						Optional:    true,
+						Attributes: map[string]schema.Attribute{
+							"url": schema.StringAttribute{
+								Description: "Microsoft Teams incoming webhook URL.",
+								Required:    true,
+								Sensitive:   true,
+							},
+						},
+					},
+					"amazon_sns": schema.SingleNestedAttribute{
+						Description: "Configuration for `amazon_sns` targets.",
+						Optional:    true,
+						Attributes: map[string]schema.Attribute{
+							"arns": schema.ListAttribute{
+								Description: "ARNs of the SNS topics to publish events to.",
+								Required:    true,
+								ElementType: types.StringType,
+							},
+							"aws_credentials": schema.SingleNestedAttribute{
+								Description: "AWS credentials used to publish to the topics.",
+								Optional:    true,
+								Attributes: map[string]schema.Attribute{
+									"access_key_id": schema.StringAttribute{
+										Description: "AWS access key ID.",
+										Required:    true,
+									},
+									"secret_access_key": schema.StringAttribute{
+										Description: "AWS secret access key.",
+										Required:    true,
+										Sensitive:   true,
+									},
+									"region": schema.StringAttribute{
+										Description: "AWS region of the topics.",
+										Optional:    true,
+									},
+								},
+							},
+						},
+					},
+					"google_pubsub": schema.SingleNestedAttribute{
+						Description: "Configuration for `google_pubsub` targets.",
+						Optional:    true,
+						Attributes: map[string]schema.Attribute{
+							"project_id": schema.StringAttribute{
+								Description: "Google Cloud project ID of the topic.",
+								Required:    true,
+							},
+							"topic_id": schema.StringAttribute{
+								Description: "Pub/Sub topic ID to publish events to.",
+								Required:    true,
+							},
+							"google_credentials": schema.MapAttribute{
+								Description: "Fields of the service account key used to publish to the topic, such as `client_email` and `private_key`.",
+								Optional:    true,
+								Sensitive:   true,
+								ElementType: types.StringType,
+							},
+						},
+					},
+					"folder": schema.SingleNestedAttribute{
+						Description: "Configuration for `folder` targets.",
+						Optional:    true,
+						Attributes: map[string]schema.Attribute{
+							"path": schema.StringAttribute{
+								Description: "Path of the folder to write events to.",
+								Required:    true,
+								CustomType:  lib.NormalizedPathType{},
+							},
+							"format": schema.StringAttribute{
+								Description: "Format of the files written to the folder.",
+								Computed:    true,
+								Optional:    true,
+								Validators:  []validator.String{stringvalidator.OneOf("json", "csv")},
+								PlanModifiers: []planmodifier.String{
+									stringplanmodifier.UseStateForUnknown(),
+								},
+							},
+						},
+					},
+				},
 			},
 			"workspace_id": schema.Int64Attribute{
 				Description: "Workspace ID. 0 means the default workspace or site-wide.",
@@ -121,12 +325,19 @@ func (r *eventTargetResource) Schema(_ context.Context, _ resource.SchemaRequest
 					boolplanmodifier.UseStateForUnknown(),
 				},
 			},
-			"delivery_policy": schema.DynamicAttribute{
-				Description: "Event Target delivery policy. Email and folder targets support batch_interval in seconds, between 600 and 86400.",
+			"delivery_policy": schema.SingleNestedAttribute{
+				Description: "Event Target delivery policy.",
 				Computed:    true,
 				Optional:    true,
-				PlanModifiers: []planmodifier.Dynamic{
-					dynamicplanmodifier.UseStateForUnknown(),
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.UseStateForUnknown(),
+				},
+				Attributes: map[string]schema.Attribute{
+					"batch_interval": schema.Int64Attribute{
+						Description: "Seconds to collect events for before delivering them together, between 600 and 86400. Only email and folder targets support batching.",
+						Optional:    true,
+						Validators:  []validator.Int64{int64validator.Between(600, 86400)},
+					},
 				},
 			},
 			"id": schema.Int64Attribute{
@@ -145,9 +356,165 @@ func (r *eventTargetResource) Schema(_ context.Context, _ resource.SchemaRequest
 				Computed:    true,
 			},
 		},
+		Version: 1,
+	}
+}
+
+func (r *eventTargetResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: {
+			PriorSchema: &schema.Schema{
+				Description: "An EventTarget is a delivery destination for EventRecords.",
+				Attributes: map[string]schema.Attribute{
+					"name": schema.StringAttribute{
+						Description: "Event Target name.",
+						Required:    true,
+					},
+					"target_type": schema.StringAttribute{
+						Description: "Event Target type.",
+						Required:    true,
+						Validators: []validator.String{
+							stringvalidator.OneOf("email", "webhook", "slack_webhook", "teams_webhook", "amazon_sns", "google_pubsub", "folder"),
+						},
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.RequiresReplace(),
+						},
+					},
+					"config": schema.DynamicAttribute{
+						Description: "Event Target configuration. Folder targets accept path and format (json or csv).",
+						Required:    true,
+					},
+					"workspace_id": schema.Int64Attribute{
+						Description: "Workspace ID. 0 means the default workspace or site-wide.",
+						Computed:    true,
+						Optional:    true,
+						PlanModifiers: []planmodifier.Int64{
+							int64planmodifier.UseStateForUnknown(),
+						},
+					},
+					"apply_to_all_workspaces": schema.BoolAttribute{
+						Description: "If true, this default-workspace target can receive events from all workspaces.",
+						Computed:    true,
+						Optional:    true,
+						PlanModifiers: []planmodifier.Bool{
+							boolplanmodifier.UseStateForUnknown(),
+						},
+					},
+					"enabled": schema.BoolAttribute{
+						Description: "Whether this Event Target can receive events.",
+						Computed:    true,
+						Optional:    true,
+						PlanModifiers: []planmodifier.Bool{
+							boolplanmodifier.UseStateForUnknown(),
+						},
+					},
+					"delivery_policy": schema.DynamicAttribute{
+						Description: "Event Target delivery policy. Email and folder targets support batch_interval in seconds, between 600 and 86400.",
+						Computed:    true,
+						Optional:    true,
+						PlanModifiers: []planmodifier.Dynamic{
+							dynamicplanmodifier.UseStateForUnknown(),
+						},
+					},
+					"id": schema.Int64Attribute{
+						Description: "Event Target ID",
+						Computed:    true,
+						PlanModifiers: []planmodifier.Int64{
+							int64planmodifier.UseStateForUnknown(),
+						},
+					},
+					"created_at": schema.StringAttribute{
+						Description: "Event Target create date/time.",
+						Computed:    true,
+					},
+					"updated_at": schema.StringAttribute{
+						Description: "Event Target update date/time.",
+						Computed:    true,
+					},
+				},
+				Version: 0,
+			},
+			StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+				var priorState eventTargetResourceModelV0
+				resp.Diagnostics.Append(req.State.Get(ctx, &priorState)...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+
+				upgradedState := eventTargetResourceModel{
+					Name:                 priorState.Name,
+					TargetType:           priorState.TargetType,
+					WorkspaceId:          priorState.WorkspaceId,
+					ApplyToAllWorkspaces: priorState.ApplyToAllWorkspaces,
+					Enabled:              priorState.Enabled,
+					Id:                   priorState.Id,
+					CreatedAt:            priorState.CreatedAt,
+					UpdatedAt:            priorState.UpdatedAt,
+				}
+				currentSchema := r.resourceSchema()
+				configValue, conversionDiags := lib.DynamicToInterface(ctx, path.Root("config"), priorState.Config)
+				resp.Diagnostics.Append(conversionDiags...)
+				configValue, transformDiags0 := lib.WrapDiscriminatedUnion(ctx, path.Root("config"), configValue, priorState.TargetType.ValueString(), eventTargetConfigVariants)
+				resp.Diagnostics.Append(transformDiags0...)
+				configType := currentSchema.Attributes["config"].GetType().(types.ObjectType)
+				upgradedState.Config, conversionDiags = lib.ToObject(ctx, path.Root("config"), configValue, types.ObjectNull(configType.AttrTypes))
+				resp.Diagnostics.Append(conversionDiags...)
+				deliveryPolicyValue, conversionDiags := lib.DynamicToInterface(ctx, path.Root("delivery_policy"), priorState.DeliveryPolicy)
+				resp.Diagnostics.Append(conversionDiags...)
+				deliveryPolicyType := currentSchema.Attributes["delivery_policy"].GetType().(types.ObjectType)
+				upgradedState.DeliveryPolicy, conversionDiags = lib.ToObject(ctx, path.Root("delivery_policy"), deliveryPolicyValue, types.ObjectNull(deliveryPolicyType.AttrTypes))
+				resp.Diagnostics.Append(conversionDiags...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+
+				resp.Diagnostics.Append(resp.State.Set(ctx, upgradedState)...)
+			},
+		},
 	}
 }
 
+func (r *eventTargetResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config eventTargetResourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.TargetType.IsNull() || config.TargetType.IsUnknown() {
+		return
+	}
+	targetType := config.TargetType.ValueString()
+
+	if !config.Config.IsNull() && !config.Config.IsUnknown() {
+		for name, value := range config.Config.Attributes() {
+			if name != targetType && !value.IsNull() && !value.IsUnknown() {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("config").AtName(name),
+					"Invalid Event Target Config",
+					fmt.Sprintf("Event Targets of type %q are configured with config.%s, not config.%s.", targetType, targetType, name),
+				)
+			}
+		}
+	}
+
+	if !config.DeliveryPolicy.IsNull() && !config.DeliveryPolicy.IsUnknown() && !slices.Contains(eventTargetBatchedTypes, targetType) {
+		batchInterval := config.DeliveryPolicy.Attributes()["batch_interval"]
+		if !batchInterval.IsNull() && !batchInterval.IsUnknown() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("delivery_policy").AtName("batch_interval"),
+				"Invalid Event Target Delivery Policy",
+				fmt.Sprintf("Event Targets of type %q do not support batch_interval. Only %s targets do.", targetType, strings.Join(eventTargetBatchedTypes, " and ")),
+			)
+		}
+	}
+}
+
+func (r *eventTargetResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyPlanDefaultWorkspaceId(ctx, r.defaultWorkspaceId, req, resp)
+}
+
 func (r *eventTargetResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan eventTargetResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -171,10 +538,12 @@ func (r *eventTargetResource) Create(ctx context.Context, req resource.CreateReq
 	if !plan.Enabled.IsNull() && !plan.Enabled.IsUnknown() {
 		paramsEventTargetCreate.Enabled = plan.Enabled.ValueBoolPointer()
 	}
-	createConfig, diags := lib.DynamicToInterface(ctx, path.Root("config"), plan.Config)
+	createConfig, diags := lib.SchemaAttributeToInterface(ctx, path.Root("config"), plan.Config)
 	resp.Diagnostics.Append(diags...)
+	_, createConfig, transformDiags0 := lib.UnwrapDiscriminatedUnion(ctx, path.Root("config"), createConfig, eventTargetConfigVariants)
+	resp.Diagnostics.Append(transformDiags0...)
 	paramsEventTargetCreate.Config = createConfig
-	createDeliveryPolicy, diags := lib.DynamicToInterface(ctx, path.Root("delivery_policy"), plan.DeliveryPolicy)
+	createDeliveryPolicy, diags := lib.SchemaAttributeToInterface(ctx, path.Root("delivery_policy"), plan.DeliveryPolicy)
 	resp.Diagnostics.Append(diags...)
 	paramsEventTargetCreate.DeliveryPolicy = createDeliveryPolicy
 	paramsEventTargetCreate.TargetType = paramsEventTargetCreate.TargetType.Enum()[plan.TargetType.ValueString()]
@@ -200,6 +569,12 @@ func (r *eventTargetResource) Create(ctx context.Context, req resource.CreateReq
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.Identity.SetAttribute(ctx, path.Root("id"), plan.Id)
+	resp.Diagnostics.Append(diags...)
 }
 
 func (r *eventTargetResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -235,6 +610,12 @@ func (r *eventTargetResource) Read(ctx context.Context, req resource.ReadRequest
 
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.Identity.SetAttribute(ctx, path.Root("id"), state.Id)
+	resp.Diagnostics.Append(diags...)
 }
 
 func (r *eventTargetResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -267,12 +648,18 @@ func (r *eventTargetResource) Update(ctx context.Context, req resource.UpdateReq
 	if !config.Enabled.IsNull() && !config.Enabled.IsUnknown() {
 		paramsEventTargetUpdate["enabled"] = config.Enabled.ValueBool()
 	}
-	updateConfig, diags := lib.DynamicToInterface(ctx, path.Root("config"), config.Config)
-	resp.Diagnostics.Append(diags...)
-	paramsEventTargetUpdate["config"] = updateConfig
-	updateDeliveryPolicy, diags := lib.DynamicToInterface(ctx, path.Root("delivery_policy"), config.DeliveryPolicy)
-	resp.Diagnostics.Append(diags...)
-	paramsEventTargetUpdate["delivery_policy"] = updateDeliveryPolicy
+	if !config.Config.IsNull() && !config.Config.IsUnknown() {
+		updateConfig, diags := lib.SchemaAttributeToInterface(ctx, path.Root("config"), config.Config)
+		resp.Diagnostics.Append(diags...)
+		_, updateConfig, transformDiags0 := lib.UnwrapDiscriminatedUnion(ctx, path.Root("config"), updateConfig, eventTargetConfigVariants)
+		resp.Diagnostics.Append(transformDiags0...)
+		paramsEventTargetUpdate["config"] = updateConfig
+	}
+	if !config.DeliveryPolicy.IsNull() && !config.DeliveryPolicy.IsUnknown() {
+		updateDeliveryPolicy, diags := lib.SchemaAttributeToInterface(ctx, path.Root("delivery_policy"), config.DeliveryPolicy)
+		resp.Diagnostics.Append(diags...)
+		paramsEventTargetUpdate["delivery_policy"] = updateDeliveryPolicy
+	}
 
 	if resp.Diagnostics.HasError() {
 		return
@@ -295,6 +682,12 @@ func (r *eventTargetResource) Update(ctx context.Context, req resource.UpdateReq
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.Identity.SetAttribute(ctx, path.Root("id"), plan.Id)
+	resp.Diagnostics.Append(diags...)
 }
 
 func (r *eventTargetResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -318,6 +711,13 @@ func (r *eventTargetResource) Delete(ctx context.Context, req resource.DeleteReq
 }
 
 func (r *eventTargetResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID == "" {
+		var id types.Int64
+		resp.Diagnostics.Append(req.Identity.GetAttribute(ctx, path.Root("id"), &id)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+		return
+	}
+
 	idParts := strings.SplitN(req.ID, ",", 1)
 
 	if len(idParts) != 1 || idParts[0] == "" {
@@ -349,9 +749,13 @@ func (r *eventTargetResource) populateResourceModel(ctx context.Context, eventTa
 	state.WorkspaceId = types.Int64Value(eventTarget.WorkspaceId)
 	state.ApplyToAllWorkspaces = types.BoolPointerValue(eventTarget.ApplyToAllWorkspaces)
 	state.Enabled = types.BoolPointerValue(eventTarget.Enabled)
-	state.Config, propDiags = lib.ToDynamic(ctx, path.Root("config"), eventTarget.Config, state.Config.UnderlyingValue())
+	configValue, transformDiags0 := lib.WrapDiscriminatedUnion(ctx, path.Root("config"), eventTarget.Config, eventTarget.TargetType, eventTargetConfigVariants)
+	diags.Append(transformDiags0...)
+	configValue, propDiags = eventTargetConfigWithSecrets(ctx, configValue, state.Config)
 	diags.Append(propDiags...)
-	state.DeliveryPolicy, propDiags = lib.ToDynamic(ctx, path.Root("delivery_policy"), eventTarget.DeliveryPolicy, state.DeliveryPolicy.UnderlyingValue())
+	state.Config, propDiags = lib.ToObject(ctx, path.Root("config"), configValue, state.Config)
+	diags.Append(propDiags...)
+	state.DeliveryPolicy, propDiags = lib.ToObject(ctx, path.Root("delivery_policy"), eventTarget.DeliveryPolicy, state.DeliveryPolicy)
 	diags.Append(propDiags...)
 	if err := lib.TimeToStringType(ctx, path.Root("created_at"), eventTarget.CreatedAt, &state.CreatedAt); err != nil {
 		diags.AddError(
@@ -368,3 +772,62 @@ func (r *eventTargetResource) populateResourceModel(ctx context.Context, eventTa
 
 	return
 }
+
+// eventTargetConfigWithSecrets copies the secrets listed in
+// eventTargetConfigSecrets from prior into the config read from the API, which
+// leaves them out. Changes made to secrets outside of Terraform are therefore
+// not detected.
+func eventTargetConfigWithSecrets(ctx context.Context, config any, prior types.Object) (any, diag.Diagnostics) {
+	priorValue, diags := lib.SchemaAttributeToInterface(ctx, path.Root("config"), prior)
+	if diags.HasError() {
+		return config, diags
+	}
+	priorConfig, ok := priorValue.(map[string]interface{})
+	if !ok {
+		return config, nil
+	}
+	wrapper, ok := config.(map[string]interface{})
+	if !ok {
+		return config, nil
+	}
+
+	for targetType, secrets := range eventTargetConfigSecrets {
+		priorFields, ok := priorConfig[targetType].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		fields, ok := wrapper[targetType].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		fields = maps.Clone(fields)
+		for _, secret := range secrets {
+			fields = setEventTargetConfigSecret(fields, priorFields, secret)
+		}
+		wrapper = maps.Clone(wrapper)
+		wrapper[targetType] = fields
+	}
+	return wrapper, nil
+}
+
+func setEventTargetConfigSecret(fields map[string]interface{}, priorFields map[string]interface{}, secret []string) map[string]interface{} {
+	priorValue, ok := priorFields[secret[0]]
+	if !ok {
+		return fields
+	}
+	if len(secret) == 1 {
+		fields[secret[0]] = priorValue
+		return fields
+	}
+	priorNested, ok := priorValue.(map[string]interface{})
+	if !ok {
+		return fields
+	}
+	nested, ok := fields[secret[0]].(map[string]interface{})
+	if !ok {
+		fields[secret[0]] = priorNested
+		return fields
+	}
+	fields[secret[0]] = setEventTargetConfigSecret(maps.Clone(nested), priorNested, secret[1:])
+	return fields
+}
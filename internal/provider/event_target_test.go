@@ -0,0 +1,210 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	files_sdk "github.com/Files-com/files-sdk-go/v3"
+	"github.com/Files-com/terraform-provider-files/lib"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	frameworkresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEventTargetValidateConfig(t *testing.T) {
+	ctx := context.Background()
+	r := &eventTargetResource{}
+	eventTargetSchema, objectType := resourceSchema(r)
+	configType := objectType.AttributeTypes["config"].(tftypes.Object)
+	deliveryPolicyType := objectType.AttributeTypes["delivery_policy"].(tftypes.Object)
+
+	webhook := objectValue(configType.AttributeTypes["webhook"].(tftypes.Object), map[string]tftypes.Value{"url": tftypes.NewValue(tftypes.String, "https://example.com/hook")})
+	folder := objectValue(configType.AttributeTypes["folder"].(tftypes.Object), map[string]tftypes.Value{"path": tftypes.NewValue(tftypes.String, "events")})
+	batchInterval := objectValue(deliveryPolicyType, map[string]tftypes.Value{"batch_interval": tftypes.NewValue(tftypes.Number, 900)})
+
+	tests := []struct {
+		name           string
+		targetType     tftypes.Value
+		config         map[string]tftypes.Value
+		deliveryPolicy tftypes.Value
+		summary        string
+		path           path.Path
+	}{
+		{name: "webhook", targetType: tftypes.NewValue(tftypes.String, "webhook"), config: map[string]tftypes.Value{"webhook": webhook}},
+		{name: "batched folder", targetType: tftypes.NewValue(tftypes.String, "folder"), config: map[string]tftypes.Value{"folder": folder}, deliveryPolicy: batchInterval},
+		{name: "unknown target type", targetType: tftypes.NewValue(tftypes.String, tftypes.UnknownValue), config: map[string]tftypes.Value{"webhook": webhook}, deliveryPolicy: batchInterval},
+		{name: "mismatched config", targetType: tftypes.NewValue(tftypes.String, "folder"), config: map[string]tftypes.Value{"webhook": webhook}, summary: "Invalid Event Target Config", path: path.Root("config").AtName("webhook")},
+		{name: "batched webhook", targetType: tftypes.NewValue(tftypes.String, "webhook"), config: map[string]tftypes.Value{"webhook": webhook}, deliveryPolicy: batchInterval, summary: "Invalid Event Target Delivery Policy", path: path.Root("delivery_policy").AtName("batch_interval")},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			deliveryPolicy := test.deliveryPolicy
+			if deliveryPolicy.Type() == nil {
+				deliveryPolicy = tftypes.NewValue(deliveryPolicyType, nil)
+			}
+			raw := objectValue(objectType, map[string]tftypes.Value{
+				"name":            tftypes.NewValue(tftypes.String, "example"),
+				"target_type":     test.targetType,
+				"config":          objectValue(configType, test.config),
+				"delivery_policy": deliveryPolicy,
+			})
+			resp := &frameworkresource.ValidateConfigResponse{}
+			r.ValidateConfig(ctx, frameworkresource.ValidateConfigRequest{Config: tfsdk.Config{Schema: eventTargetSchema, Raw: raw}}, resp)
+			assertAttributeError(t, resp.Diagnostics, test.summary, test.path)
+		})
+	}
+}
+
+func TestEventTargetConfigWithSecrets(t *testing.T) {
+	ctx := context.Background()
+	eventTargetSchema, _ := resourceSchema(&eventTargetResource{})
+	configType := eventTargetSchema.Attributes["config"].GetType().(types.ObjectType)
+
+	object := func(objectType types.ObjectType, values map[string]attr.Value) types.Object {
+		attributes := map[string]attr.Value{}
+		for name, attributeType := range objectType.AttrTypes {
+			attributes[name], _ = attributeType.ValueFromTerraform(ctx, tftypes.NewValue(attributeType.TerraformType(ctx), nil))
+		}
+		for name, value := range values {
+			attributes[name] = value
+		}
+		return types.ObjectValueMust(objectType.AttrTypes, attributes)
+	}
+	snsType := configType.AttrTypes["amazon_sns"].(types.ObjectType)
+	credentialsType := snsType.AttrTypes["aws_credentials"].(types.ObjectType)
+	prior := object(configType, map[string]attr.Value{
+		"amazon_sns": object(snsType, map[string]attr.Value{
+			"arns": types.ListValueMust(types.StringType, []attr.Value{types.StringValue("arn:aws:sns:us-east-1:123456789012:events")}),
+			"aws_credentials": object(credentialsType, map[string]attr.Value{
+				"access_key_id":     types.StringValue("AKIAEXAMPLE"),
+				"secret_access_key": types.StringValue("secret"),
+			}),
+		}),
+	})
+
+	tests := []struct {
+		name     string
+		config   map[string]interface{}
+		expected map[string]interface{}
+	}{
+		{
+			name:     "omitted secret",
+			config:   map[string]interface{}{"arns": []interface{}{"arn"}, "aws_credentials": map[string]interface{}{"access_key_id": "AKIAEXAMPLE"}},
+			expected: map[string]interface{}{"arns": []interface{}{"arn"}, "aws_credentials": map[string]interface{}{"access_key_id": "AKIAEXAMPLE", "secret_access_key": "secret"}},
+		},
+		{
+			name:     "omitted credentials",
+			config:   map[string]interface{}{"arns": []interface{}{"arn"}},
+			expected: map[string]interface{}{"arns": []interface{}{"arn"}, "aws_credentials": map[string]interface{}{"access_key_id": "AKIAEXAMPLE", "secret_access_key": "secret"}},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			config, diags := eventTargetConfigWithSecrets(ctx, map[string]interface{}{"amazon_sns": test.config}, prior)
+			assert.False(t, diags.HasError(), diags)
+			assert.Equal(t, map[string]interface{}{"amazon_sns": test.expected}, config)
+		})
+	}
+}
+
+func TestEventTargetFolderPath(t *testing.T) {
+	ctx := context.Background()
+	r := &eventTargetResource{}
+	eventTargetSchema, _ := resourceSchema(r)
+	configType := eventTargetSchema.Attributes["config"].GetType().(types.ObjectType)
+	deliveryPolicyType := eventTargetSchema.Attributes["delivery_policy"].GetType().(types.ObjectType)
+
+	state := eventTargetResourceModel{Config: types.ObjectNull(configType.AttrTypes), DeliveryPolicy: types.ObjectNull(deliveryPolicyType.AttrTypes)}
+	diags := r.populateResourceModel(ctx, files_sdk.EventTarget{
+		TargetType: "folder",
+		Config:     map[string]interface{}{"path": "/Events/", "format": "json"},
+	}, &state)
+	require.False(t, diags.HasError(), diags)
+
+	folder := state.Config.Attributes()["folder"].(types.Object)
+	folderPath, ok := folder.Attributes()["path"].(lib.NormalizedPath)
+	require.True(t, ok)
+	equal, diags := folderPath.StringSemanticEquals(ctx, lib.NormalizedPathValue("events"))
+	assert.False(t, diags.HasError(), diags)
+	assert.True(t, equal)
+}
+
+func TestEventTargetUpgradeStateV0(t *testing.T) {
+	ctx := context.Background()
+	r := &eventTargetResource{}
+	eventTargetSchema, _ := resourceSchema(r)
+	upgrader := r.UpgradeState(ctx)[0]
+	priorType := upgrader.PriorSchema.Type().TerraformType(ctx).(tftypes.Object)
+
+	tests := []struct {
+		name           string
+		targetType     string
+		config         tftypes.Value
+		deliveryPolicy tftypes.Value
+		check          func(t *testing.T, state eventTargetResourceModel)
+	}{
+		{
+			name:       "folder",
+			targetType: "folder",
+			config: tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{"path": tftypes.String, "format": tftypes.String}}, map[string]tftypes.Value{
+				"path":   tftypes.NewValue(tftypes.String, "events"),
+				"format": tftypes.NewValue(tftypes.String, "csv"),
+			}),
+			deliveryPolicy: tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{"batch_interval": tftypes.Number}}, map[string]tftypes.Value{
+				"batch_interval": tftypes.NewValue(tftypes.Number, 900),
+			}),
+			check: func(t *testing.T, state eventTargetResourceModel) {
+				folder := state.Config.Attributes()["folder"].(types.Object).Attributes()
+				assert.Equal(t, lib.NormalizedPathValue("events"), folder["path"])
+				assert.Equal(t, types.StringValue("csv"), folder["format"])
+				assert.True(t, state.Config.Attributes()["webhook"].IsNull())
+				assert.Equal(t, types.Int64Value(900), state.DeliveryPolicy.Attributes()["batch_interval"])
+			},
+		},
+		{
+			name:       "webhook",
+			targetType: "webhook",
+			config: tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{"url": tftypes.String, "method": tftypes.String}}, map[string]tftypes.Value{
+				"url":    tftypes.NewValue(tftypes.String, "https://example.com/hook"),
+				"method": tftypes.NewValue(tftypes.String, "POST"),
+			}),
+			deliveryPolicy: tftypes.NewValue(tftypes.DynamicPseudoType, nil),
+			check: func(t *testing.T, state eventTargetResourceModel) {
+				webhook := state.Config.Attributes()["webhook"].(types.Object).Attributes()
+				assert.Equal(t, types.StringValue("https://example.com/hook"), webhook["url"])
+				assert.Equal(t, types.StringValue("POST"), webhook["method"])
+				assert.True(t, webhook["headers"].IsNull())
+				assert.True(t, state.DeliveryPolicy.IsNull())
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			resp := &frameworkresource.UpgradeStateResponse{State: tfsdk.State{Schema: eventTargetSchema}}
+			upgrader.StateUpgrader(ctx, frameworkresource.UpgradeStateRequest{
+				State: &tfsdk.State{Schema: *upgrader.PriorSchema, Raw: objectValue(priorType, map[string]tftypes.Value{
+					"name":            tftypes.NewValue(tftypes.String, "example"),
+					"target_type":     tftypes.NewValue(tftypes.String, test.targetType),
+					"config":          test.config,
+					"delivery_policy": test.deliveryPolicy,
+					"id":              tftypes.NewValue(tftypes.Number, 1),
+				})},
+			}, resp)
+			require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+			var state eventTargetResourceModel
+			require.False(t, resp.State.Get(ctx, &state).HasError())
+			assert.Equal(t, types.StringValue(test.targetType), state.TargetType)
+			assert.Equal(t, types.Int64Value(1), state.Id)
+			test.check(t, state)
+		})
+	}
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/dynamicplanmodifier"
@@ -30,6 +31,8 @@ var (
 	_ resource.Resource                = &expectationResource{}
 	_ resource.ResourceWithConfigure   = &expectationResource{}
 	_ resource.ResourceWithImportState = &expectationResource{}
+	_ resource.ResourceWithIdentity    = &expectationResource{}
+	_ resource.ResourceWithModifyPlan  = &expectationResource{}
 )
 
 func NewExpectationResource() resource.Resource {
@@ -37,39 +40,40 @@ func NewExpectationResource() resource.Resource {
 }
 
 type expectationResource struct {
-	client *expectation.Client
+	client             *expectation.Client
+	defaultWorkspaceId types.Int64
 }
 
 type expectationResourceModel struct {
-	WorkspaceId            types.Int64   `tfsdk:"workspace_id"`
-	Name                   types.String  `tfsdk:"name"`
-	Description            types.String  `tfsdk:"description"`
-	Path                   types.String  `tfsdk:"path"`
-	Source                 types.String  `tfsdk:"source"`
-	ExcludePattern         types.String  `tfsdk:"exclude_pattern"`
-	Disabled               types.Bool    `tfsdk:"disabled"`
-	Trigger                types.String  `tfsdk:"trigger"`
-	Interval               types.String  `tfsdk:"interval"`
-	RecurringDay           types.Int64   `tfsdk:"recurring_day"`
-	RecurringDays          types.List    `tfsdk:"recurring_days"`
-	ScheduleId             types.Int64   `tfsdk:"schedule_id"`
-	ScheduleDaysOfWeek     types.List    `tfsdk:"schedule_days_of_week"`
-	ScheduleTimesOfDay     types.List    `tfsdk:"schedule_times_of_day"`
-	ScheduleTimeZone       types.String  `tfsdk:"schedule_time_zone"`
-	HolidayRegion          types.String  `tfsdk:"holiday_region"`
-	LookbackInterval       types.Int64   `tfsdk:"lookback_interval"`
-	LateAcceptanceInterval types.Int64   `tfsdk:"late_acceptance_interval"`
-	InactivityInterval     types.Int64   `tfsdk:"inactivity_interval"`
-	MaxOpenInterval        types.Int64   `tfsdk:"max_open_interval"`
-	Criteria               types.Dynamic `tfsdk:"criteria"`
-	Id                     types.Int64   `tfsdk:"id"`
-	ExpectationsVersion    types.Int64   `tfsdk:"expectations_version"`
-	LastEvaluatedAt        types.String  `tfsdk:"last_evaluated_at"`
-	LastSuccessAt          types.String  `tfsdk:"last_success_at"`
-	LastFailureAt          types.String  `tfsdk:"last_failure_at"`
-	LastResult             types.String  `tfsdk:"last_result"`
-	CreatedAt              types.String  `tfsdk:"created_at"`
-	UpdatedAt              types.String  `tfsdk:"updated_at"`
+	WorkspaceId            types.Int64        `tfsdk:"workspace_id"`
+	Name                   types.String       `tfsdk:"name"`
+	Description            types.String       `tfsdk:"description"`
+	Path                   lib.NormalizedPath `tfsdk:"path"`
+	Source                 types.String       `tfsdk:"source"`
+	ExcludePattern         types.String       `tfsdk:"exclude_pattern"`
+	Disabled               types.Bool         `tfsdk:"disabled"`
+	Trigger                types.String       `tfsdk:"trigger"`
+	Interval               types.String       `tfsdk:"interval"`
+	RecurringDay           types.Int64        `tfsdk:"recurring_day"`
+	RecurringDays          types.List         `tfsdk:"recurring_days"`
+	ScheduleId             types.Int64        `tfsdk:"schedule_id"`
+	ScheduleDaysOfWeek     types.List         `tfsdk:"schedule_days_of_week"`
+	ScheduleTimesOfDay     types.List         `tfsdk:"schedule_times_of_day"`
+	ScheduleTimeZone       types.String       `tfsdk:"schedule_time_zone"`
+	HolidayRegion          types.String       `tfsdk:"holiday_region"`
+	LookbackInterval       types.Int64        `tfsdk:"lookback_interval"`
+	LateAcceptanceInterval types.Int64        `tfsdk:"late_acceptance_interval"`
+	InactivityInterval     types.Int64        `tfsdk:"inactivity_interval"`
+	MaxOpenInterval        types.Int64        `tfsdk:"max_open_interval"`
+	Criteria               types.Dynamic      `tfsdk:"criteria"`
+	Id                     types.Int64        `tfsdk:"id"`
+	ExpectationsVersion    types.Int64        `tfsdk:"expectations_version"`
+	LastEvaluatedAt        types.String       `tfsdk:"last_evaluated_at"`
+	LastSuccessAt          types.String       `tfsdk:"last_success_at"`
+	LastFailureAt          types.String       `tfsdk:"last_failure_at"`
+	LastResult             types.String       `tfsdk:"last_result"`
+	CreatedAt              types.String       `tfsdk:"created_at"`
+	UpdatedAt              types.String       `tfsdk:"updated_at"`
 }
 
 func (r *expectationResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -77,18 +81,19 @@ func (r *expectationResource) Configure(_ context.Context, req resource.Configur
 		return
 	}
 
-	sdk_config, ok := req.ProviderData.(files_sdk.Config)
+	provider_data, ok := req.ProviderData.(providerResourceData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected files_sdk.Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected providerResourceData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.client = &expectation.Client{Config: sdk_config}
+	r.client = &expectation.Client{Config: provider_data.Config}
+	r.defaultWorkspaceId = provider_data.DefaultWorkspaceId
 }
 
 func (r *expectationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -130,6 +135,7 @@ func (r *expectationResource) Schema(_ context.Context, _ resource.SchemaRequest
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
 				},
+				CustomType: lib.NormalizedPathType{},
 			},
 			"source": schema.StringAttribute{
 				Description: "Source glob used to select candidate files.",
@@ -315,6 +321,21 @@ func (r *expectationResource) Schema(_ context.Context, _ resource.SchemaRequest
 	}
 }
 
+func (r *expectationResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.Int64Attribute{
+				Description:       "Expectation ID",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+func (r *expectationResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyPlanDefaultWorkspaceId(ctx, r.defaultWorkspaceId, req, resp)
+}
+
 func (r *expectationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan expectationResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -386,6 +407,12 @@ func (r *expectationResource) Create(ctx context.Context, req resource.CreateReq
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.Identity.SetAttribute(ctx, path.Root("id"), plan.Id)
+	resp.Diagnostics.Append(diags...)
 }
 
 func (r *expectationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -421,6 +448,12 @@ func (r *expectationResource) Read(ctx context.Context, req resource.ReadRequest
 
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.Identity.SetAttribute(ctx, path.Root("id"), state.Id)
+	resp.Diagnostics.Append(diags...)
 }
 
 func (r *expectationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -535,6 +568,12 @@ func (r *expectationResource) Update(ctx context.Context, req resource.UpdateReq
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.Identity.SetAttribute(ctx, path.Root("id"), plan.Id)
+	resp.Diagnostics.Append(diags...)
 }
 
 func (r *expectationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -558,6 +597,13 @@ func (r *expectationResource) Delete(ctx context.Context, req resource.DeleteReq
 }
 
 func (r *expectationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID == "" {
+		var id types.Int64
+		resp.Diagnostics.Append(req.Identity.GetAttribute(ctx, path.Root("id"), &id)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+		return
+	}
+
 	idParts := strings.SplitN(req.ID, ",", 1)
 
 	if len(idParts) != 1 || idParts[0] == "" {
@@ -587,7 +633,7 @@ func (r *expectationResource) populateResourceModel(ctx context.Context, expecta
 	state.WorkspaceId = types.Int64Value(expectation.WorkspaceId)
 	state.Name = types.StringValue(expectation.Name)
 	state.Description = types.StringValue(expectation.Description)
-	state.Path = types.StringValue(expectation.Path)
+	state.Path = lib.NormalizedPathValue(expectation.Path)
 	state.Source = types.StringValue(expectation.Source)
 	state.ExcludePattern = types.StringValue(expectation.ExcludePattern)
 	state.Disabled = types.BoolPointerValue(expectation.Disabled)
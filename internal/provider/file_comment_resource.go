@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 
@@ -25,6 +26,7 @@ var (
 	_ resource.Resource                = &fileCommentResource{}
 	_ resource.ResourceWithConfigure   = &fileCommentResource{}
 	_ resource.ResourceWithImportState = &fileCommentResource{}
+	_ resource.ResourceWithIdentity    = &fileCommentResource{}
 )
 
 func NewFileCommentResource() resource.Resource {
@@ -36,10 +38,15 @@ type fileCommentResource struct {
 }
 
 type fileCommentResourceModel struct {
-	Body      types.String  `tfsdk:"body"`
-	Path      types.String  `tfsdk:"path"`
-	Id        types.Int64   `tfsdk:"id"`
-	Reactions types.Dynamic `tfsdk:"reactions"`
+	Body      types.String       `tfsdk:"body"`
+	Path      lib.NormalizedPath `tfsdk:"path"`
+	Id        types.Int64        `tfsdk:"id"`
+	Reactions types.Dynamic      `tfsdk:"reactions"`
+}
+
+type fileCommentResourceIdentityModel struct {
+	Id   types.Int64  `tfsdk:"id"`
+	Path types.String `tfsdk:"path"`
 }
 
 func (r *fileCommentResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -47,18 +54,18 @@ func (r *fileCommentResource) Configure(_ context.Context, req resource.Configur
 		return
 	}
 
-	sdk_config, ok := req.ProviderData.(files_sdk.Config)
+	provider_data, ok := req.ProviderData.(providerResourceData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected files_sdk.Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected providerResourceData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.client = &file_comment.Client{Config: sdk_config}
+	r.client = &file_comment.Client{Config: provider_data.Config}
 }
 
 func (r *fileCommentResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -80,6 +87,7 @@ func (r *fileCommentResource) Schema(_ context.Context, _ resource.SchemaRequest
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
+				CustomType: lib.NormalizedPathType{},
 			},
 			"id": schema.Int64Attribute{
 				Description: "File Comment ID",
@@ -96,6 +104,21 @@ func (r *fileCommentResource) Schema(_ context.Context, _ resource.SchemaRequest
 	}
 }
 
+func (r *fileCommentResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.Int64Attribute{
+				Description:       "File Comment ID",
+				RequiredForImport: true,
+			},
+			"path": identityschema.StringAttribute{
+				Description:       "File path.",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
 func (r *fileCommentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan fileCommentResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -135,6 +158,16 @@ func (r *fileCommentResource) Create(ctx context.Context, req resource.CreateReq
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	identity := fileCommentResourceIdentityModel{
+		Id:   plan.Id,
+		Path: types.StringValue(config.Path.ValueString()),
+	}
+	diags = resp.Identity.Set(ctx, identity)
+	resp.Diagnostics.Append(diags...)
 }
 
 func (r *fileCommentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -145,8 +178,22 @@ func (r *fileCommentResource) Read(ctx context.Context, req resource.ReadRequest
 		return
 	}
 
+	// path is write-only, so the file the comment is on is kept in the
+	// identity rather than the state.
+	var identity fileCommentResourceIdentityModel
+	if !req.Identity.Raw.IsNull() {
+		diags = req.Identity.Get(ctx, &identity)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+	if identity.Path.IsNull() {
+		identity.Path = types.StringValue(state.Path.ValueString())
+	}
+
 	paramsFileCommentListFor := files_sdk.FileCommentListForParams{}
-	paramsFileCommentListFor.Path = state.Path.ValueString()
+	paramsFileCommentListFor.Path = identity.Path.ValueString()
 
 	fileCommentIt, err := r.client.ListFor(paramsFileCommentListFor, files_sdk.WithContext(ctx))
 	if err != nil {
@@ -196,6 +243,13 @@ func (r *fileCommentResource) Read(ctx context.Context, req resource.ReadRequest
 
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	identity.Id = state.Id
+	diags = resp.Identity.Set(ctx, identity)
+	resp.Diagnostics.Append(diags...)
 }
 
 func (r *fileCommentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -264,6 +318,13 @@ func (r *fileCommentResource) Delete(ctx context.Context, req resource.DeleteReq
 }
 
 func (r *fileCommentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID == "" {
+		var id types.Int64
+		resp.Diagnostics.Append(req.Identity.GetAttribute(ctx, path.Root("id"), &id)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+		return
+	}
+
 	idParts := strings.SplitN(req.ID, ",", 2)
 
 	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
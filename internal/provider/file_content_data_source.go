@@ -0,0 +1,275 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"unicode/utf8"
+
+	files_sdk "github.com/Files-com/files-sdk-go/v3"
+	file "github.com/Files-com/files-sdk-go/v3/file"
+	"github.com/Files-com/terraform-provider-files/lib"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// fileContentDefaultMaxSize is the largest file whose content is returned
+// unless max_content_size says otherwise.
+const fileContentDefaultMaxSize = 1 << 20
+
+var errFileContentTooLarge = errors.New("file is larger than max_content_size")
+
+var (
+	_ datasource.DataSource              = &fileContentDataSource{}
+	_ datasource.DataSourceWithConfigure = &fileContentDataSource{}
+)
+
+func NewFileContentDataSource() datasource.DataSource {
+	return &fileContentDataSource{}
+}
+
+type fileContentDataSource struct {
+	client *file.Client
+}
+
+type fileContentDataSourceModel struct {
+	Path           lib.NormalizedPath `tfsdk:"path"`
+	OutputPath     types.String       `tfsdk:"output_path"`
+	MaxContentSize types.Int64        `tfsdk:"max_content_size"`
+	Content        types.String       `tfsdk:"content"`
+	ContentBase64  types.String       `tfsdk:"content_base64"`
+	Size           types.Int64        `tfsdk:"size"`
+	Md5            types.String       `tfsdk:"md5"`
+	Sha256         types.String       `tfsdk:"sha256"`
+}
+
+func (r *fileContentDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	sdk_config, ok := req.ProviderData.(files_sdk.Config)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected files_sdk.Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = &file.Client{Config: sdk_config}
+}
+
+func (r *fileContentDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_file_content"
+}
+
+func (r *fileContentDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Downloads a file's content, either into Terraform as `content`/`content_base64` or to a local `output_path`. The downloaded bytes are verified against the MD5 and SHA256 checksums Files.com has on record for the file. Checksums that Files.com hasn't calculated yet, for example right after an upload, can't be verified, so the download is then returned as is.",
+		Attributes: map[string]schema.Attribute{
+			"path": schema.StringAttribute{
+				Description: "File path. This must be slash-delimited, but it must neither start nor end with a slash. Maximum of 5000 characters.",
+				Required:    true,
+				CustomType:  lib.NormalizedPathType{},
+			},
+			"output_path": schema.StringAttribute{
+				Description: "Local path to write the file to, with permissions `0600`. Missing parent directories are created. The file is only written once the download has been verified.",
+				Optional:    true,
+			},
+			"max_content_size": schema.Int64Attribute{
+				Description: "Largest file size, in bytes, whose content is returned in `content` and `content_base64`. Defaults to 1048576 (1 MiB). Larger files are an error unless `output_path` is set, in which case they are only written to disk.",
+				Optional:    true,
+				Validators:  []validator.Int64{int64validator.AtLeast(0)},
+			},
+			"content": schema.StringAttribute{
+				Description: "File content as a UTF-8 string. Null if the file is not valid UTF-8 or is larger than `max_content_size`.",
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"content_base64": schema.StringAttribute{
+				Description: "File content, base64 encoded. Null if the file is larger than `max_content_size`.",
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"size": schema.Int64Attribute{
+				Description: "Size of the downloaded file in bytes.",
+				Computed:    true,
+			},
+			"md5": schema.StringAttribute{
+				Description: "MD5 hash of the downloaded file.",
+				Computed:    true,
+			},
+			"sha256": schema.StringAttribute{
+				Description: "SHA256 hash of the downloaded file.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (r *fileContentDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data fileContentDataSourceModel
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	maxContentSize := int64(fileContentDefaultMaxSize)
+	if !data.MaxContentSize.IsNull() {
+		maxContentSize = data.MaxContentSize.ValueInt64()
+	}
+	content := &fileContentBuffer{limit: maxContentSize, strict: data.OutputPath.IsNull()}
+	writers := []io.Writer{content}
+
+	var output *os.File
+	if !data.OutputPath.IsNull() {
+		outputPath := data.OutputPath.ValueString()
+		err := os.MkdirAll(filepath.Dir(outputPath), 0o755)
+		if err == nil {
+			output, err = os.CreateTemp(filepath.Dir(outputPath), "."+filepath.Base(outputPath)+".*")
+		}
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Writing Files File Content",
+				"Could not create "+outputPath+": "+err.Error(),
+			)
+			return
+		}
+		defer os.Remove(output.Name())
+		defer output.Close()
+		writers = append(writers, output)
+	}
+
+	md5Hash := md5.New()
+	sha256Hash := sha256.New()
+	writers = append(writers, md5Hash, sha256Hash)
+	var size int64
+	paramsFileDownload := files_sdk.FileDownloadParams{}
+	paramsFileDownload.Path = data.Path.ValueString()
+
+	file, err := r.client.Download(
+		paramsFileDownload,
+		files_sdk.WithContext(ctx),
+		files_sdk.ResponseBodyOption(func(body io.ReadCloser) (err error) {
+			size, err = io.Copy(io.MultiWriter(writers...), body)
+			return
+		}),
+	)
+	if errors.Is(err, errFileContentTooLarge) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("max_content_size"),
+			"Error Downloading Files File",
+			fmt.Sprintf("File path %s is larger than max_content_size (%d bytes). Increase max_content_size or set output_path to download it to disk.", data.Path.ValueString(), maxContentSize),
+		)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Downloading Files File",
+			"Could not download file path "+fmt.Sprint(data.Path.ValueString())+": "+err.Error(),
+		)
+		return
+	}
+
+	checksums := fileChecksums{
+		md5:    hex.EncodeToString(md5Hash.Sum(nil)),
+		sha256: hex.EncodeToString(sha256Hash.Sum(nil)),
+		size:   size,
+	}
+	if err := fileContentVerify(file, checksums); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Downloading Files File",
+			"Could not verify file path "+fmt.Sprint(data.Path.ValueString())+": "+err.Error(),
+		)
+		return
+	}
+
+	if output != nil {
+		err := output.Close()
+		if err == nil {
+			err = os.Rename(output.Name(), data.OutputPath.ValueString())
+		}
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Writing Files File Content",
+				"Could not write "+data.OutputPath.ValueString()+": "+err.Error(),
+			)
+			return
+		}
+	}
+
+	data.Size = types.Int64Value(checksums.size)
+	data.Md5 = types.StringValue(checksums.md5)
+	data.Sha256 = types.StringValue(checksums.sha256)
+	data.Content = types.StringNull()
+	data.ContentBase64 = types.StringNull()
+	if !content.overflow {
+		data.ContentBase64 = types.StringValue(base64.StdEncoding.EncodeToString(content.Bytes()))
+		if utf8.Valid(content.Bytes()) {
+			data.Content = types.StringValue(content.String())
+		}
+	}
+
+	diags = resp.State.Set(ctx, data)
+	resp.Diagnostics.Append(diags...)
+}
+
+// fileContentBuffer keeps up to limit bytes of a download. Past the limit it
+// either fails the download, when strict, or stops buffering and records the
+// overflow so the rest can still be written to disk.
+type fileContentBuffer struct {
+	bytes.Buffer
+	limit    int64
+	strict   bool
+	overflow bool
+}
+
+func (b *fileContentBuffer) Write(p []byte) (int, error) {
+	if b.overflow {
+		return len(p), nil
+	}
+	if int64(b.Len()+len(p)) > b.limit {
+		if b.strict {
+			return 0, errFileContentTooLarge
+		}
+		b.overflow = true
+		b.Reset()
+		return len(p), nil
+	}
+	return b.Buffer.Write(p)
+}
+
+// fileContentVerify compares the checksums of the downloaded bytes with the
+// ones Files.com has on record. Checksums that haven't been calculated yet are
+// skipped.
+func fileContentVerify(file files_sdk.File, checksums fileChecksums) error {
+	var mismatches []string
+	if file.Md5 != "" && !strings.EqualFold(file.Md5, checksums.md5) {
+		mismatches = append(mismatches, fmt.Sprintf("md5 %s, expected %s", checksums.md5, file.Md5))
+	}
+	if file.Sha256 != "" && !strings.EqualFold(file.Sha256, checksums.sha256) {
+		mismatches = append(mismatches, fmt.Sprintf("sha256 %s, expected %s", checksums.sha256, file.Sha256))
+	}
+	if len(mismatches) > 0 {
+		return fmt.Errorf("downloaded content does not match: %s", strings.Join(mismatches, "; "))
+	}
+	return nil
+}
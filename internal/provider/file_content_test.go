@@ -0,0 +1,57 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	files_sdk "github.com/Files-com/files-sdk-go/v3"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFileContentBuffer(t *testing.T) {
+	content := &fileContentBuffer{limit: 5}
+	_, err := content.Write([]byte("hello"))
+	assert.NoError(t, err)
+	_, err = content.Write([]byte(" world"))
+	assert.NoError(t, err)
+	assert.True(t, content.overflow)
+	assert.Equal(t, 0, content.Len())
+
+	strict := &fileContentBuffer{limit: 5, strict: true}
+	_, err = strict.Write([]byte("hello world"))
+	assert.ErrorIs(t, err, errFileContentTooLarge)
+}
+
+func TestFileContentVerify(t *testing.T) {
+	checksums := fileChecksums{
+		md5:    "5eb63bbbe01eeed093cb22bb8f5acdc3",
+		sha256: "b94d27b9934d3e08a52e52d7da7dabfac484efe37a5380ee9088f7ace2efcde9",
+		size:   11,
+	}
+
+	assert.NoError(t, fileContentVerify(files_sdk.File{}, checksums))
+	assert.NoError(t, fileContentVerify(files_sdk.File{Md5: "5EB63BBBE01EEED093CB22BB8F5ACDC3", Sha256: checksums.sha256}, checksums))
+	assert.EqualError(t, fileContentVerify(files_sdk.File{Md5: checksums.md5, Sha256: "00"}, checksums), "downloaded content does not match: sha256 "+checksums.sha256+", expected 00")
+}
+
+func TestFileContentMaxContentSize(t *testing.T) {
+	t.Setenv("FILES_API_KEY", "api-key")
+	t.Setenv("HOME", t.TempDir())
+	providerServer, schemaResp, diags := configureProvider(t, map[string]tftypes.Value{})
+	require.Empty(t, diags)
+
+	for size, valid := range map[int64]bool{-1: false, 0: true, 1024: true} {
+		resp, err := providerServer.ValidateDataResourceConfig(context.Background(), &tfprotov6.ValidateDataResourceConfigRequest{
+			TypeName: "files_file_content",
+			Config: dynamicValue(t, schemaResp.DataSourceSchemas["files_file_content"], map[string]tftypes.Value{
+				"path":             tftypes.NewValue(tftypes.String, "a.txt"),
+				"max_content_size": tftypes.NewValue(tftypes.Number, size),
+			}),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, valid, len(resp.Diagnostics) == 0, size)
+	}
+}
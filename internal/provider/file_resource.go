@@ -1,17 +1,28 @@
 package provider
 
 import (
+	"bytes"
 	"context"
+	"crypto/md5"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
+	"io/fs"
+	"os"
 	"time"
 
 	files_sdk "github.com/Files-com/files-sdk-go/v3"
 	"github.com/Files-com/files-sdk-go/v3/file"
 	"github.com/Files-com/terraform-provider-files/lib"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/dynamicplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
@@ -22,9 +33,12 @@ import (
 )
 
 var (
-	_ resource.Resource                = &fileResource{}
-	_ resource.ResourceWithConfigure   = &fileResource{}
-	_ resource.ResourceWithImportState = &fileResource{}
+	_ resource.Resource                     = &fileResource{}
+	_ resource.ResourceWithConfigure        = &fileResource{}
+	_ resource.ResourceWithConfigValidators = &fileResource{}
+	_ resource.ResourceWithModifyPlan       = &fileResource{}
+	_ resource.ResourceWithImportState      = &fileResource{}
+	_ resource.ResourceWithIdentity         = &fileResource{}
 )
 
 func NewFileResource() resource.Resource {
@@ -36,43 +50,45 @@ type fileResource struct {
 }
 
 type fileResourceModel struct {
-	Source                             types.String  `tfsdk:"source"`
-	Md5                                types.String  `tfsdk:"md5"`
-	Path                               types.String  `tfsdk:"path"`
-	CustomMetadata                     types.Dynamic `tfsdk:"custom_metadata"`
-	Size                               types.Int64   `tfsdk:"size"`
-	ProvidedMtime                      types.String  `tfsdk:"provided_mtime"`
-	PriorityColor                      types.String  `tfsdk:"priority_color"`
-	CreatedById                        types.Int64   `tfsdk:"created_by_id"`
-	CreatedByApiKeyId                  types.Int64   `tfsdk:"created_by_api_key_id"`
-	CreatedByAs2IncomingMessageId      types.Int64   `tfsdk:"created_by_as2_incoming_message_id"`
-	CreatedByAutomationId              types.Int64   `tfsdk:"created_by_automation_id"`
-	CreatedByBundleRegistrationId      types.Int64   `tfsdk:"created_by_bundle_registration_id"`
-	CreatedByInboxId                   types.Int64   `tfsdk:"created_by_inbox_id"`
-	CreatedByRemoteServerId            types.Int64   `tfsdk:"created_by_remote_server_id"`
-	CreatedBySyncId                    types.Int64   `tfsdk:"created_by_sync_id"`
-	DisplayName                        types.String  `tfsdk:"display_name"`
-	Type                               types.String  `tfsdk:"type"`
-	CreatedAt                          types.String  `tfsdk:"created_at"`
-	LastModifiedById                   types.Int64   `tfsdk:"last_modified_by_id"`
-	LastModifiedByApiKeyId             types.Int64   `tfsdk:"last_modified_by_api_key_id"`
-	LastModifiedByAutomationId         types.Int64   `tfsdk:"last_modified_by_automation_id"`
-	LastModifiedByBundleRegistrationId types.Int64   `tfsdk:"last_modified_by_bundle_registration_id"`
-	LastModifiedByRemoteServerId       types.Int64   `tfsdk:"last_modified_by_remote_server_id"`
-	LastModifiedBySyncId               types.Int64   `tfsdk:"last_modified_by_sync_id"`
-	Mtime                              types.String  `tfsdk:"mtime"`
-	Crc32                              types.String  `tfsdk:"crc32"`
-	Sha1                               types.String  `tfsdk:"sha1"`
-	Sha256                             types.String  `tfsdk:"sha256"`
-	MimeType                           types.String  `tfsdk:"mime_type"`
-	Region                             types.String  `tfsdk:"region"`
-	Permissions                        types.String  `tfsdk:"permissions"`
-	SubfoldersLocked                   types.Bool    `tfsdk:"subfolders_locked"`
-	IsLocked                           types.Bool    `tfsdk:"is_locked"`
-	DownloadUri                        types.String  `tfsdk:"download_uri"`
-	DirectConnectionInfo               types.String  `tfsdk:"direct_connection_info"`
-	PreviewId                          types.Int64   `tfsdk:"preview_id"`
-	Preview                            types.String  `tfsdk:"preview"`
+	Source                             types.String       `tfsdk:"source"`
+	Content                            types.String       `tfsdk:"content"`
+	ContentBase64                      types.String       `tfsdk:"content_base64"`
+	Md5                                types.String       `tfsdk:"md5"`
+	Path                               lib.NormalizedPath `tfsdk:"path"`
+	CustomMetadata                     types.Dynamic      `tfsdk:"custom_metadata"`
+	Size                               types.Int64        `tfsdk:"size"`
+	ProvidedMtime                      types.String       `tfsdk:"provided_mtime"`
+	PriorityColor                      types.String       `tfsdk:"priority_color"`
+	CreatedById                        types.Int64        `tfsdk:"created_by_id"`
+	CreatedByApiKeyId                  types.Int64        `tfsdk:"created_by_api_key_id"`
+	CreatedByAs2IncomingMessageId      types.Int64        `tfsdk:"created_by_as2_incoming_message_id"`
+	CreatedByAutomationId              types.Int64        `tfsdk:"created_by_automation_id"`
+	CreatedByBundleRegistrationId      types.Int64        `tfsdk:"created_by_bundle_registration_id"`
+	CreatedByInboxId                   types.Int64        `tfsdk:"created_by_inbox_id"`
+	CreatedByRemoteServerId            types.Int64        `tfsdk:"created_by_remote_server_id"`
+	CreatedBySyncId                    types.Int64        `tfsdk:"created_by_sync_id"`
+	DisplayName                        types.String       `tfsdk:"display_name"`
+	Type                               types.String       `tfsdk:"type"`
+	CreatedAt                          types.String       `tfsdk:"created_at"`
+	LastModifiedById                   types.Int64        `tfsdk:"last_modified_by_id"`
+	LastModifiedByApiKeyId             types.Int64        `tfsdk:"last_modified_by_api_key_id"`
+	LastModifiedByAutomationId         types.Int64        `tfsdk:"last_modified_by_automation_id"`
+	LastModifiedByBundleRegistrationId types.Int64        `tfsdk:"last_modified_by_bundle_registration_id"`
+	LastModifiedByRemoteServerId       types.Int64        `tfsdk:"last_modified_by_remote_server_id"`
+	LastModifiedBySyncId               types.Int64        `tfsdk:"last_modified_by_sync_id"`
+	Mtime                              types.String       `tfsdk:"mtime"`
+	Crc32                              types.String       `tfsdk:"crc32"`
+	Sha1                               types.String       `tfsdk:"sha1"`
+	Sha256                             types.String       `tfsdk:"sha256"`
+	MimeType                           types.String       `tfsdk:"mime_type"`
+	Region                             types.String       `tfsdk:"region"`
+	Permissions                        types.String       `tfsdk:"permissions"`
+	SubfoldersLocked                   types.Bool         `tfsdk:"subfolders_locked"`
+	IsLocked                           types.Bool         `tfsdk:"is_locked"`
+	DownloadUri                        types.String       `tfsdk:"download_uri"`
+	DirectConnectionInfo               types.String       `tfsdk:"direct_connection_info"`
+	PreviewId                          types.Int64        `tfsdk:"preview_id"`
+	Preview                            types.String       `tfsdk:"preview"`
 }
 
 func (r *fileResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -80,22 +96,24 @@ func (r *fileResource) Configure(_ context.Context, req resource.ConfigureReques
 		return
 	}
 
-	sdk_config, ok := req.ProviderData.(files_sdk.Config)
+	provider_data, ok := req.ProviderData.(providerResourceData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected files_sdk.Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected providerResourceData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.client = &file.Client{Config: sdk_config}
+	r.client = &file.Client{Config: provider_data.Config}
 }
 
 func (r *fileResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_file"
+	// Changing path moves the file, so its identity follows.
+	resp.ResourceBehavior.MutableIdentity = true
 }
 
 func (r *fileResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
@@ -103,19 +121,24 @@ func (r *fileResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 		Description: "",
 		Attributes: map[string]schema.Attribute{
 			"source": schema.StringAttribute{
-				Description: "Path to a file that will be read and uploaded.",
-				Required:    true,
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
-				},
+				Description: "Path to a local file that will be read and uploaded. The file is hashed at plan time, and uploaded again in place when its contents change. Exactly one of `source`, `content` or `content_base64` must be set.",
+				Optional:    true,
+			},
+			"content": schema.StringAttribute{
+				Description: "Content to upload as the file, as a UTF-8 string. Changing it uploads the new content in place.",
+				Optional:    true,
+			},
+			"content_base64": schema.StringAttribute{
+				Description: "Base64-encoded content to upload as the file, for binary content. Changing it uploads the new content in place.",
+				Optional:    true,
 			},
 			"md5": schema.StringAttribute{
-				Description: "File MD5 checksum.",
+				Description: "File MD5 checksum, as stored by Files.com. Changes to `source`, `content` or `content_base64` are detected at plan time by comparing their hash with the last upload, and the new checksum is known after apply. Setting it with `source` replaces the file whenever it changes, instead of comparing checksums.",
 				Computed:    true,
 				Optional:    true,
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
 					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplaceIfConfigured(),
 				},
 			},
 			"path": schema.StringAttribute{
@@ -124,6 +147,7 @@ func (r *fileResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
 				},
+				CustomType: lib.NormalizedPathType{},
 			},
 			"custom_metadata": schema.DynamicAttribute{
 				Description: "Custom metadata map of keys and values. Limited to 32 keys, 256 characters per key and 1024 characters per value.",
@@ -282,6 +306,101 @@ func (r *fileResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 	}
 }
 
+func (r *fileResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"path": identityschema.StringAttribute{
+				Description:       "File path",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+func (r *fileResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(
+			path.MatchRoot("source"),
+			path.MatchRoot("content"),
+			path.MatchRoot("content_base64"),
+		),
+		resourcevalidator.Conflicting(
+			path.MatchRoot("md5"),
+			path.MatchRoot("content"),
+			path.MatchRoot("content_base64"),
+		),
+	}
+}
+
+func (r *fileResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan fileResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var config fileResourceModel
+	diags = req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// A configured md5 is compared as-is, and replaces the file when changed.
+	if !config.Md5.IsNull() {
+		return
+	}
+
+	// New files are left unknown until they are uploaded, since folder
+	// behaviors such as auto_encrypt can change the bytes that are stored.
+	if req.State.Raw.IsNull() {
+		return
+	}
+
+	var state fileResourceModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// A source that doesn't exist yet may be written by another resource
+	// during apply, so it can only be compared once it is uploaded.
+	sourceMissing := false
+	if !plan.Source.IsNull() && !plan.Source.IsUnknown() {
+		_, err := os.Stat(plan.Source.ValueString())
+		sourceMissing = errors.Is(err, fs.ErrNotExist)
+	}
+
+	upload := sourceMissing || plan.Source.IsUnknown() || plan.Content.IsUnknown() || plan.ContentBase64.IsUnknown()
+	if !upload {
+		upload, diags = fileUploadChanged(ctx, plan, state, req.Private)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+	if !upload {
+		return
+	}
+
+	// The stored bytes are only known after the upload, since folder
+	// behaviors can rewrite them.
+	plan.Md5 = types.StringUnknown()
+	if config.Size.IsNull() {
+		plan.Size = types.Int64Unknown()
+	}
+	planFileUploadUnknown(&plan)
+
+	diags = resp.Plan.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
 func (r *fileResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan fileResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -290,7 +409,15 @@ func (r *fileResource) Create(ctx context.Context, req resource.CreateRequest, r
 		return
 	}
 
-	err := r.client.Upload(file.UploadWithContext(ctx), file.UploadWithFile(plan.Source.ValueString()), file.UploadWithDestinationPath(plan.Path.ValueString()))
+	checksums, diags := fileUploadChecksums(plan)
+	resp.Diagnostics.Append(diags...)
+	uploadSource, diags := fileUploadSource(plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.Upload(file.UploadWithContext(ctx), uploadSource, file.UploadWithDestinationPath(plan.Path.ValueString()))
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Creating Files File",
@@ -298,6 +425,8 @@ func (r *fileResource) Create(ctx context.Context, req resource.CreateRequest, r
 		)
 		return
 	}
+	diags = setFileUploadedChecksums(ctx, resp.Private, checksums)
+	resp.Diagnostics.Append(diags...)
 
 	paramsFileUpdate := files_sdk.FileUpdateParams{}
 	paramsFileUpdate.Path = plan.Path.ValueString()
@@ -343,6 +472,12 @@ func (r *fileResource) Create(ctx context.Context, req resource.CreateRequest, r
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.Identity.SetAttribute(ctx, path.Root("path"), plan.Path.ValueString())
+	resp.Diagnostics.Append(diags...)
 }
 
 func (r *fileResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -381,6 +516,12 @@ func (r *fileResource) Read(ctx context.Context, req resource.ReadRequest, resp
 
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.Identity.SetAttribute(ctx, path.Root("path"), state.Path.ValueString())
+	resp.Diagnostics.Append(diags...)
 }
 
 func (r *fileResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -417,6 +558,29 @@ func (r *fileResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		}
 	}
 
+	if plan.Md5.IsUnknown() || plan.Md5.ValueString() != state.Md5.ValueString() || fileContentChanged(plan, state) {
+		tflog.Info(ctx, "Detected content change, uploading file", map[string]interface{}{
+			"path": plan.Path.ValueString(),
+		})
+		checksums, diags := fileUploadChecksums(plan)
+		resp.Diagnostics.Append(diags...)
+		uploadSource, diags := fileUploadSource(plan)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		err := r.client.Upload(file.UploadWithContext(ctx), uploadSource, file.UploadWithDestinationPath(plan.Path.ValueString()))
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Uploading Files File",
+				"Could not upload file path "+fmt.Sprint(plan.Path.ValueString())+": "+err.Error(),
+			)
+			return
+		}
+		diags = setFileUploadedChecksums(ctx, resp.Private, checksums)
+		resp.Diagnostics.Append(diags...)
+	}
+
 	paramsFileUpdate := files_sdk.FileUpdateParams{}
 	paramsFileUpdate.Path = plan.Path.ValueString()
 	updateCustomMetadata, diags := lib.DynamicToInterface(ctx, path.Root("custom_metadata"), plan.CustomMetadata)
@@ -461,6 +625,12 @@ func (r *fileResource) Update(ctx context.Context, req resource.UpdateRequest, r
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.Identity.SetAttribute(ctx, path.Root("path"), plan.Path.ValueString())
+	resp.Diagnostics.Append(diags...)
 }
 
 func (r *fileResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -485,13 +655,177 @@ func (r *fileResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 }
 
 func (r *fileResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("path"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("path"), path.Root("path"), req, resp)
+}
+
+// fileContent returns the inline content of model, decoding content_base64.
+func fileContent(model fileResourceModel) ([]byte, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if !model.ContentBase64.IsNull() {
+		content, err := base64.StdEncoding.DecodeString(model.ContentBase64.ValueString())
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("content_base64"),
+				"Invalid content_base64",
+				"Could not decode content_base64: "+err.Error(),
+			)
+		}
+		return content, diags
+	}
+	return []byte(model.Content.ValueString()), diags
+}
+
+type fileChecksums struct {
+	md5    string
+	sha256 string
+	size   int64
+}
+
+// fileUploadChecksums hashes the bytes that would be uploaded: the local
+// source file, or the inline content.
+func fileUploadChecksums(model fileResourceModel) (checksums fileChecksums, diags diag.Diagnostics) {
+	var reader io.Reader
+	if !model.Source.IsNull() {
+		source, err := os.Open(model.Source.ValueString())
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("source"),
+				"Error Reading Files File Source",
+				"Could not read source file: "+err.Error(),
+			)
+			return
+		}
+		defer source.Close()
+		reader = source
+	} else {
+		content, contentDiags := fileContent(model)
+		diags.Append(contentDiags...)
+		if diags.HasError() {
+			return
+		}
+		reader = bytes.NewReader(content)
+	}
+
+	md5Hash := md5.New()
+	sha256Hash := sha256.New()
+	size, err := io.Copy(io.MultiWriter(md5Hash, sha256Hash), reader)
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("source"),
+			"Error Reading Files File Source",
+			"Could not read source file: "+err.Error(),
+		)
+		return
+	}
+	checksums.md5 = hex.EncodeToString(md5Hash.Sum(nil))
+	checksums.sha256 = hex.EncodeToString(sha256Hash.Sum(nil))
+	checksums.size = size
+	return
+}
+
+// fileUploadedChecksumsKey is the private state key of the checksums of the
+// bytes last uploaded. Folder behaviors such as auto_encrypt can store
+// different bytes, so the md5 and sha256 Files.com reports can't tell whether
+// the local file changed.
+const fileUploadedChecksumsKey = "uploaded_checksums"
+
+type fileUploadedChecksums struct {
+	Md5    string `json:"md5"`
+	Sha256 string `json:"sha256"`
+}
+
+// fileUploadChanged reports whether the bytes in plan need to be uploaded over
+// the file in state. They are compared with the checksums recorded when they
+// were last uploaded, or with the remote ones for files that were imported.
+// Files whose checksums aren't known yet are only uploaded again when source,
+// content or content_base64 changes.
+func fileUploadChanged(ctx context.Context, plan fileResourceModel, state fileResourceModel, private privateState) (bool, diag.Diagnostics) {
+	if fileContentChanged(plan, state) {
+		return true, nil
+	}
+
+	uploaded := fileUploadedChecksums{Md5: state.Md5.ValueString(), Sha256: state.Sha256.ValueString()}
+	privateBytes, diags := private.GetKey(ctx, fileUploadedChecksumsKey)
+	if diags.HasError() {
+		return false, diags
+	}
+	if privateBytes != nil {
+		if err := json.Unmarshal(privateBytes, &uploaded); err != nil {
+			diags.AddError(
+				"Error Reading Files File",
+				"Could not decode private state: "+err.Error(),
+			)
+			return false, diags
+		}
+	}
+	if uploaded.Md5 == "" && uploaded.Sha256 == "" {
+		return false, diags
+	}
+
+	checksums, checksumDiags := fileUploadChecksums(plan)
+	diags.Append(checksumDiags...)
+	if diags.HasError() {
+		return false, diags
+	}
+	md5Changed := uploaded.Md5 != "" && uploaded.Md5 != checksums.md5
+	sha256Changed := uploaded.Sha256 != "" && uploaded.Sha256 != checksums.sha256
+	return md5Changed || sha256Changed, diags
+}
+
+// fileContentChanged reports whether a different source, content or
+// content_base64 is configured than the one in state.
+func fileContentChanged(plan fileResourceModel, state fileResourceModel) bool {
+	return !plan.Source.Equal(state.Source) || !plan.Content.Equal(state.Content) || !plan.ContentBase64.Equal(state.ContentBase64)
+}
+
+// setFileUploadedChecksums records the checksums of the bytes just uploaded.
+func setFileUploadedChecksums(ctx context.Context, private privateState, checksums fileChecksums) diag.Diagnostics {
+	privateBytes, err := json.Marshal(fileUploadedChecksums{Md5: checksums.md5, Sha256: checksums.sha256})
+	if err != nil {
+		var diags diag.Diagnostics
+		diags.AddError(
+			"Error Uploading Files File",
+			"Could not encode private state: "+err.Error(),
+		)
+		return diags
+	}
+	return private.SetKey(ctx, fileUploadedChecksumsKey, privateBytes)
+}
+
+// planFileUploadUnknown marks the attributes that an upload changes as unknown.
+// Terraform only does so itself when the configuration changes, not when the
+// bytes behind an unchanged source do.
+func planFileUploadUnknown(plan *fileResourceModel) {
+	plan.LastModifiedById = types.Int64Unknown()
+	plan.LastModifiedByApiKeyId = types.Int64Unknown()
+	plan.LastModifiedByAutomationId = types.Int64Unknown()
+	plan.LastModifiedByBundleRegistrationId = types.Int64Unknown()
+	plan.LastModifiedByRemoteServerId = types.Int64Unknown()
+	plan.LastModifiedBySyncId = types.Int64Unknown()
+	plan.Mtime = types.StringUnknown()
+	plan.Crc32 = types.StringUnknown()
+	plan.Sha1 = types.StringUnknown()
+	plan.Sha256 = types.StringUnknown()
+	plan.DownloadUri = types.StringUnknown()
+	plan.DirectConnectionInfo = types.StringUnknown()
+	plan.PreviewId = types.Int64Unknown()
+	plan.Preview = types.StringUnknown()
+}
+
+// fileUploadSource returns the upload option for the local source file, or
+// for the inline content when no source is set.
+func fileUploadSource(model fileResourceModel) (file.UploadOption, diag.Diagnostics) {
+	if !model.Source.IsNull() {
+		return file.UploadWithFile(model.Source.ValueString()), nil
+	}
+	content, diags := fileContent(model)
+	return file.UploadWithReader(bytes.NewReader(content)), diags
 }
 
 func (r *fileResource) populateResourceModel(ctx context.Context, file files_sdk.File, state *fileResourceModel) (diags diag.Diagnostics) {
 	var propDiags diag.Diagnostics
 
-	state.Path = types.StringValue(file.Path)
+	state.Path = lib.NormalizedPathValue(file.Path)
 	state.CreatedById = types.Int64Value(file.CreatedById)
 	state.CreatedByApiKeyId = types.Int64Value(file.CreatedByApiKeyId)
 	state.CreatedByAs2IncomingMessageId = types.Int64Value(file.CreatedByAs2IncomingMessageId)
@@ -1,9 +1,17 @@
 package provider
 
 import (
+	"context"
+	"os"
+	"path/filepath"
 	"testing"
 
+	frameworkresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestFileDataSource(t *testing.T) {
@@ -88,3 +96,195 @@ resource "files_file" "bar_file" {
 		},
 	})
 }
+
+func TestFileModifyPlan(t *testing.T) {
+	ctx := context.Background()
+	r := &fileResource{}
+	fileSchema, objectType := resourceSchema(r)
+
+	source := filepath.Join(t.TempDir(), "README.txt")
+	if err := os.WriteFile(source, []byte("hello world"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	// withState returns the remote values of README.txt in state, as last
+	// uploaded from values.
+	withState := func(md5 string, sha256 string, values map[string]tftypes.Value) map[string]tftypes.Value {
+		state := map[string]tftypes.Value{
+			"path":   tftypes.NewValue(tftypes.String, "README.txt"),
+			"md5":    tftypes.NewValue(tftypes.String, md5),
+			"sha256": tftypes.NewValue(tftypes.String, sha256),
+			"size":   tftypes.NewValue(tftypes.Number, 5),
+			"mtime":  tftypes.NewValue(tftypes.String, "2024-06-01T01:02:03Z"),
+		}
+		for name, value := range values {
+			state[name] = value
+		}
+		return state
+	}
+	helloMd5 := "5d41402abc4b2a76b9719d911017c592"
+	helloSha256 := "2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824"
+	sourceValues := map[string]tftypes.Value{"source": tftypes.NewValue(tftypes.String, source)}
+
+	tests := []struct {
+		name   string
+		config map[string]tftypes.Value
+		state  map[string]tftypes.Value
+		md5    types.String
+		size   types.Int64
+		mtime  types.String
+	}{
+		{
+			name:   "changed content",
+			config: map[string]tftypes.Value{"content": tftypes.NewValue(tftypes.String, "hello world")},
+			state:  withState(helloMd5, helloSha256, map[string]tftypes.Value{"content": tftypes.NewValue(tftypes.String, "hello")}),
+			md5:    types.StringUnknown(),
+			size:   types.Int64Unknown(),
+			mtime:  types.StringUnknown(),
+		},
+		{
+			name:   "changed content without remote checksums",
+			config: map[string]tftypes.Value{"content": tftypes.NewValue(tftypes.String, "hello world")},
+			state:  withState("", "", map[string]tftypes.Value{"content": tftypes.NewValue(tftypes.String, "hello")}),
+			md5:    types.StringUnknown(),
+			size:   types.Int64Unknown(),
+			mtime:  types.StringUnknown(),
+		},
+		{
+			name:   "unchanged content_base64",
+			config: map[string]tftypes.Value{"content_base64": tftypes.NewValue(tftypes.String, "aGVsbG8=")},
+			state:  withState(helloMd5, helloSha256, map[string]tftypes.Value{"content_base64": tftypes.NewValue(tftypes.String, "aGVsbG8=")}),
+			md5:    types.StringValue(helloMd5),
+			size:   types.Int64Value(5),
+			mtime:  types.StringValue("2024-06-01T01:02:03Z"),
+		},
+		{
+			name:   "unknown content",
+			config: map[string]tftypes.Value{"content": tftypes.NewValue(tftypes.String, tftypes.UnknownValue)},
+			state:  withState(helloMd5, helloSha256, map[string]tftypes.Value{"content": tftypes.NewValue(tftypes.String, "hello")}),
+			md5:    types.StringUnknown(),
+			size:   types.Int64Unknown(),
+			mtime:  types.StringUnknown(),
+		},
+		{
+			name:   "changed source",
+			config: sourceValues,
+			state:  withState(helloMd5, helloSha256, sourceValues),
+			md5:    types.StringUnknown(),
+			size:   types.Int64Unknown(),
+			mtime:  types.StringUnknown(),
+		},
+		{
+			name:   "changed source without remote md5",
+			config: sourceValues,
+			state:  withState("", helloSha256, sourceValues),
+			md5:    types.StringUnknown(),
+			size:   types.Int64Unknown(),
+			mtime:  types.StringUnknown(),
+		},
+		{
+			name:   "source without remote checksums",
+			config: sourceValues,
+			state:  withState("", "", sourceValues),
+			md5:    types.StringValue(""),
+			size:   types.Int64Value(5),
+			mtime:  types.StringValue("2024-06-01T01:02:03Z"),
+		},
+		{
+			name:   "missing source",
+			config: map[string]tftypes.Value{"source": tftypes.NewValue(tftypes.String, filepath.Join(t.TempDir(), "missing.txt"))},
+			state:  withState(helloMd5, helloSha256, sourceValues),
+			md5:    types.StringUnknown(),
+			size:   types.Int64Unknown(),
+			mtime:  types.StringUnknown(),
+		},
+		{
+			name:   "new source",
+			config: sourceValues,
+			md5:    types.StringUnknown(),
+			size:   types.Int64Unknown(),
+			mtime:  types.StringUnknown(),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			configValues := map[string]tftypes.Value{"path": tftypes.NewValue(tftypes.String, "README.txt")}
+			for name, value := range test.config {
+				configValues[name] = value
+			}
+			// The plan holds the prior state for computed attributes, as
+			// Terraform proposes it.
+			planValues := map[string]tftypes.Value{}
+			for name, value := range test.state {
+				planValues[name] = value
+			}
+			for name, value := range configValues {
+				planValues[name] = value
+			}
+			if test.state == nil {
+				for _, name := range []string{"md5", "size", "mtime"} {
+					planValues[name] = tftypes.NewValue(objectType.AttributeTypes[name], tftypes.UnknownValue)
+				}
+			}
+			state := tftypes.NewValue(objectType, nil)
+			if test.state != nil {
+				state = objectValue(objectType, test.state)
+			}
+
+			resp := &frameworkresource.ModifyPlanResponse{Plan: tfsdk.Plan{Schema: fileSchema, Raw: objectValue(objectType, planValues)}}
+			r.ModifyPlan(ctx, frameworkresource.ModifyPlanRequest{
+				Config: tfsdk.Config{Schema: fileSchema, Raw: objectValue(objectType, configValues)},
+				Plan:   tfsdk.Plan{Schema: fileSchema, Raw: objectValue(objectType, planValues)},
+				State:  tfsdk.State{Schema: fileSchema, Raw: state},
+			}, resp)
+			assert.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+			assert.Empty(t, resp.RequiresReplace)
+
+			var plan fileResourceModel
+			resp.Plan.Get(ctx, &plan)
+			assert.Equal(t, test.md5, plan.Md5)
+			assert.Equal(t, test.size, plan.Size)
+			assert.Equal(t, test.mtime, plan.Mtime)
+		})
+	}
+}
+
+func TestFileUploadChanged(t *testing.T) {
+	ctx := context.Background()
+	source := filepath.Join(t.TempDir(), "README.txt")
+	if err := os.WriteFile(source, []byte("hello world"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	// A folder behavior such as auto_encrypt stored different bytes than the
+	// ones uploaded, so the remote checksums never match the local file.
+	model := fileResourceModel{
+		Source: types.StringValue(source),
+		Md5:    types.StringValue("0cc175b9c0f1b6a831c399e269772661"),
+		Sha256: types.StringValue("ca978112ca1bbdcafac231b39a23dc4da786eff8147c4e72b9807785afee48bb"),
+	}
+	private := testPrivateState{}
+	diags := setFileUploadedChecksums(ctx, private, fileChecksums{
+		md5:    "5eb63bbbe01eeed093cb22bb8f5acdc3",
+		sha256: "b94d27b9934d3e08a52e52d7da7dabfac484efe37a5380ee9088f7ace2efcde9",
+	})
+	assert.False(t, diags.HasError(), diags)
+
+	upload, diags := fileUploadChanged(ctx, model, model, private)
+	assert.False(t, diags.HasError(), diags)
+	assert.False(t, upload)
+
+	if err := os.WriteFile(source, []byte("hello"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	upload, diags = fileUploadChanged(ctx, model, model, private)
+	assert.False(t, diags.HasError(), diags)
+	assert.True(t, upload)
+
+	// Without private state, as after an import, the remote checksums are
+	// compared.
+	upload, diags = fileUploadChanged(ctx, model, model, testPrivateState{})
+	assert.False(t, diags.HasError(), diags)
+	assert.True(t, upload)
+}
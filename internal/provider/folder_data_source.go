@@ -31,42 +31,42 @@ type folderDataSource struct {
 }
 
 type folderDataSourceModel struct {
-	Path                               types.String  `tfsdk:"path"`
-	CreatedById                        types.Int64   `tfsdk:"created_by_id"`
-	CreatedByApiKeyId                  types.Int64   `tfsdk:"created_by_api_key_id"`
-	CreatedByAs2IncomingMessageId      types.Int64   `tfsdk:"created_by_as2_incoming_message_id"`
-	CreatedByAutomationId              types.Int64   `tfsdk:"created_by_automation_id"`
-	CreatedByBundleRegistrationId      types.Int64   `tfsdk:"created_by_bundle_registration_id"`
-	CreatedByInboxId                   types.Int64   `tfsdk:"created_by_inbox_id"`
-	CreatedByRemoteServerId            types.Int64   `tfsdk:"created_by_remote_server_id"`
-	CreatedBySyncId                    types.Int64   `tfsdk:"created_by_sync_id"`
-	CustomMetadata                     types.Dynamic `tfsdk:"custom_metadata"`
-	DisplayName                        types.String  `tfsdk:"display_name"`
-	Type                               types.String  `tfsdk:"type"`
-	Size                               types.Int64   `tfsdk:"size"`
-	CreatedAt                          types.String  `tfsdk:"created_at"`
-	LastModifiedById                   types.Int64   `tfsdk:"last_modified_by_id"`
-	LastModifiedByApiKeyId             types.Int64   `tfsdk:"last_modified_by_api_key_id"`
-	LastModifiedByAutomationId         types.Int64   `tfsdk:"last_modified_by_automation_id"`
-	LastModifiedByBundleRegistrationId types.Int64   `tfsdk:"last_modified_by_bundle_registration_id"`
-	LastModifiedByRemoteServerId       types.Int64   `tfsdk:"last_modified_by_remote_server_id"`
-	LastModifiedBySyncId               types.Int64   `tfsdk:"last_modified_by_sync_id"`
-	Mtime                              types.String  `tfsdk:"mtime"`
-	ProvidedMtime                      types.String  `tfsdk:"provided_mtime"`
-	Crc32                              types.String  `tfsdk:"crc32"`
-	Md5                                types.String  `tfsdk:"md5"`
-	Sha1                               types.String  `tfsdk:"sha1"`
-	Sha256                             types.String  `tfsdk:"sha256"`
-	MimeType                           types.String  `tfsdk:"mime_type"`
-	Region                             types.String  `tfsdk:"region"`
-	Permissions                        types.String  `tfsdk:"permissions"`
-	SubfoldersLocked                   types.Bool    `tfsdk:"subfolders_locked"`
-	IsLocked                           types.Bool    `tfsdk:"is_locked"`
-	DownloadUri                        types.String  `tfsdk:"download_uri"`
-	DirectConnectionInfo               types.String  `tfsdk:"direct_connection_info"`
-	PriorityColor                      types.String  `tfsdk:"priority_color"`
-	PreviewId                          types.Int64   `tfsdk:"preview_id"`
-	Preview                            types.String  `tfsdk:"preview"`
+	Path                               lib.NormalizedPath `tfsdk:"path"`
+	CreatedById                        types.Int64        `tfsdk:"created_by_id"`
+	CreatedByApiKeyId                  types.Int64        `tfsdk:"created_by_api_key_id"`
+	CreatedByAs2IncomingMessageId      types.Int64        `tfsdk:"created_by_as2_incoming_message_id"`
+	CreatedByAutomationId              types.Int64        `tfsdk:"created_by_automation_id"`
+	CreatedByBundleRegistrationId      types.Int64        `tfsdk:"created_by_bundle_registration_id"`
+	CreatedByInboxId                   types.Int64        `tfsdk:"created_by_inbox_id"`
+	CreatedByRemoteServerId            types.Int64        `tfsdk:"created_by_remote_server_id"`
+	CreatedBySyncId                    types.Int64        `tfsdk:"created_by_sync_id"`
+	CustomMetadata                     types.Dynamic      `tfsdk:"custom_metadata"`
+	DisplayName                        types.String       `tfsdk:"display_name"`
+	Type                               types.String       `tfsdk:"type"`
+	Size                               types.Int64        `tfsdk:"size"`
+	CreatedAt                          types.String       `tfsdk:"created_at"`
+	LastModifiedById                   types.Int64        `tfsdk:"last_modified_by_id"`
+	LastModifiedByApiKeyId             types.Int64        `tfsdk:"last_modified_by_api_key_id"`
+	LastModifiedByAutomationId         types.Int64        `tfsdk:"last_modified_by_automation_id"`
+	LastModifiedByBundleRegistrationId types.Int64        `tfsdk:"last_modified_by_bundle_registration_id"`
+	LastModifiedByRemoteServerId       types.Int64        `tfsdk:"last_modified_by_remote_server_id"`
+	LastModifiedBySyncId               types.Int64        `tfsdk:"last_modified_by_sync_id"`
+	Mtime                              types.String       `tfsdk:"mtime"`
+	ProvidedMtime                      types.String       `tfsdk:"provided_mtime"`
+	Crc32                              types.String       `tfsdk:"crc32"`
+	Md5                                types.String       `tfsdk:"md5"`
+	Sha1                               types.String       `tfsdk:"sha1"`
+	Sha256                             types.String       `tfsdk:"sha256"`
+	MimeType                           types.String       `tfsdk:"mime_type"`
+	Region                             types.String       `tfsdk:"region"`
+	Permissions                        types.String       `tfsdk:"permissions"`
+	SubfoldersLocked                   types.Bool         `tfsdk:"subfolders_locked"`
+	IsLocked                           types.Bool         `tfsdk:"is_locked"`
+	DownloadUri                        types.String       `tfsdk:"download_uri"`
+	DirectConnectionInfo               types.String       `tfsdk:"direct_connection_info"`
+	PriorityColor                      types.String       `tfsdk:"priority_color"`
+	PreviewId                          types.Int64        `tfsdk:"preview_id"`
+	Preview                            types.String       `tfsdk:"preview"`
 }
 
 func (r *folderDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -100,6 +100,7 @@ func (r *folderDataSource) Schema(_ context.Context, _ datasource.SchemaRequest,
 			"path": schema.StringAttribute{
 				Description: "File/Folder path. This must be slash-delimited, but it must neither start nor end with a slash. Maximum of 5000 characters.",
 				Required:    true,
+				CustomType:  lib.NormalizedPathType{},
 			},
 			"created_by_id": schema.Int64Attribute{
 				Description: "User ID of the User who created the file/folder",
@@ -281,7 +282,7 @@ func (r *folderDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 func (r *folderDataSource) populateDataSourceModel(ctx context.Context, folder files_sdk.File, state *folderDataSourceModel) (diags diag.Diagnostics) {
 	var propDiags diag.Diagnostics
 
-	state.Path = types.StringValue(folder.Path)
+	state.Path = lib.NormalizedPathValue(folder.Path)
 	state.CreatedById = types.Int64Value(folder.CreatedById)
 	state.CreatedByApiKeyId = types.Int64Value(folder.CreatedByApiKeyId)
 	state.CreatedByAs2IncomingMessageId = types.Int64Value(folder.CreatedByAs2IncomingMessageId)
@@ -0,0 +1,151 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	files_sdk "github.com/Files-com/files-sdk-go/v3"
+	"github.com/Files-com/files-sdk-go/v3/folder"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ list.ListResource              = &folderListResource{}
+	_ list.ListResourceWithConfigure = &folderListResource{}
+)
+
+func NewFolderListResource() list.ListResource {
+	return &folderListResource{}
+}
+
+type folderListResource struct {
+	client *folder.Client
+}
+
+type folderListResourceModel struct {
+	Path      types.String `tfsdk:"path"`
+	Recursive types.Bool   `tfsdk:"recursive"`
+}
+
+func (r *folderListResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	sdk_config, ok := req.ProviderData.(files_sdk.Config)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected List Resource Configure Type",
+			fmt.Sprintf("Expected files_sdk.Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = &folder.Client{Config: sdk_config}
+}
+
+func (r *folderListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_folder"
+}
+
+func (r *folderListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the folders inside a folder so `terraform query` can import them.",
+		Attributes: map[string]schema.Attribute{
+			"path": schema.StringAttribute{
+				Description: "Path of the folder to list. Defaults to the root folder.",
+				Optional:    true,
+			},
+			"recursive": schema.BoolAttribute{
+				Description: "If `true`, folders inside the listed folders are included as well.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func (r *folderListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var data folderListResourceModel
+	diags := req.Config.Get(ctx, &data)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	withPriorityColor := true
+	folderIt := &folderTreeIterator{
+		ctx:    ctx,
+		client: r.client,
+		params: files_sdk.FolderListForParams{
+			WithPriorityColor: &withPriorityColor,
+		},
+		recursive: data.Recursive.ValueBool(),
+		pending:   []string{data.Path.ValueString()},
+	}
+
+	stream.Results = listResults(ctx, req, folderIt, "Error Listing Files Folders", "Could not list folders: ", func(folder files_sdk.File, result *list.ListResult) {
+		result.DisplayName = "/" + folder.Path
+		result.Diagnostics.Append(result.Identity.SetAttribute(ctx, path.Root("path"), folder.Path)...)
+	}, (&folderResource{}).populateResourceModel)
+}
+
+// folderTreeIterator returns the folders inside each pending path, one folder
+// listing at a time. When recursive is set, every folder it returns is
+// queued to be listed in turn.
+type folderTreeIterator struct {
+	ctx       context.Context
+	client    *folder.Client
+	params    files_sdk.FolderListForParams
+	recursive bool
+	pending   []string
+	it        *folder.Iter
+	current   files_sdk.File
+	err       error
+}
+
+func (i *folderTreeIterator) Next() bool {
+	for i.err == nil {
+		if i.it == nil {
+			if len(i.pending) == 0 {
+				return false
+			}
+			params := i.params
+			params.Path = i.pending[0]
+			i.pending = i.pending[1:]
+			i.it, i.err = i.client.ListFor(params, files_sdk.WithContext(i.ctx))
+			continue
+		}
+
+		if !i.it.Next() {
+			i.err = i.it.Err()
+			i.it = nil
+			continue
+		}
+
+		entry := i.it.File()
+		if entry.Type != "directory" {
+			continue
+		}
+		if i.recursive {
+			i.pending = append(i.pending, entry.Path)
+		}
+		i.current = entry
+		return true
+	}
+	return false
+}
+
+func (i *folderTreeIterator) Current() interface{} {
+	return i.current
+}
+
+func (i *folderTreeIterator) Err() error {
+	return i.err
+}
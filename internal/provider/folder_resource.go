@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strings"
 	"time"
 
 	files_sdk "github.com/Files-com/files-sdk-go/v3"
@@ -13,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/dynamicplanmodifier"
@@ -26,6 +28,7 @@ var (
 	_ resource.Resource                = &folderResource{}
 	_ resource.ResourceWithConfigure   = &folderResource{}
 	_ resource.ResourceWithImportState = &folderResource{}
+	_ resource.ResourceWithIdentity    = &folderResource{}
 )
 
 func NewFolderResource() resource.Resource {
@@ -38,43 +41,45 @@ type folderResource struct {
 }
 
 type folderResourceModel struct {
-	Path                               types.String  `tfsdk:"path"`
-	CustomMetadata                     types.Dynamic `tfsdk:"custom_metadata"`
-	ProvidedMtime                      types.String  `tfsdk:"provided_mtime"`
-	PriorityColor                      types.String  `tfsdk:"priority_color"`
-	MkdirParents                       types.Bool    `tfsdk:"mkdir_parents"`
-	CreatedById                        types.Int64   `tfsdk:"created_by_id"`
-	CreatedByApiKeyId                  types.Int64   `tfsdk:"created_by_api_key_id"`
-	CreatedByAs2IncomingMessageId      types.Int64   `tfsdk:"created_by_as2_incoming_message_id"`
-	CreatedByAutomationId              types.Int64   `tfsdk:"created_by_automation_id"`
-	CreatedByBundleRegistrationId      types.Int64   `tfsdk:"created_by_bundle_registration_id"`
-	CreatedByInboxId                   types.Int64   `tfsdk:"created_by_inbox_id"`
-	CreatedByRemoteServerId            types.Int64   `tfsdk:"created_by_remote_server_id"`
-	CreatedBySyncId                    types.Int64   `tfsdk:"created_by_sync_id"`
-	DisplayName                        types.String  `tfsdk:"display_name"`
-	Type                               types.String  `tfsdk:"type"`
-	Size                               types.Int64   `tfsdk:"size"`
-	CreatedAt                          types.String  `tfsdk:"created_at"`
-	LastModifiedById                   types.Int64   `tfsdk:"last_modified_by_id"`
-	LastModifiedByApiKeyId             types.Int64   `tfsdk:"last_modified_by_api_key_id"`
-	LastModifiedByAutomationId         types.Int64   `tfsdk:"last_modified_by_automation_id"`
-	LastModifiedByBundleRegistrationId types.Int64   `tfsdk:"last_modified_by_bundle_registration_id"`
-	LastModifiedByRemoteServerId       types.Int64   `tfsdk:"last_modified_by_remote_server_id"`
-	LastModifiedBySyncId               types.Int64   `tfsdk:"last_modified_by_sync_id"`
-	Mtime                              types.String  `tfsdk:"mtime"`
-	Crc32                              types.String  `tfsdk:"crc32"`
-	Md5                                types.String  `tfsdk:"md5"`
-	Sha1                               types.String  `tfsdk:"sha1"`
-	Sha256                             types.String  `tfsdk:"sha256"`
-	MimeType                           types.String  `tfsdk:"mime_type"`
-	Region                             types.String  `tfsdk:"region"`
-	Permissions                        types.String  `tfsdk:"permissions"`
-	SubfoldersLocked                   types.Bool    `tfsdk:"subfolders_locked"`
-	IsLocked                           types.Bool    `tfsdk:"is_locked"`
-	DownloadUri                        types.String  `tfsdk:"download_uri"`
-	DirectConnectionInfo               types.String  `tfsdk:"direct_connection_info"`
-	PreviewId                          types.Int64   `tfsdk:"preview_id"`
-	Preview                            types.String  `tfsdk:"preview"`
+	Path                               lib.NormalizedPath `tfsdk:"path"`
+	CustomMetadata                     types.Dynamic      `tfsdk:"custom_metadata"`
+	ProvidedMtime                      types.String       `tfsdk:"provided_mtime"`
+	PriorityColor                      types.String       `tfsdk:"priority_color"`
+	MkdirParents                       types.Bool         `tfsdk:"mkdir_parents"`
+	ForceDestroy                       types.Bool         `tfsdk:"force_destroy"`
+	PreventDestroyIfNotEmpty           types.Bool         `tfsdk:"prevent_destroy_if_not_empty"`
+	CreatedById                        types.Int64        `tfsdk:"created_by_id"`
+	CreatedByApiKeyId                  types.Int64        `tfsdk:"created_by_api_key_id"`
+	CreatedByAs2IncomingMessageId      types.Int64        `tfsdk:"created_by_as2_incoming_message_id"`
+	CreatedByAutomationId              types.Int64        `tfsdk:"created_by_automation_id"`
+	CreatedByBundleRegistrationId      types.Int64        `tfsdk:"created_by_bundle_registration_id"`
+	CreatedByInboxId                   types.Int64        `tfsdk:"created_by_inbox_id"`
+	CreatedByRemoteServerId            types.Int64        `tfsdk:"created_by_remote_server_id"`
+	CreatedBySyncId                    types.Int64        `tfsdk:"created_by_sync_id"`
+	DisplayName                        types.String       `tfsdk:"display_name"`
+	Type                               types.String       `tfsdk:"type"`
+	Size                               types.Int64        `tfsdk:"size"`
+	CreatedAt                          types.String       `tfsdk:"created_at"`
+	LastModifiedById                   types.Int64        `tfsdk:"last_modified_by_id"`
+	LastModifiedByApiKeyId             types.Int64        `tfsdk:"last_modified_by_api_key_id"`
+	LastModifiedByAutomationId         types.Int64        `tfsdk:"last_modified_by_automation_id"`
+	LastModifiedByBundleRegistrationId types.Int64        `tfsdk:"last_modified_by_bundle_registration_id"`
+	LastModifiedByRemoteServerId       types.Int64        `tfsdk:"last_modified_by_remote_server_id"`
+	LastModifiedBySyncId               types.Int64        `tfsdk:"last_modified_by_sync_id"`
+	Mtime                              types.String       `tfsdk:"mtime"`
+	Crc32                              types.String       `tfsdk:"crc32"`
+	Md5                                types.String       `tfsdk:"md5"`
+	Sha1                               types.String       `tfsdk:"sha1"`
+	Sha256                             types.String       `tfsdk:"sha256"`
+	MimeType                           types.String       `tfsdk:"mime_type"`
+	Region                             types.String       `tfsdk:"region"`
+	Permissions                        types.String       `tfsdk:"permissions"`
+	SubfoldersLocked                   types.Bool         `tfsdk:"subfolders_locked"`
+	IsLocked                           types.Bool         `tfsdk:"is_locked"`
+	DownloadUri                        types.String       `tfsdk:"download_uri"`
+	DirectConnectionInfo               types.String       `tfsdk:"direct_connection_info"`
+	PreviewId                          types.Int64        `tfsdk:"preview_id"`
+	Preview                            types.String       `tfsdk:"preview"`
 }
 
 func (r *folderResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -82,23 +87,25 @@ func (r *folderResource) Configure(_ context.Context, req resource.ConfigureRequ
 		return
 	}
 
-	sdk_config, ok := req.ProviderData.(files_sdk.Config)
+	provider_data, ok := req.ProviderData.(providerResourceData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected files_sdk.Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected providerResourceData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.folderClient = &folder.Client{Config: sdk_config}
-	r.fileClient = &file.Client{Config: sdk_config}
+	r.folderClient = &folder.Client{Config: provider_data.Config}
+	r.fileClient = &file.Client{Config: provider_data.Config}
 }
 
 func (r *folderResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_folder"
+	// Changing path moves the folder, so its identity follows.
+	resp.ResourceBehavior.MutableIdentity = true
 }
 
 func (r *folderResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
@@ -111,6 +118,7 @@ func (r *folderResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
 				},
+				CustomType: lib.NormalizedPathType{},
 			},
 			"custom_metadata": schema.DynamicAttribute{
 				Description: "Custom metadata map of keys and values. Limited to 32 keys, 256 characters per key and 1024 characters per value.",
@@ -144,6 +152,14 @@ func (r *folderResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 					boolplanmodifier.RequiresReplace(),
 				},
 			},
+			"force_destroy": schema.BoolAttribute{
+				Description: "Delete the folder along with all of its contents when the resource is destroyed. Without it, destroying a folder that isn't empty fails with a count of the files that would be lost. Must be applied before the destroy that relies on it.",
+				Optional:    true,
+			},
+			"prevent_destroy_if_not_empty": schema.BoolAttribute{
+				Description: "Refuse to destroy the folder while it has any contents, even if `force_destroy` is set.",
+				Optional:    true,
+			},
 			"created_by_id": schema.Int64Attribute{
 				Description: "User ID of the User who created the file/folder",
 				Computed:    true,
@@ -276,6 +292,17 @@ func (r *folderResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 	}
 }
 
+func (r *folderResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"path": identityschema.StringAttribute{
+				Description:       "Folder path",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
 func (r *folderResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan folderResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -369,6 +396,12 @@ func (r *folderResource) Create(ctx context.Context, req resource.CreateRequest,
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.Identity.SetAttribute(ctx, path.Root("path"), plan.Path.ValueString())
+	resp.Diagnostics.Append(diags...)
 }
 
 func (r *folderResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -407,6 +440,12 @@ func (r *folderResource) Read(ctx context.Context, req resource.ReadRequest, res
 
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.Identity.SetAttribute(ctx, path.Root("path"), state.Path.ValueString())
+	resp.Diagnostics.Append(diags...)
 }
 
 func (r *folderResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -487,6 +526,12 @@ func (r *folderResource) Update(ctx context.Context, req resource.UpdateRequest,
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.Identity.SetAttribute(ctx, path.Root("path"), plan.Path.ValueString())
+	resp.Diagnostics.Append(diags...)
 }
 
 func (r *folderResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -497,9 +542,40 @@ func (r *folderResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
+	contents, diags := r.contents(ctx, state.Path.ValueString())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !contents.empty() {
+		if state.PreventDestroyIfNotEmpty.ValueBool() {
+			resp.Diagnostics.AddError(
+				"Error Deleting Files Folder",
+				"Folder path "+fmt.Sprint(state.Path.ValueString())+" is not empty and prevent_destroy_if_not_empty is set. It contains "+contents.String()+". Empty the folder or unset prevent_destroy_if_not_empty to delete it.",
+			)
+			return
+		}
+		if !state.ForceDestroy.ValueBool() {
+			resp.Diagnostics.AddError(
+				"Error Deleting Files Folder",
+				"Folder path "+fmt.Sprint(state.Path.ValueString())+" is not empty. It contains "+contents.String()+", which would be lost. Set force_destroy to true and apply before destroying to delete the folder along with its contents.",
+			)
+			return
+		}
+		tflog.Warn(ctx, "Deleting folder along with its contents", map[string]interface{}{
+			"path":    state.Path.ValueString(),
+			"files":   contents.files,
+			"folders": contents.folders,
+			"bytes":   contents.size,
+		})
+	}
+
 	paramsFolderDelete := files_sdk.FileDeleteParams{
 		Path: state.Path.ValueString(),
 	}
+	if state.ForceDestroy.ValueBool() {
+		paramsFolderDelete.Recursive = state.ForceDestroy.ValueBoolPointer()
+	}
 
 	err := r.fileClient.Delete(paramsFolderDelete, files_sdk.WithContext(ctx))
 	if err != nil && !files_sdk.IsNotExist(err) {
@@ -510,14 +586,85 @@ func (r *folderResource) Delete(ctx context.Context, req resource.DeleteRequest,
 	}
 }
 
+// folderContents totals what a recursive delete of a folder would remove.
+type folderContents struct {
+	files   int
+	folders int
+	size    int64
+}
+
+func (c folderContents) empty() bool {
+	return c.files == 0 && c.folders == 0
+}
+
+func (c folderContents) String() string {
+	plural := func(count int, noun string) string {
+		if count == 1 {
+			return fmt.Sprintf("%d %s", count, noun)
+		}
+		return fmt.Sprintf("%d %ss", count, noun)
+	}
+	return fmt.Sprintf("%s (%d bytes) and %s", plural(c.files, "file"), c.size, plural(c.folders, "subfolder"))
+}
+
+func (c *folderContents) add(item files_sdk.File) {
+	if item.Type == "directory" {
+		c.folders++
+		return
+	}
+	c.files++
+	c.size += item.Size
+}
+
+// contents lists everything below folderPath. A folder that no longer exists
+// is empty.
+func (r *folderResource) contents(ctx context.Context, folderPath string) (contents folderContents, diags diag.Diagnostics) {
+	paramsFolderListFor := files_sdk.FolderListForParams{}
+	paramsFolderListFor.Path = folderPath
+	it, err := r.fileClient.ListForRecursive(paramsFolderListFor, files_sdk.WithContext(ctx))
+	if files_sdk.IsNotExist(err) {
+		return
+	}
+	if err != nil {
+		diags.AddError(
+			"Error Deleting Files Folder",
+			"Could not list folder path "+fmt.Sprint(folderPath)+": "+err.Error(),
+		)
+		return
+	}
+
+	prefix := directoryRemotePath(folderPath, "")
+	for it.Next() {
+		item := it.Resource()
+		if item.Err() != nil {
+			err = item.Err()
+			break
+		}
+		if len(item.Path) <= len(prefix) || !strings.EqualFold(item.Path[:len(prefix)], prefix) {
+			continue
+		}
+		contents.add(item.File)
+	}
+	if err == nil {
+		err = it.Err()
+	}
+	if err != nil && !files_sdk.IsNotExist(err) {
+		diags.AddError(
+			"Error Deleting Files Folder",
+			"Could not list folder path "+fmt.Sprint(folderPath)+": "+err.Error(),
+		)
+	}
+	return
+}
+
 func (r *folderResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("path"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("path"), path.Root("path"), req, resp)
 }
 
 func (r *folderResource) populateResourceModel(ctx context.Context, folder files_sdk.File, state *folderResourceModel) (diags diag.Diagnostics) {
 	var propDiags diag.Diagnostics
 
-	state.Path = types.StringValue(folder.Path)
+	state.Path = lib.NormalizedPathValue(folder.Path)
 	state.CreatedById = types.Int64Value(folder.CreatedById)
 	state.CreatedByApiKeyId = types.Int64Value(folder.CreatedByApiKeyId)
 	state.CreatedByAs2IncomingMessageId = types.Int64Value(folder.CreatedByAs2IncomingMessageId)
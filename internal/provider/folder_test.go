@@ -1,9 +1,21 @@
 package provider
 
 import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
+	files_sdk "github.com/Files-com/files-sdk-go/v3"
+	"github.com/Files-com/files-sdk-go/v3/file"
+	frameworkresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestFolderDataSource(t *testing.T) {
@@ -88,3 +100,110 @@ resource "files_folder" "bar_folder" {
 		},
 	})
 }
+
+func TestFolderContents(t *testing.T) {
+	contents := folderContents{}
+	assert.True(t, contents.empty())
+
+	contents.add(files_sdk.File{Path: "Test Folder/sub", Type: "directory"})
+	assert.False(t, contents.empty())
+	assert.Equal(t, "0 files (0 bytes) and 1 subfolder", contents.String())
+
+	contents.add(files_sdk.File{Path: "Test Folder/a.txt", Type: "file", Size: 10})
+	contents.add(files_sdk.File{Path: "Test Folder/sub/b.txt", Type: "file", Size: 5})
+	assert.Equal(t, "2 files (15 bytes) and 1 subfolder", contents.String())
+}
+
+func TestFolderDelete(t *testing.T) {
+	r := &folderResource{}
+	folderSchema, objectType := resourceSchema(r)
+	list := []string{"GET /api/rest/v1/file_actions/metadata/Test%20Folder?", "GET /api/rest/v1/folders/Test%20Folder?recursive=true"}
+
+	tests := []struct {
+		name     string
+		state    map[string]tftypes.Value
+		empty    bool
+		requests []string
+		summary  string
+		detail   string
+		warning  string
+	}{
+		{
+			name:     "empty",
+			empty:    true,
+			requests: append(list, "DELETE /api/rest/v1/files/Test%20Folder?"),
+		},
+		{
+			name:     "not empty",
+			requests: list,
+			summary:  "Error Deleting Files Folder",
+			detail:   "Folder path Test Folder is not empty. It contains 2 files (15 bytes) and 1 subfolder, which would be lost. Set force_destroy to true and apply before destroying to delete the folder along with its contents.",
+		},
+		{
+			name:     "force_destroy",
+			state:    map[string]tftypes.Value{"force_destroy": tftypes.NewValue(tftypes.Bool, true)},
+			requests: append(list, "DELETE /api/rest/v1/files/Test%20Folder?recursive=true"),
+			warning:  `"@message":"Deleting folder along with its contents"`,
+		},
+		{
+			name: "prevent_destroy_if_not_empty",
+			state: map[string]tftypes.Value{
+				"force_destroy":                tftypes.NewValue(tftypes.Bool, true),
+				"prevent_destroy_if_not_empty": tftypes.NewValue(tftypes.Bool, true),
+			},
+			requests: list,
+			summary:  "Error Deleting Files Folder",
+			detail:   "Folder path Test Folder is not empty and prevent_destroy_if_not_empty is set. It contains 2 files (15 bytes) and 1 subfolder. Empty the folder or unset prevent_destroy_if_not_empty to delete it.",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			requests := []string{}
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+				requests = append(requests, req.Method+" "+req.URL.EscapedPath()+"?"+req.URL.RawQuery)
+				w.Header().Set("Content-Type", "application/json")
+				switch {
+				case req.Method == http.MethodDelete:
+					w.WriteHeader(http.StatusNoContent)
+				case req.URL.Path == "/api/rest/v1/file_actions/metadata/Test Folder":
+					json.NewEncoder(w).Encode(files_sdk.File{Path: "Test Folder", Type: "directory"})
+				case test.empty:
+					json.NewEncoder(w).Encode([]files_sdk.File{})
+				default:
+					json.NewEncoder(w).Encode([]files_sdk.File{
+						{Path: "Test Folder/sub", Type: "directory"},
+						{Path: "Test Folder/a.txt", Type: "file", Size: 10},
+						{Path: "Test Folder/sub/b.txt", Type: "file", Size: 5},
+					})
+				}
+			}))
+			defer server.Close()
+			r.fileClient = &file.Client{Config: files_sdk.Config{APIKey: "api-key", EndpointOverride: server.URL}.Init()}
+
+			stateValues := map[string]tftypes.Value{"path": tftypes.NewValue(tftypes.String, "Test Folder")}
+			for name, value := range test.state {
+				stateValues[name] = value
+			}
+			var logs bytes.Buffer
+			resp := &frameworkresource.DeleteResponse{}
+			r.Delete(tflogtest.RootLogger(context.Background(), &logs), frameworkresource.DeleteRequest{
+				State: tfsdk.State{Schema: folderSchema, Raw: objectValue(objectType, stateValues)},
+			}, resp)
+
+			assert.Equal(t, test.requests, requests)
+			if test.warning != "" {
+				assert.Contains(t, logs.String(), test.warning)
+				assert.Contains(t, logs.String(), `"bytes":15,"files":2,"folders":1`)
+			}
+			if test.summary == "" {
+				assert.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+				return
+			}
+			if assert.Len(t, resp.Diagnostics, 1) {
+				assert.Equal(t, test.summary, resp.Diagnostics[0].Summary())
+				assert.Equal(t, test.detail, resp.Diagnostics[0].Detail())
+			}
+		})
+	}
+}
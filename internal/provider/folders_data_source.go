@@ -0,0 +1,138 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	files_sdk "github.com/Files-com/files-sdk-go/v3"
+	"github.com/Files-com/files-sdk-go/v3/folder"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &foldersDataSource{}
+	_ datasource.DataSourceWithConfigure = &foldersDataSource{}
+)
+
+func NewFoldersDataSource() datasource.DataSource {
+	return &foldersDataSource{}
+}
+
+type foldersDataSource struct {
+	client *folder.Client
+}
+
+type foldersDataSourceModel struct {
+	Path    types.String `tfsdk:"path"`
+	SortBy  types.Map    `tfsdk:"sort_by"`
+	Search  types.String `tfsdk:"search"`
+	Type    types.String `tfsdk:"type"`
+	Folders types.List   `tfsdk:"folders"`
+}
+
+func (r *foldersDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	sdk_config, ok := req.ProviderData.(files_sdk.Config)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected files_sdk.Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = &folder.Client{Config: sdk_config}
+}
+
+func (r *foldersDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_folders"
+}
+
+func (r *foldersDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the files and folders directly inside a folder. Sorting and searching are performed by the Files.com API and all pages of results are returned. The free-form `custom_metadata` attribute is not included; read it with the `files_folder` or `files_file` data source.",
+		Attributes: map[string]schema.Attribute{
+			"path": schema.StringAttribute{
+				Description: "Path of the folder to list. This must be slash-delimited, but it must neither start nor end with a slash. Leave empty to list the root folder.",
+				Required:    true,
+			},
+			"sort_by": schema.MapAttribute{
+				Description: "If set, sort records by the specified field in either `asc` or `desc` direction.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"search": schema.StringAttribute{
+				Description: "If specified, will filter folders/files list by name. Ignores text before last `/`. Wildcards of `*` and `?` are acceptable here.",
+				Optional:    true,
+			},
+			"type": schema.StringAttribute{
+				Description: "Type of objects to return.  Can be `folder` or `file`.",
+				Optional:    true,
+			},
+			"folders": schema.ListNestedAttribute{
+				Description: "Files and folders inside `path`.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: listItemAttributes(dataSourceSchema(ctx, NewFolderDataSource()).Attributes),
+				},
+			},
+		},
+	}
+}
+
+func (r *foldersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data foldersDataSourceModel
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var propDiags diag.Diagnostics
+	paramsFolderListFor := files_sdk.FolderListForParams{}
+	paramsFolderListFor.Path = data.Path.ValueString()
+	paramsFolderListFor.SortBy, propDiags = listFilterValue(ctx, path.Root("sort_by"), data.SortBy)
+	resp.Diagnostics.Append(propDiags...)
+	paramsFolderListFor.Search = data.Search.ValueString()
+	paramsFolderListFor.Type = data.Type.ValueString()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	folderIt, err := r.client.ListFor(paramsFolderListFor, files_sdk.WithContext(ctx))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Listing Files Folders",
+			"Could not list folder path "+fmt.Sprint(data.Path.ValueString())+": "+err.Error(),
+		)
+		return
+	}
+
+	folders, err := listAll[files_sdk.File](folderIt)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Listing Files Folders",
+			"Could not list folder path "+fmt.Sprint(data.Path.ValueString())+": "+err.Error(),
+		)
+		return
+	}
+
+	data.Folders, diags = listItems(ctx, dataSourceSchema(ctx, NewFolderDataSource()).Attributes, folders, (&folderDataSource{}).populateDataSourceModel)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, data)
+	resp.Diagnostics.Append(diags...)
+}
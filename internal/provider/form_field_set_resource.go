@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/dynamicplanmodifier"
@@ -27,6 +28,7 @@ var (
 	_ resource.Resource                = &formFieldSetResource{}
 	_ resource.ResourceWithConfigure   = &formFieldSetResource{}
 	_ resource.ResourceWithImportState = &formFieldSetResource{}
+	_ resource.ResourceWithIdentity    = &formFieldSetResource{}
 )
 
 func NewFormFieldSetResource() resource.Resource {
@@ -34,7 +36,8 @@ func NewFormFieldSetResource() resource.Resource {
 }
 
 type formFieldSetResource struct {
-	client *form_field_set.Client
+	client             *form_field_set.Client
+	defaultWorkspaceId types.Int64
 }
 
 type formFieldSetResourceModel struct {
@@ -55,18 +58,19 @@ func (r *formFieldSetResource) Configure(_ context.Context, req resource.Configu
 		return
 	}
 
-	sdk_config, ok := req.ProviderData.(files_sdk.Config)
+	provider_data, ok := req.ProviderData.(providerResourceData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected files_sdk.Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected providerResourceData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.client = &form_field_set.Client{Config: sdk_config}
+	r.client = &form_field_set.Client{Config: provider_data.Config}
+	r.defaultWorkspaceId = provider_data.DefaultWorkspaceId
 }
 
 func (r *formFieldSetResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -150,6 +154,17 @@ func (r *formFieldSetResource) Schema(_ context.Context, _ resource.SchemaReques
 	}
 }
 
+func (r *formFieldSetResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.Int64Attribute{
+				Description:       "Form field set id",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
 func (r *formFieldSetResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan formFieldSetResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -168,6 +183,10 @@ func (r *formFieldSetResource) Create(ctx context.Context, req resource.CreateRe
 	paramsFormFieldSetCreate.UserId = config.UserId.ValueInt64()
 	paramsFormFieldSetCreate.Title = plan.Title.ValueString()
 	paramsFormFieldSetCreate.WorkspaceId = config.WorkspaceId.ValueInt64()
+	if config.WorkspaceId.IsNull() {
+		// workspace_id is write-only, so the provider default cannot be planned.
+		paramsFormFieldSetCreate.WorkspaceId = r.defaultWorkspaceId.ValueInt64()
+	}
 	if !plan.SkipEmail.IsNull() && !plan.SkipEmail.IsUnknown() {
 		paramsFormFieldSetCreate.SkipEmail = plan.SkipEmail.ValueBoolPointer()
 	}
@@ -201,6 +220,12 @@ func (r *formFieldSetResource) Create(ctx context.Context, req resource.CreateRe
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.Identity.SetAttribute(ctx, path.Root("id"), plan.Id)
+	resp.Diagnostics.Append(diags...)
 }
 
 func (r *formFieldSetResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -236,6 +261,12 @@ func (r *formFieldSetResource) Read(ctx context.Context, req resource.ReadReques
 
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.Identity.SetAttribute(ctx, path.Root("id"), state.Id)
+	resp.Diagnostics.Append(diags...)
 }
 
 func (r *formFieldSetResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -298,6 +329,12 @@ func (r *formFieldSetResource) Update(ctx context.Context, req resource.UpdateRe
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.Identity.SetAttribute(ctx, path.Root("id"), plan.Id)
+	resp.Diagnostics.Append(diags...)
 }
 
 func (r *formFieldSetResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -321,6 +358,13 @@ func (r *formFieldSetResource) Delete(ctx context.Context, req resource.DeleteRe
 }
 
 func (r *formFieldSetResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID == "" {
+		var id types.Int64
+		resp.Diagnostics.Append(req.Identity.GetAttribute(ctx, path.Root("id"), &id)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+		return
+	}
+
 	idParts := strings.SplitN(req.ID, ",", 1)
 
 	if len(idParts) != 1 || idParts[0] == "" {
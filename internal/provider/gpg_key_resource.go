@@ -11,15 +11,18 @@ import (
 	gpg_key "github.com/Files-com/files-sdk-go/v3/gpgkey"
 	"github.com/Files-com/terraform-provider-files/lib"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -27,6 +30,8 @@ var (
 	_ resource.Resource                = &gpgKeyResource{}
 	_ resource.ResourceWithConfigure   = &gpgKeyResource{}
 	_ resource.ResourceWithImportState = &gpgKeyResource{}
+	_ resource.ResourceWithIdentity    = &gpgKeyResource{}
+	_ resource.ResourceWithModifyPlan  = &gpgKeyResource{}
 )
 
 func NewGpgKeyResource() resource.Resource {
@@ -34,29 +39,32 @@ func NewGpgKeyResource() resource.Resource {
 }
 
 type gpgKeyResource struct {
-	client *gpg_key.Client
+	client             *gpg_key.Client
+	defaultWorkspaceId types.Int64
 }
 
 type gpgKeyResourceModel struct {
-	Name                  types.String `tfsdk:"name"`
-	WorkspaceId           types.Int64  `tfsdk:"workspace_id"`
-	PartnerId             types.Int64  `tfsdk:"partner_id"`
-	UserId                types.Int64  `tfsdk:"user_id"`
-	PublicKey             types.String `tfsdk:"public_key"`
-	PrivateKey            types.String `tfsdk:"private_key"`
-	PrivateKeyPassword    types.String `tfsdk:"private_key_password"`
-	GenerateExpiresAt     types.String `tfsdk:"generate_expires_at"`
-	GenerateKeypair       types.Bool   `tfsdk:"generate_keypair"`
-	GenerateFullName      types.String `tfsdk:"generate_full_name"`
-	GenerateEmail         types.String `tfsdk:"generate_email"`
-	Id                    types.Int64  `tfsdk:"id"`
-	ExpiresAt             types.String `tfsdk:"expires_at"`
-	PartnerName           types.String `tfsdk:"partner_name"`
-	PublicKeyMd5          types.String `tfsdk:"public_key_md5"`
-	PrivateKeyMd5         types.String `tfsdk:"private_key_md5"`
-	GeneratedPublicKey    types.String `tfsdk:"generated_public_key"`
-	GeneratedPrivateKey   types.String `tfsdk:"generated_private_key"`
-	PrivateKeyPasswordMd5 types.String `tfsdk:"private_key_password_md5"`
+	Name                      types.String `tfsdk:"name"`
+	WorkspaceId               types.Int64  `tfsdk:"workspace_id"`
+	PartnerId                 types.Int64  `tfsdk:"partner_id"`
+	UserId                    types.Int64  `tfsdk:"user_id"`
+	PublicKey                 types.String `tfsdk:"public_key"`
+	PrivateKey                types.String `tfsdk:"private_key"`
+	PrivateKeyVersion         types.Int64  `tfsdk:"private_key_version"`
+	PrivateKeyPassword        types.String `tfsdk:"private_key_password"`
+	PrivateKeyPasswordVersion types.Int64  `tfsdk:"private_key_password_version"`
+	GenerateExpiresAt         types.String `tfsdk:"generate_expires_at"`
+	GenerateKeypair           types.Bool   `tfsdk:"generate_keypair"`
+	GenerateFullName          types.String `tfsdk:"generate_full_name"`
+	GenerateEmail             types.String `tfsdk:"generate_email"`
+	Id                        types.Int64  `tfsdk:"id"`
+	ExpiresAt                 types.String `tfsdk:"expires_at"`
+	PartnerName               types.String `tfsdk:"partner_name"`
+	PublicKeyMd5              types.String `tfsdk:"public_key_md5"`
+	PrivateKeyMd5             types.String `tfsdk:"private_key_md5"`
+	GeneratedPublicKey        types.String `tfsdk:"generated_public_key"`
+	GeneratedPrivateKey       types.String `tfsdk:"generated_private_key"`
+	PrivateKeyPasswordMd5     types.String `tfsdk:"private_key_password_md5"`
 }
 
 func (r *gpgKeyResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -64,18 +72,19 @@ func (r *gpgKeyResource) Configure(_ context.Context, req resource.ConfigureRequ
 		return
 	}
 
-	sdk_config, ok := req.ProviderData.(files_sdk.Config)
+	provider_data, ok := req.ProviderData.(providerResourceData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected files_sdk.Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected providerResourceData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.client = &gpg_key.Client{Config: sdk_config}
+	r.client = &gpg_key.Client{Config: provider_data.Config}
+	r.defaultWorkspaceId = provider_data.DefaultWorkspaceId
 }
 
 func (r *gpgKeyResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -126,11 +135,25 @@ func (r *gpgKeyResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 				Optional:    true,
 				WriteOnly:   true,
 			},
+			"private_key_version": schema.Int64Attribute{
+				Description: "Change this value to send the write-only `private_key` to Files.com again, for example after rotating it.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRoot("private_key")),
+				},
+			},
 			"private_key_password": schema.StringAttribute{
 				Description: "The GPG private key password",
 				Optional:    true,
 				WriteOnly:   true,
 			},
+			"private_key_password_version": schema.Int64Attribute{
+				Description: "Change this value to send the write-only `private_key_password` to Files.com again, for example after rotating it.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRoot("private_key_password")),
+				},
+			},
 			"generate_expires_at": schema.StringAttribute{
 				Description: "Expiration date of the key. Used for the generation of the key. Will be ignored if `generate_keypair` is false.",
 				Optional:    true,
@@ -203,6 +226,21 @@ func (r *gpgKeyResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 	}
 }
 
+func (r *gpgKeyResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.Int64Attribute{
+				Description:       "GPG key ID.",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+func (r *gpgKeyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyPlanDefaultWorkspaceId(ctx, r.defaultWorkspaceId, req, resp)
+}
+
 func (r *gpgKeyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan gpgKeyResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -268,6 +306,12 @@ func (r *gpgKeyResource) Create(ctx context.Context, req resource.CreateRequest,
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.Identity.SetAttribute(ctx, path.Root("id"), plan.Id)
+	resp.Diagnostics.Append(diags...)
 }
 
 func (r *gpgKeyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -303,6 +347,12 @@ func (r *gpgKeyResource) Read(ctx context.Context, req resource.ReadRequest, res
 
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.Identity.SetAttribute(ctx, path.Root("id"), state.Id)
+	resp.Diagnostics.Append(diags...)
 }
 
 func (r *gpgKeyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -360,6 +410,12 @@ func (r *gpgKeyResource) Update(ctx context.Context, req resource.UpdateRequest,
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.Identity.SetAttribute(ctx, path.Root("id"), plan.Id)
+	resp.Diagnostics.Append(diags...)
 }
 
 func (r *gpgKeyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -383,6 +439,13 @@ func (r *gpgKeyResource) Delete(ctx context.Context, req resource.DeleteRequest,
 }
 
 func (r *gpgKeyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID == "" {
+		var id types.Int64
+		resp.Diagnostics.Append(req.Identity.GetAttribute(ctx, path.Root("id"), &id)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+		return
+	}
+
 	idParts := strings.SplitN(req.ID, ",", 1)
 
 	if len(idParts) != 1 || idParts[0] == "" {
@@ -7,15 +7,18 @@ import (
 	files_sdk "github.com/Files-com/files-sdk-go/v3"
 	group "github.com/Files-com/files-sdk-go/v3/group"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 var (
-	_ datasource.DataSource              = &groupDataSource{}
-	_ datasource.DataSourceWithConfigure = &groupDataSource{}
+	_ datasource.DataSource                     = &groupDataSource{}
+	_ datasource.DataSourceWithConfigure        = &groupDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &groupDataSource{}
 )
 
 func NewGroupDataSource() datasource.DataSource {
@@ -70,14 +73,16 @@ func (r *groupDataSource) Metadata(_ context.Context, req datasource.MetadataReq
 
 func (r *groupDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Description: "A Group is a powerful tool for permissions and user management on Files.com. Users can belong to multiple groups.\n\n\n\nAll permissions can be managed via Groups, and Groups can also be synced to your identity platform via LDAP or SCIM.\n\n\n\nFiles.com's Group Admin feature allows you to define Group Admins, who then have access to add and remove users within their groups.",
+		Description: "A Group is a powerful tool for permissions and user management on Files.com. Users can belong to multiple groups.\n\n\n\nAll permissions can be managed via Groups, and Groups can also be synced to your identity platform via LDAP or SCIM.\n\n\n\nFiles.com's Group Admin feature allows you to define Group Admins, who then have access to add and remove users within their groups.\n\nLook up a group either by `id` or by `name`. A `name` lookup fails if no group, or more than one group, has that name.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.Int64Attribute{
 				Description: "Group ID",
-				Required:    true,
+				Optional:    true,
+				Computed:    true,
 			},
 			"name": schema.StringAttribute{
 				Description: "Group name",
+				Optional:    true,
 				Computed:    true,
 			},
 			"allowed_ips": schema.StringAttribute{
@@ -140,6 +145,15 @@ func (r *groupDataSource) Schema(_ context.Context, _ datasource.SchemaRequest,
 	}
 }
 
+func (r *groupDataSource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("id"),
+			path.MatchRoot("name"),
+		),
+	}
+}
+
 func (r *groupDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	var data groupDataSourceModel
 	diags := req.Config.Get(ctx, &data)
@@ -148,6 +162,30 @@ func (r *groupDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		return
 	}
 
+	if data.Id.IsNull() {
+		paramsGroupList := files_sdk.GroupListParams{}
+		paramsGroupList.Filter = map[string]interface{}{"name": data.Name.ValueString()}
+
+		groupIt, err := r.client.List(paramsGroupList, files_sdk.WithContext(ctx))
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Reading Files Group",
+				"Could not list groups: "+err.Error(),
+			)
+			return
+		}
+
+		match, diags := lookupByKey(groupIt, "Error Reading Files Group", "group", "name", data.Name.ValueString(),
+			func(g files_sdk.Group) string { return g.Name },
+			func(g files_sdk.Group) int64 { return g.Id },
+		)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		data.Id = types.Int64Value(match.Id)
+	}
+
 	paramsGroupFind := files_sdk.GroupFindParams{}
 	paramsGroupFind.Id = data.Id.ValueInt64()
 
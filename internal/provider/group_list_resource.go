@@ -0,0 +1,124 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	files_sdk "github.com/Files-com/files-sdk-go/v3"
+	group "github.com/Files-com/files-sdk-go/v3/group"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ list.ListResource              = &groupListResource{}
+	_ list.ListResourceWithConfigure = &groupListResource{}
+)
+
+func NewGroupListResource() list.ListResource {
+	return &groupListResource{}
+}
+
+type groupListResource struct {
+	client *group.Client
+}
+
+type groupListResourceModel struct {
+	Filter                  types.Map    `tfsdk:"filter"`
+	FilterPrefix            types.Map    `tfsdk:"filter_prefix"`
+	Ids                     types.String `tfsdk:"ids"`
+	IncludeParentSiteGroups types.Bool   `tfsdk:"include_parent_site_groups"`
+}
+
+func (r *groupListResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	sdk_config, ok := req.ProviderData.(files_sdk.Config)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected List Resource Configure Type",
+			fmt.Sprintf("Expected files_sdk.Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = &group.Client{Config: sdk_config}
+}
+
+func (r *groupListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_group"
+}
+
+func (r *groupListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists every Group matching the given arguments so `terraform query` can import them.",
+		Attributes: map[string]schema.Attribute{
+			"filter": schema.MapAttribute{
+				Description: "If set, return records where the specified field is equal to the supplied value.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"filter_prefix": schema.MapAttribute{
+				Description: "If set, return records where the specified field is prefixed by the supplied value.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"ids": schema.StringAttribute{
+				Description: "Comma-separated list of group ids to include in results.",
+				Optional:    true,
+			},
+			"include_parent_site_groups": schema.BoolAttribute{
+				Description: "Include groups from the parent site.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func (r *groupListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var data groupListResourceModel
+	diags := req.Config.Get(ctx, &data)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	var propDiags diag.Diagnostics
+	paramsGroupList := files_sdk.GroupListParams{}
+	paramsGroupList.Filter, propDiags = listFilterValue(ctx, path.Root("filter"), data.Filter)
+	diags.Append(propDiags...)
+	paramsGroupList.FilterPrefix, propDiags = listFilterValue(ctx, path.Root("filter_prefix"), data.FilterPrefix)
+	diags.Append(propDiags...)
+	paramsGroupList.Ids = data.Ids.ValueString()
+	if !data.IncludeParentSiteGroups.IsNull() && !data.IncludeParentSiteGroups.IsUnknown() {
+		paramsGroupList.IncludeParentSiteGroups = data.IncludeParentSiteGroups.ValueBoolPointer()
+	}
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	groupIt, err := r.client.List(paramsGroupList, files_sdk.WithContext(ctx))
+	if err != nil {
+		diags.AddError(
+			"Error Listing Files Groups",
+			"Could not list groups: "+err.Error(),
+		)
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	stream.Results = listResults(ctx, req, groupIt, "Error Listing Files Groups", "Could not list groups: ", func(group files_sdk.Group, result *list.ListResult) {
+		result.DisplayName = group.Name
+		result.Diagnostics.Append(result.Identity.SetAttribute(ctx, path.Root("id"), group.Id)...)
+	}, (&groupResource{}).populateResourceModel)
+}
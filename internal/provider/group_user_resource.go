@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
@@ -24,6 +25,7 @@ var (
 	_ resource.Resource                = &groupUserResource{}
 	_ resource.ResourceWithConfigure   = &groupUserResource{}
 	_ resource.ResourceWithImportState = &groupUserResource{}
+	_ resource.ResourceWithIdentity    = &groupUserResource{}
 )
 
 func NewGroupUserResource() resource.Resource {
@@ -47,18 +49,18 @@ func (r *groupUserResource) Configure(_ context.Context, req resource.ConfigureR
 		return
 	}
 
-	sdk_config, ok := req.ProviderData.(files_sdk.Config)
+	provider_data, ok := req.ProviderData.(providerResourceData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected files_sdk.Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected providerResourceData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.client = &group_user.Client{Config: sdk_config}
+	r.client = &group_user.Client{Config: provider_data.Config}
 }
 
 func (r *groupUserResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -104,6 +106,21 @@ func (r *groupUserResource) Schema(_ context.Context, _ resource.SchemaRequest,
 	}
 }
 
+func (r *groupUserResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"group_id": identityschema.Int64Attribute{
+				Description:       "Group ID",
+				RequiredForImport: true,
+			},
+			"user_id": identityschema.Int64Attribute{
+				Description:       "User ID",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
 func (r *groupUserResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan groupUserResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -146,6 +163,14 @@ func (r *groupUserResource) Create(ctx context.Context, req resource.CreateReque
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.Identity.SetAttribute(ctx, path.Root("group_id"), plan.GroupId)
+	resp.Diagnostics.Append(diags...)
+	diags = resp.Identity.SetAttribute(ctx, path.Root("user_id"), plan.UserId)
+	resp.Diagnostics.Append(diags...)
 }
 
 func (r *groupUserResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -208,6 +233,14 @@ func (r *groupUserResource) Read(ctx context.Context, req resource.ReadRequest,
 
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.Identity.SetAttribute(ctx, path.Root("group_id"), state.GroupId)
+	resp.Diagnostics.Append(diags...)
+	diags = resp.Identity.SetAttribute(ctx, path.Root("user_id"), state.UserId)
+	resp.Diagnostics.Append(diags...)
 }
 
 func (r *groupUserResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -240,6 +273,15 @@ func (r *groupUserResource) Delete(ctx context.Context, req resource.DeleteReque
 }
 
 func (r *groupUserResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID == "" {
+		var groupId, userId types.Int64
+		resp.Diagnostics.Append(req.Identity.GetAttribute(ctx, path.Root("group_id"), &groupId)...)
+		resp.Diagnostics.Append(req.Identity.GetAttribute(ctx, path.Root("user_id"), &userId)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("group_id"), groupId)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("user_id"), userId)...)
+		return
+	}
+
 	idParts := strings.SplitN(req.ID, ",", 2)
 
 	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
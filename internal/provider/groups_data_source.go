@@ -0,0 +1,150 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	files_sdk "github.com/Files-com/files-sdk-go/v3"
+	group "github.com/Files-com/files-sdk-go/v3/group"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &groupsDataSource{}
+	_ datasource.DataSourceWithConfigure = &groupsDataSource{}
+)
+
+func NewGroupsDataSource() datasource.DataSource {
+	return &groupsDataSource{}
+}
+
+type groupsDataSource struct {
+	client *group.Client
+}
+
+type groupsDataSourceModel struct {
+	SortBy                  types.Map    `tfsdk:"sort_by"`
+	Filter                  types.Map    `tfsdk:"filter"`
+	FilterPrefix            types.Map    `tfsdk:"filter_prefix"`
+	Ids                     types.String `tfsdk:"ids"`
+	IncludeParentSiteGroups types.Bool   `tfsdk:"include_parent_site_groups"`
+	Groups                  types.List   `tfsdk:"groups"`
+}
+
+func (r *groupsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	sdk_config, ok := req.ProviderData.(files_sdk.Config)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected files_sdk.Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = &group.Client{Config: sdk_config}
+}
+
+func (r *groupsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_groups"
+}
+
+func (r *groupsDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists every Group matching the given arguments. Filtering and sorting are performed by the Files.com API and all pages of results are returned.",
+		Attributes: map[string]schema.Attribute{
+			"sort_by": schema.MapAttribute{
+				Description: "If set, sort records by the specified field in either `asc` or `desc` direction.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"filter": schema.MapAttribute{
+				Description: "If set, return records where the specified field is equal to the supplied value.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"filter_prefix": schema.MapAttribute{
+				Description: "If set, return records where the specified field is prefixed by the supplied value.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"ids": schema.StringAttribute{
+				Description: "Comma-separated list of group ids to include in results.",
+				Optional:    true,
+			},
+			"include_parent_site_groups": schema.BoolAttribute{
+				Description: "Include groups from the parent site.",
+				Optional:    true,
+			},
+			"groups": schema.ListNestedAttribute{
+				Description: "Groups matching the given arguments.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: listItemAttributes(dataSourceSchema(ctx, NewGroupDataSource()).Attributes),
+				},
+			},
+		},
+	}
+}
+
+func (r *groupsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data groupsDataSourceModel
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var propDiags diag.Diagnostics
+	paramsGroupList := files_sdk.GroupListParams{}
+	paramsGroupList.SortBy, propDiags = listFilterValue(ctx, path.Root("sort_by"), data.SortBy)
+	resp.Diagnostics.Append(propDiags...)
+	paramsGroupList.Filter, propDiags = listFilterValue(ctx, path.Root("filter"), data.Filter)
+	resp.Diagnostics.Append(propDiags...)
+	paramsGroupList.FilterPrefix, propDiags = listFilterValue(ctx, path.Root("filter_prefix"), data.FilterPrefix)
+	resp.Diagnostics.Append(propDiags...)
+	paramsGroupList.Ids = data.Ids.ValueString()
+	if !data.IncludeParentSiteGroups.IsNull() && !data.IncludeParentSiteGroups.IsUnknown() {
+		paramsGroupList.IncludeParentSiteGroups = data.IncludeParentSiteGroups.ValueBoolPointer()
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	groupIt, err := r.client.List(paramsGroupList, files_sdk.WithContext(ctx))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Listing Files Groups",
+			"Could not list groups: "+err.Error(),
+		)
+		return
+	}
+
+	groups, err := listAll[files_sdk.Group](groupIt)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Listing Files Groups",
+			"Could not list groups: "+err.Error(),
+		)
+		return
+	}
+
+	data.Groups, diags = listItems(ctx, dataSourceSchema(ctx, NewGroupDataSource()).Attributes, groups, (&groupDataSource{}).populateDataSourceModel)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, data)
+	resp.Diagnostics.Append(diags...)
+}
@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 
@@ -28,6 +29,7 @@ var (
 	_ resource.Resource                 = &holidayCalendarResource{}
 	_ resource.ResourceWithConfigure    = &holidayCalendarResource{}
 	_ resource.ResourceWithImportState  = &holidayCalendarResource{}
+	_ resource.ResourceWithIdentity     = &holidayCalendarResource{}
 	_ resource.ResourceWithUpgradeState = &holidayCalendarResource{}
 )
 
@@ -60,18 +62,18 @@ func (r *holidayCalendarResource) Configure(_ context.Context, req resource.Conf
 		return
 	}
 
-	sdk_config, ok := req.ProviderData.(files_sdk.Config)
+	provider_data, ok := req.ProviderData.(providerResourceData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected files_sdk.Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected providerResourceData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.client = &holiday_calendar.Client{Config: sdk_config}
+	r.client = &holiday_calendar.Client{Config: provider_data.Config}
 }
 
 func (r *holidayCalendarResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -82,6 +84,17 @@ func (r *holidayCalendarResource) Schema(_ context.Context, _ resource.SchemaReq
 	resp.Schema = r.resourceSchema()
 }
 
+func (r *holidayCalendarResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.Int64Attribute{
+				Description:       "Holiday Calendar ID. Set a scheduled resource's `holiday_region` to `custom_` followed by this ID to make it skip the days in this calendar.",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
 func (r *holidayCalendarResource) resourceSchema() schema.Schema {
 	return schema.Schema{
 		Description: "A Holiday Calendar defines site-wide holiday dates and optional partial-day windows that scheduled resources skip.",
@@ -427,6 +440,12 @@ func (r *holidayCalendarResource) Create(ctx context.Context, req resource.Creat
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.Identity.SetAttribute(ctx, path.Root("id"), plan.Id)
+	resp.Diagnostics.Append(diags...)
 }
 
 func (r *holidayCalendarResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -462,6 +481,12 @@ func (r *holidayCalendarResource) Read(ctx context.Context, req resource.ReadReq
 
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.Identity.SetAttribute(ctx, path.Root("id"), state.Id)
+	resp.Diagnostics.Append(diags...)
 }
 
 func (r *holidayCalendarResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -514,6 +539,12 @@ func (r *holidayCalendarResource) Update(ctx context.Context, req resource.Updat
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.Identity.SetAttribute(ctx, path.Root("id"), plan.Id)
+	resp.Diagnostics.Append(diags...)
 }
 
 func (r *holidayCalendarResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -537,6 +568,13 @@ func (r *holidayCalendarResource) Delete(ctx context.Context, req resource.Delet
 }
 
 func (r *holidayCalendarResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID == "" {
+		var id types.Int64
+		resp.Diagnostics.Append(req.Identity.GetAttribute(ctx, path.Root("id"), &id)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+		return
+	}
+
 	idParts := strings.SplitN(req.ID, ",", 1)
 
 	if len(idParts) != 1 || idParts[0] == "" {
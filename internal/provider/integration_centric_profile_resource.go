@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
@@ -25,6 +26,8 @@ var (
 	_ resource.Resource                = &integrationCentricProfileResource{}
 	_ resource.ResourceWithConfigure   = &integrationCentricProfileResource{}
 	_ resource.ResourceWithImportState = &integrationCentricProfileResource{}
+	_ resource.ResourceWithIdentity    = &integrationCentricProfileResource{}
+	_ resource.ResourceWithModifyPlan  = &integrationCentricProfileResource{}
 )
 
 func NewIntegrationCentricProfileResource() resource.Resource {
@@ -32,7 +35,8 @@ func NewIntegrationCentricProfileResource() resource.Resource {
 }
 
 type integrationCentricProfileResource struct {
-	client *integration_centric_profile.Client
+	client             *integration_centric_profile.Client
+	defaultWorkspaceId types.Int64
 }
 
 type integrationCentricProfileResourceModel struct {
@@ -48,18 +52,19 @@ func (r *integrationCentricProfileResource) Configure(_ context.Context, req res
 		return
 	}
 
-	sdk_config, ok := req.ProviderData.(files_sdk.Config)
+	provider_data, ok := req.ProviderData.(providerResourceData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected files_sdk.Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected providerResourceData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.client = &integration_centric_profile.Client{Config: sdk_config}
+	r.client = &integration_centric_profile.Client{Config: provider_data.Config}
+	r.defaultWorkspaceId = provider_data.DefaultWorkspaceId
 }
 
 func (r *integrationCentricProfileResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -105,6 +110,21 @@ func (r *integrationCentricProfileResource) Schema(_ context.Context, _ resource
 	}
 }
 
+func (r *integrationCentricProfileResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.Int64Attribute{
+				Description:       "Integration Centric Profile ID",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+func (r *integrationCentricProfileResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyPlanDefaultWorkspaceId(ctx, r.defaultWorkspaceId, req, resp)
+}
+
 func (r *integrationCentricProfileResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan integrationCentricProfileResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -149,6 +169,12 @@ func (r *integrationCentricProfileResource) Create(ctx context.Context, req reso
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.Identity.SetAttribute(ctx, path.Root("id"), plan.Id)
+	resp.Diagnostics.Append(diags...)
 }
 
 func (r *integrationCentricProfileResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -184,6 +210,12 @@ func (r *integrationCentricProfileResource) Read(ctx context.Context, req resour
 
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.Identity.SetAttribute(ctx, path.Root("id"), state.Id)
+	resp.Diagnostics.Append(diags...)
 }
 
 func (r *integrationCentricProfileResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -240,6 +272,12 @@ func (r *integrationCentricProfileResource) Update(ctx context.Context, req reso
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.Identity.SetAttribute(ctx, path.Root("id"), plan.Id)
+	resp.Diagnostics.Append(diags...)
 }
 
 func (r *integrationCentricProfileResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -263,6 +301,13 @@ func (r *integrationCentricProfileResource) Delete(ctx context.Context, req reso
 }
 
 func (r *integrationCentricProfileResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID == "" {
+		var id types.Int64
+		resp.Diagnostics.Append(req.Identity.GetAttribute(ctx, path.Root("id"), &id)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+		return
+	}
+
 	idParts := strings.SplitN(req.ID, ",", 1)
 
 	if len(idParts) != 1 || idParts[0] == "" {
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
@@ -27,6 +28,8 @@ var (
 	_ resource.Resource                = &keyLifecycleRuleResource{}
 	_ resource.ResourceWithConfigure   = &keyLifecycleRuleResource{}
 	_ resource.ResourceWithImportState = &keyLifecycleRuleResource{}
+	_ resource.ResourceWithIdentity    = &keyLifecycleRuleResource{}
+	_ resource.ResourceWithModifyPlan  = &keyLifecycleRuleResource{}
 )
 
 func NewKeyLifecycleRuleResource() resource.Resource {
@@ -34,7 +37,8 @@ func NewKeyLifecycleRuleResource() resource.Resource {
 }
 
 type keyLifecycleRuleResource struct {
-	client *key_lifecycle_rule.Client
+	client             *key_lifecycle_rule.Client
+	defaultWorkspaceId types.Int64
 }
 
 type keyLifecycleRuleResourceModel struct {
@@ -52,18 +56,19 @@ func (r *keyLifecycleRuleResource) Configure(_ context.Context, req resource.Con
 		return
 	}
 
-	sdk_config, ok := req.ProviderData.(files_sdk.Config)
+	provider_data, ok := req.ProviderData.(providerResourceData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected files_sdk.Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected providerResourceData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.client = &key_lifecycle_rule.Client{Config: sdk_config}
+	r.client = &key_lifecycle_rule.Client{Config: provider_data.Config}
+	r.defaultWorkspaceId = provider_data.DefaultWorkspaceId
 }
 
 func (r *keyLifecycleRuleResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -136,6 +141,21 @@ func (r *keyLifecycleRuleResource) Schema(_ context.Context, _ resource.SchemaRe
 	}
 }
 
+func (r *keyLifecycleRuleResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.Int64Attribute{
+				Description:       "Key Lifecycle Rule ID",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+func (r *keyLifecycleRuleResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyPlanDefaultWorkspaceId(ctx, r.defaultWorkspaceId, req, resp)
+}
+
 func (r *keyLifecycleRuleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan keyLifecycleRuleResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -181,6 +201,12 @@ func (r *keyLifecycleRuleResource) Create(ctx context.Context, req resource.Crea
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.Identity.SetAttribute(ctx, path.Root("id"), plan.Id)
+	resp.Diagnostics.Append(diags...)
 }
 
 func (r *keyLifecycleRuleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -216,6 +242,12 @@ func (r *keyLifecycleRuleResource) Read(ctx context.Context, req resource.ReadRe
 
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.Identity.SetAttribute(ctx, path.Root("id"), state.Id)
+	resp.Diagnostics.Append(diags...)
 }
 
 func (r *keyLifecycleRuleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -276,6 +308,12 @@ func (r *keyLifecycleRuleResource) Update(ctx context.Context, req resource.Upda
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.Identity.SetAttribute(ctx, path.Root("id"), plan.Id)
+	resp.Diagnostics.Append(diags...)
 }
 
 func (r *keyLifecycleRuleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -299,6 +337,13 @@ func (r *keyLifecycleRuleResource) Delete(ctx context.Context, req resource.Dele
 }
 
 func (r *keyLifecycleRuleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID == "" {
+		var id types.Int64
+		resp.Diagnostics.Append(req.Identity.GetAttribute(ctx, path.Root("id"), &id)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+		return
+	}
+
 	idParts := strings.SplitN(req.ID, ",", 1)
 
 	if len(idParts) != 1 || idParts[0] == "" {
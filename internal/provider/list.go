@@ -0,0 +1,189 @@
+package provider
+
+import (
+	"context"
+	"iter"
+
+	"github.com/Files-com/terraform-provider-files/lib"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// listIterator is implemented by every SDK list iterator. Next follows the
+// pagination cursor, so draining it returns every matching record.
+type listIterator interface {
+	Next() bool
+	Current() interface{}
+	Err() error
+}
+
+func listAll[T any](it listIterator) ([]T, error) {
+	entries := []T{}
+	for it.Next() {
+		entries = append(entries, it.Current().(T))
+	}
+	return entries, it.Err()
+}
+
+func listFilterValue(ctx context.Context, path path.Path, source types.Map) (interface{}, diag.Diagnostics) {
+	if source.IsNull() || source.IsUnknown() {
+		return nil, nil
+	}
+	return lib.AttributeToInterface(ctx, path, source)
+}
+
+func dataSourceSchema(ctx context.Context, dataSource datasource.DataSource) schema.Schema {
+	resp := &datasource.SchemaResponse{}
+	dataSource.Schema(ctx, datasource.SchemaRequest{}, resp)
+	return resp.Schema
+}
+
+// listItemAttributes converts the attributes of a singular data source into
+// the read-only attributes of one element of a plural data source. Dynamic
+// attributes are dropped since Terraform does not allow them in collections.
+func listItemAttributes(attributes map[string]schema.Attribute) map[string]schema.Attribute {
+	items := map[string]schema.Attribute{}
+	for name, attribute := range attributes {
+		switch a := attribute.(type) {
+		case schema.DynamicAttribute:
+			continue
+		case schema.StringAttribute:
+			a.Required, a.Optional, a.Computed, a.Validators = false, false, true, nil
+			items[name] = a
+		case schema.Int64Attribute:
+			a.Required, a.Optional, a.Computed, a.Validators = false, false, true, nil
+			items[name] = a
+		case schema.BoolAttribute:
+			a.Required, a.Optional, a.Computed, a.Validators = false, false, true, nil
+			items[name] = a
+		default:
+			items[name] = attribute
+		}
+	}
+	return items
+}
+
+func listItemAttributeTypes(attributes map[string]schema.Attribute) map[string]attr.Type {
+	attributeTypes := map[string]attr.Type{}
+	for name, attribute := range attributes {
+		attributeTypes[name] = attribute.GetType()
+	}
+	return attributeTypes
+}
+
+// listItems populates a singular data source model for every entry and
+// collects the non-dynamic attributes of each into a list of objects.
+func listItems[T any, M any](ctx context.Context, attributes map[string]schema.Attribute, entries []T, populate func(context.Context, T, *M) diag.Diagnostics) (types.List, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	modelTypes := listItemAttributeTypes(attributes)
+	itemTypes := listItemAttributeTypes(listItemAttributes(attributes))
+
+	nullValues := map[string]attr.Value{}
+	for name, attributeType := range modelTypes {
+		value, err := attributeType.ValueFromTerraform(ctx, tftypes.NewValue(attributeType.TerraformType(ctx), nil))
+		if err != nil {
+			diags.AddError("Error Building Files List", "Could not build null value for "+name+": "+err.Error())
+			return types.ListNull(types.ObjectType{AttrTypes: itemTypes}), diags
+		}
+		nullValues[name] = value
+	}
+	nullModel, propDiags := types.ObjectValue(modelTypes, nullValues)
+	diags.Append(propDiags...)
+	if diags.HasError() {
+		return types.ListNull(types.ObjectType{AttrTypes: itemTypes}), diags
+	}
+
+	items := make([]attr.Value, 0, len(entries))
+	for _, entry := range entries {
+		var model M
+		diags.Append(nullModel.As(ctx, &model, basetypes.ObjectAsOptions{})...)
+		diags.Append(populate(ctx, entry, &model)...)
+		if diags.HasError() {
+			return types.ListNull(types.ObjectType{AttrTypes: itemTypes}), diags
+		}
+
+		object, propDiags := types.ObjectValueFrom(ctx, modelTypes, model)
+		diags.Append(propDiags...)
+		if diags.HasError() {
+			return types.ListNull(types.ObjectType{AttrTypes: itemTypes}), diags
+		}
+
+		values := map[string]attr.Value{}
+		for name := range itemTypes {
+			values[name] = object.Attributes()[name]
+		}
+		item, propDiags := types.ObjectValue(itemTypes, values)
+		diags.Append(propDiags...)
+		items = append(items, item)
+	}
+	if diags.HasError() {
+		return types.ListNull(types.ObjectType{AttrTypes: itemTypes}), diags
+	}
+
+	list, propDiags := types.ListValue(types.ObjectType{AttrTypes: itemTypes}, items)
+	diags.Append(propDiags...)
+	return list, diags
+}
+
+// listResults streams a list resource result for every entry of it, stopping
+// after req.Limit results. describe sets the display name and identity of a
+// result. When Terraform asks for the resources too, populate fills a resource
+// model that starts out with every attribute null, the same as a resource
+// being imported.
+func listResults[T any, M any](ctx context.Context, req list.ListRequest, it listIterator, errorSummary string, errorDetail string, describe func(T, *list.ListResult), populate func(context.Context, T, *M) diag.Diagnostics) iter.Seq[list.ListResult] {
+	return func(push func(list.ListResult) bool) {
+		for count := int64(0); req.Limit == 0 || count < req.Limit; count++ {
+			if !it.Next() {
+				break
+			}
+			entry := it.Current().(T)
+
+			result := req.NewListResult(ctx)
+			describe(entry, &result)
+			if req.IncludeResource && !result.Diagnostics.HasError() {
+				var model M
+				result.Diagnostics.Append(nullResourceModel(ctx, req, &model)...)
+				if !result.Diagnostics.HasError() {
+					result.Diagnostics.Append(populate(ctx, entry, &model)...)
+				}
+				if !result.Diagnostics.HasError() {
+					result.Diagnostics.Append(result.Resource.Set(ctx, model)...)
+				}
+			}
+
+			if !push(result) {
+				return
+			}
+		}
+
+		if err := it.Err(); err != nil {
+			var diags diag.Diagnostics
+			diags.AddError(errorSummary, errorDetail+err.Error())
+			push(list.ListResult{Diagnostics: diags})
+		}
+	}
+}
+
+// nullResourceModel sets model to the listed resource with every attribute
+// null, so attributes the API doesn't return keep a value of the right type.
+func nullResourceModel(ctx context.Context, req list.ListRequest, model any) diag.Diagnostics {
+	resourceType := req.ResourceSchema.Type().TerraformType(ctx).(tftypes.Object)
+	values := map[string]tftypes.Value{}
+	for name, attributeType := range resourceType.AttributeTypes {
+		values[name] = tftypes.NewValue(attributeType, nil)
+	}
+	resource := tfsdk.Resource{
+		Schema: req.ResourceSchema,
+		Raw:    tftypes.NewValue(resourceType, values),
+	}
+	return resource.Get(ctx, model)
+}
@@ -0,0 +1,38 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	files_sdk "github.com/Files-com/files-sdk-go/v3"
+	"github.com/Files-com/terraform-provider-files/lib"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListItems(t *testing.T) {
+	ctx := context.Background()
+	attributes := dataSourceSchema(ctx, NewFolderDataSource()).Attributes
+
+	items, diags := listItems(ctx, attributes, []files_sdk.File{
+		{Path: "partners/acme", Type: "directory", CustomMetadata: map[string]interface{}{"key": "value"}},
+		{Path: "partners/globex", Type: "directory"},
+	}, (&folderDataSource{}).populateDataSourceModel)
+	assert.False(t, diags.HasError(), diags)
+	assert.Len(t, items.Elements(), 2)
+
+	first := items.Elements()[0].(types.Object).Attributes()
+	assert.Equal(t, lib.NormalizedPathValue("partners/acme"), first["path"])
+	assert.Equal(t, types.StringValue("directory"), first["type"])
+	assert.NotContains(t, first, "custom_metadata")
+}
+
+func TestListItemAttributes(t *testing.T) {
+	attributes := listItemAttributes(dataSourceSchema(context.Background(), NewGroupDataSource()).Attributes)
+
+	for name, attribute := range attributes {
+		assert.True(t, attribute.IsComputed(), name)
+		assert.False(t, attribute.IsRequired(), name)
+		assert.False(t, attribute.IsOptional(), name)
+	}
+}
@@ -6,6 +6,7 @@ import (
 
 	files_sdk "github.com/Files-com/files-sdk-go/v3"
 	lock "github.com/Files-com/files-sdk-go/v3/lock"
+	"github.com/Files-com/terraform-provider-files/lib"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -27,18 +28,18 @@ type lockDataSource struct {
 }
 
 type lockDataSourceModel struct {
-	Path                 types.String `tfsdk:"path"`
-	Timeout              types.Int64  `tfsdk:"timeout"`
-	Depth                types.String `tfsdk:"depth"`
-	Recursive            types.Bool   `tfsdk:"recursive"`
-	Owner                types.String `tfsdk:"owner"`
-	Scope                types.String `tfsdk:"scope"`
-	Exclusive            types.Bool   `tfsdk:"exclusive"`
-	Token                types.String `tfsdk:"token"`
-	Type                 types.String `tfsdk:"type"`
-	AllowAccessByAnyUser types.Bool   `tfsdk:"allow_access_by_any_user"`
-	UserId               types.Int64  `tfsdk:"user_id"`
-	Username             types.String `tfsdk:"username"`
+	Path                 lib.NormalizedPath `tfsdk:"path"`
+	Timeout              types.Int64        `tfsdk:"timeout"`
+	Depth                types.String       `tfsdk:"depth"`
+	Recursive            types.Bool         `tfsdk:"recursive"`
+	Owner                types.String       `tfsdk:"owner"`
+	Scope                types.String       `tfsdk:"scope"`
+	Exclusive            types.Bool         `tfsdk:"exclusive"`
+	Token                types.String       `tfsdk:"token"`
+	Type                 types.String       `tfsdk:"type"`
+	AllowAccessByAnyUser types.Bool         `tfsdk:"allow_access_by_any_user"`
+	UserId               types.Int64        `tfsdk:"user_id"`
+	Username             types.String       `tfsdk:"username"`
 }
 
 func (r *lockDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -71,6 +72,7 @@ func (r *lockDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, r
 			"path": schema.StringAttribute{
 				Description: "Path. This must be slash-delimited, but it must neither start nor end with a slash. Maximum of 5000 characters.",
 				Required:    true,
+				CustomType:  lib.NormalizedPathType{},
 			},
 			"timeout": schema.Int64Attribute{
 				Description: "Lock timeout in seconds",
@@ -172,7 +174,7 @@ func (r *lockDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 }
 
 func (r *lockDataSource) populateDataSourceModel(ctx context.Context, lock files_sdk.Lock, state *lockDataSourceModel) (diags diag.Diagnostics) {
-	state.Path = types.StringValue(lock.Path)
+	state.Path = lib.NormalizedPathValue(lock.Path)
 	state.Timeout = types.Int64Value(lock.Timeout)
 	state.Depth = types.StringValue(lock.Depth)
 	state.Recursive = types.BoolPointerValue(lock.Recursive)
@@ -7,10 +7,12 @@ import (
 
 	files_sdk "github.com/Files-com/files-sdk-go/v3"
 	lock "github.com/Files-com/files-sdk-go/v3/lock"
+	"github.com/Files-com/terraform-provider-files/lib"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
@@ -24,6 +26,7 @@ var (
 	_ resource.Resource                = &lockResource{}
 	_ resource.ResourceWithConfigure   = &lockResource{}
 	_ resource.ResourceWithImportState = &lockResource{}
+	_ resource.ResourceWithIdentity    = &lockResource{}
 )
 
 func NewLockResource() resource.Resource {
@@ -35,18 +38,18 @@ type lockResource struct {
 }
 
 type lockResourceModel struct {
-	Path                 types.String `tfsdk:"path"`
-	Timeout              types.Int64  `tfsdk:"timeout"`
-	Recursive            types.Bool   `tfsdk:"recursive"`
-	Exclusive            types.Bool   `tfsdk:"exclusive"`
-	AllowAccessByAnyUser types.Bool   `tfsdk:"allow_access_by_any_user"`
-	Depth                types.String `tfsdk:"depth"`
-	Owner                types.String `tfsdk:"owner"`
-	Scope                types.String `tfsdk:"scope"`
-	Token                types.String `tfsdk:"token"`
-	Type                 types.String `tfsdk:"type"`
-	UserId               types.Int64  `tfsdk:"user_id"`
-	Username             types.String `tfsdk:"username"`
+	Path                 lib.NormalizedPath `tfsdk:"path"`
+	Timeout              types.Int64        `tfsdk:"timeout"`
+	Recursive            types.Bool         `tfsdk:"recursive"`
+	Exclusive            types.Bool         `tfsdk:"exclusive"`
+	AllowAccessByAnyUser types.Bool         `tfsdk:"allow_access_by_any_user"`
+	Depth                types.String       `tfsdk:"depth"`
+	Owner                types.String       `tfsdk:"owner"`
+	Scope                types.String       `tfsdk:"scope"`
+	Token                types.String       `tfsdk:"token"`
+	Type                 types.String       `tfsdk:"type"`
+	UserId               types.Int64        `tfsdk:"user_id"`
+	Username             types.String       `tfsdk:"username"`
 }
 
 func (r *lockResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -54,18 +57,18 @@ func (r *lockResource) Configure(_ context.Context, req resource.ConfigureReques
 		return
 	}
 
-	sdk_config, ok := req.ProviderData.(files_sdk.Config)
+	provider_data, ok := req.ProviderData.(providerResourceData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected files_sdk.Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected providerResourceData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.client = &lock.Client{Config: sdk_config}
+	r.client = &lock.Client{Config: provider_data.Config}
 }
 
 func (r *lockResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -83,6 +86,7 @@ func (r *lockResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 					stringplanmodifier.UseStateForUnknown(),
 					stringplanmodifier.RequiresReplace(),
 				},
+				CustomType: lib.NormalizedPathType{},
 			},
 			"timeout": schema.Int64Attribute{
 				Description: "Lock timeout in seconds",
@@ -149,6 +153,17 @@ func (r *lockResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 	}
 }
 
+func (r *lockResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"path": identityschema.StringAttribute{
+				Description:       "Path",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
 func (r *lockResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan lockResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -197,6 +212,12 @@ func (r *lockResource) Create(ctx context.Context, req resource.CreateRequest, r
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.Identity.SetAttribute(ctx, path.Root("path"), plan.Path.ValueString())
+	resp.Diagnostics.Append(diags...)
 }
 
 func (r *lockResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -258,6 +279,12 @@ func (r *lockResource) Read(ctx context.Context, req resource.ReadRequest, resp
 
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.Identity.SetAttribute(ctx, path.Root("path"), state.Path.ValueString())
+	resp.Diagnostics.Append(diags...)
 }
 
 func (r *lockResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -289,6 +316,13 @@ func (r *lockResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 }
 
 func (r *lockResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID == "" {
+		var identityPath types.String
+		resp.Diagnostics.Append(req.Identity.GetAttribute(ctx, path.Root("path"), &identityPath)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("path"), identityPath)...)
+		return
+	}
+
 	idParts := strings.SplitN(req.ID, ",", 1)
 
 	if len(idParts) != 1 || idParts[0] == "" {
@@ -304,7 +338,7 @@ func (r *lockResource) ImportState(ctx context.Context, req resource.ImportState
 }
 
 func (r *lockResource) populateResourceModel(ctx context.Context, lock files_sdk.Lock, state *lockResourceModel) (diags diag.Diagnostics) {
-	state.Path = types.StringValue(lock.Path)
+	state.Path = lib.NormalizedPathValue(lock.Path)
 	state.Timeout = types.Int64Value(lock.Timeout)
 	state.Depth = types.StringValue(lock.Depth)
 	state.Recursive = types.BoolPointerValue(lock.Recursive)
@@ -0,0 +1,69 @@
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"log"
+	"sync"
+
+	files_sdk "github.com/Files-com/files-sdk-go/v3"
+	session "github.com/Files-com/files-sdk-go/v3/session"
+)
+
+// loginSessions holds the sessions created for username and password
+// authentication. Each set of credentials logs in once per provider process,
+// however often the provider is configured in it, and the session is logged
+// out by CloseSessions when the process is done. Terraform starts a new
+// provider process for each command, so commands don't share a session.
+var loginSessions = &loginSessionCache{sessions: map[string]files_sdk.Config{}}
+
+type loginSessionCache struct {
+	mu sync.Mutex
+	// sessions maps a hash of the endpoint and credentials to the config used
+	// to log the session out.
+	sessions map[string]files_sdk.Config
+}
+
+// login returns the session for username on config's endpoint, creating it on
+// first use.
+func (c *loginSessionCache) login(ctx context.Context, config files_sdk.Config, username string, password string) (string, error) {
+	key := sha256.Sum256([]byte(config.Endpoint() + "\x00" + username + "\x00" + password))
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if existing, ok := c.sessions[hex.EncodeToString(key[:])]; ok {
+		return existing.SessionId, nil
+	}
+
+	paramsSessionCreate := files_sdk.SessionCreateParams{}
+	paramsSessionCreate.Username = username
+	paramsSessionCreate.Password = password
+	created, err := (&session.Client{Config: config}).Create(paramsSessionCreate, files_sdk.WithContext(ctx), withoutAPIKey())
+	if err != nil {
+		return "", err
+	}
+
+	config.APIKey = ""
+	config.SessionId = created.Id
+	c.sessions[hex.EncodeToString(key[:])] = config
+	return created.Id, nil
+}
+
+// close logs out every session and forgets it.
+func (c *loginSessionCache) close(ctx context.Context) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for key, config := range c.sessions {
+		err := (&session.Client{Config: config}).Delete(files_sdk.WithContext(ctx), withoutAPIKey())
+		if err != nil && !files_sdk.IsNotExist(err) {
+			log.Printf("[WARN] Could not log out of Files.com session: %s", err)
+		}
+		delete(c.sessions, key)
+	}
+}
+
+// CloseSessions logs out the sessions the provider created for username and
+// password authentication. Call it once the provider has stopped serving.
+func CloseSessions(ctx context.Context) {
+	loginSessions.close(ctx)
+}
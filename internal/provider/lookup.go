@@ -0,0 +1,81 @@
+package provider
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// lookupByKey drains it and returns the single entry whose natural key, as
+// returned by key, equals value. Files.com treats names, usernames and email
+// addresses as case-insensitive, so the comparison is too. noun and attribute
+// are only used to describe a missing or ambiguous match, which is reported
+// against the attribute that was used for the lookup.
+func lookupByKey[T any](it listIterator, summary string, noun string, attribute string, value string, key func(T) string, id func(T) int64) (entry T, diags diag.Diagnostics) {
+	entries, err := listAll[T](it)
+	if err != nil {
+		diags.AddError(
+			summary,
+			"Could not list "+noun+"s to find "+attribute+" "+fmt.Sprintf("%q", value)+": "+err.Error(),
+		)
+		return
+	}
+
+	matches := keyMatches(entries, value, key)
+	switch len(matches) {
+	case 0:
+		diags.AddAttributeError(
+			path.Root(attribute),
+			summary,
+			fmt.Sprintf("No %s with %s %q was found.", noun, attribute, value),
+		)
+	case 1:
+		entry = matches[0]
+	default:
+		diags.AddAttributeError(
+			path.Root(attribute),
+			summary,
+			fmt.Sprintf("Found %d %ss with %s %q (ids %s). Set `id` instead to select one of them.", len(matches), noun, attribute, value, joinIds(matches, id)),
+		)
+	}
+	return
+}
+
+// importByKey is lookupByKey for ImportState, which has no attribute to report
+// against. It returns the ID of the single entry whose natural key equals
+// value, or an error describing why there isn't one.
+func importByKey[T any](it listIterator, noun string, attribute string, value string, key func(T) string, id func(T) int64) (int64, error) {
+	entries, err := listAll[T](it)
+	if err != nil {
+		return 0, fmt.Errorf("could not list %ss: %w", noun, err)
+	}
+
+	matches := keyMatches(entries, value, key)
+	switch len(matches) {
+	case 0:
+		return 0, fmt.Errorf("no %s with %s %q was found", noun, attribute, value)
+	case 1:
+		return id(matches[0]), nil
+	}
+	return 0, fmt.Errorf("found %d %ss with %s %q (ids %s), import one of them by id instead", len(matches), noun, attribute, value, joinIds(matches, id))
+}
+
+func keyMatches[T any](entries []T, value string, key func(T) string) []T {
+	matches := []T{}
+	for _, e := range entries {
+		if strings.EqualFold(key(e), value) {
+			matches = append(matches, e)
+		}
+	}
+	return matches
+}
+
+func joinIds[T any](entries []T, id func(T) int64) string {
+	ids := []string{}
+	for _, e := range entries {
+		ids = append(ids, fmt.Sprint(id(e)))
+	}
+	return strings.Join(ids, ", ")
+}
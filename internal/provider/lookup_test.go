@@ -0,0 +1,68 @@
+package provider
+
+import (
+	"testing"
+
+	files_sdk "github.com/Files-com/files-sdk-go/v3"
+	"github.com/stretchr/testify/assert"
+)
+
+type sliceIterator struct {
+	entries []interface{}
+	index   int
+}
+
+func (i *sliceIterator) Next() bool {
+	i.index++
+	return i.index <= len(i.entries)
+}
+
+func (i *sliceIterator) Current() interface{} { return i.entries[i.index-1] }
+
+func (i *sliceIterator) Err() error { return nil }
+
+func TestLookupByKey(t *testing.T) {
+	groups := func() listIterator {
+		return &sliceIterator{entries: []interface{}{
+			files_sdk.Group{Id: 1, Name: "Admins"},
+			files_sdk.Group{Id: 2, Name: "partners"},
+			files_sdk.Group{Id: 3, Name: "Partners"},
+		}}
+	}
+	name := func(g files_sdk.Group) string { return g.Name }
+	id := func(g files_sdk.Group) int64 { return g.Id }
+
+	match, diags := lookupByKey(groups(), "Error Reading Files Group", "group", "name", "admins", name, id)
+	assert.False(t, diags.HasError(), diags)
+	assert.Equal(t, int64(1), match.Id)
+
+	_, diags = lookupByKey(groups(), "Error Reading Files Group", "group", "name", "missing", name, id)
+	assert.True(t, diags.HasError())
+	assert.Equal(t, `No group with name "missing" was found.`, diags[0].Detail())
+
+	_, diags = lookupByKey(groups(), "Error Reading Files Group", "group", "name", "partners", name, id)
+	assert.True(t, diags.HasError())
+	assert.Contains(t, diags[0].Detail(), "Found 2 groups with name \"partners\" (ids 2, 3)")
+}
+
+func TestImportByKey(t *testing.T) {
+	users := func() listIterator {
+		return &sliceIterator{entries: []interface{}{
+			files_sdk.User{Id: 1, Username: "alice"},
+			files_sdk.User{Id: 2, Username: "Bob"},
+			files_sdk.User{Id: 3, Username: "bob"},
+		}}
+	}
+	username := func(u files_sdk.User) string { return u.Username }
+	id := func(u files_sdk.User) int64 { return u.Id }
+
+	match, err := importByKey(users(), "user", "username", "Alice", username, id)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), match)
+
+	_, err = importByKey(users(), "user", "username", "carol", username, id)
+	assert.EqualError(t, err, `no user with username "carol" was found`)
+
+	_, err = importByKey(users(), "user", "username", "bob", username, id)
+	assert.EqualError(t, err, `found 2 users with username "bob" (ids 2, 3), import one of them by id instead`)
+}
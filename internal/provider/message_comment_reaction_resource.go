@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 
@@ -24,6 +25,7 @@ var (
 	_ resource.Resource                = &messageCommentReactionResource{}
 	_ resource.ResourceWithConfigure   = &messageCommentReactionResource{}
 	_ resource.ResourceWithImportState = &messageCommentReactionResource{}
+	_ resource.ResourceWithIdentity    = &messageCommentReactionResource{}
 )
 
 func NewMessageCommentReactionResource() resource.Resource {
@@ -45,18 +47,18 @@ func (r *messageCommentReactionResource) Configure(_ context.Context, req resour
 		return
 	}
 
-	sdk_config, ok := req.ProviderData.(files_sdk.Config)
+	provider_data, ok := req.ProviderData.(providerResourceData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected files_sdk.Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected providerResourceData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.client = &message_comment_reaction.Client{Config: sdk_config}
+	r.client = &message_comment_reaction.Client{Config: provider_data.Config}
 }
 
 func (r *messageCommentReactionResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -92,6 +94,17 @@ func (r *messageCommentReactionResource) Schema(_ context.Context, _ resource.Sc
 	}
 }
 
+func (r *messageCommentReactionResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.Int64Attribute{
+				Description:       "Reaction ID",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
 func (r *messageCommentReactionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan messageCommentReactionResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -131,6 +144,12 @@ func (r *messageCommentReactionResource) Create(ctx context.Context, req resourc
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.Identity.SetAttribute(ctx, path.Root("id"), plan.Id)
+	resp.Diagnostics.Append(diags...)
 }
 
 func (r *messageCommentReactionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -166,6 +185,12 @@ func (r *messageCommentReactionResource) Read(ctx context.Context, req resource.
 
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.Identity.SetAttribute(ctx, path.Root("id"), state.Id)
+	resp.Diagnostics.Append(diags...)
 }
 
 func (r *messageCommentReactionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -196,6 +221,13 @@ func (r *messageCommentReactionResource) Delete(ctx context.Context, req resourc
 }
 
 func (r *messageCommentReactionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID == "" {
+		var id types.Int64
+		resp.Diagnostics.Append(req.Identity.GetAttribute(ctx, path.Root("id"), &id)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+		return
+	}
+
 	idParts := strings.SplitN(req.ID, ",", 1)
 
 	if len(idParts) != 1 || idParts[0] == "" {
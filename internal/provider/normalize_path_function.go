@@ -0,0 +1,46 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/Files-com/terraform-provider-files/lib"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var (
+	_ function.Function = &normalizePathFunction{}
+)
+
+func NewNormalizePathFunction() function.Function {
+	return &normalizePathFunction{}
+}
+
+type normalizePathFunction struct{}
+
+func (f *normalizePathFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "normalize_path"
+}
+
+func (f *normalizePathFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Normalize a path the way Files.com compares paths",
+		Description: "Returns the key Files.com uses to decide whether two paths refer to the same file or folder. Backslashes become slashes, empty, `.` and `..` segments are removed, accents are stripped, the path is lowercased, and trailing whitespace is trimmed. Two paths refer to the same file or folder when their normalized forms are equal.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "path",
+				Description: "The path to normalize.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *normalizePathFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var path string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &path))
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, lib.NormalizePathForComparison(path)))
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
@@ -29,6 +30,8 @@ var (
 	_ resource.Resource                = &notificationResource{}
 	_ resource.ResourceWithConfigure   = &notificationResource{}
 	_ resource.ResourceWithImportState = &notificationResource{}
+	_ resource.ResourceWithIdentity    = &notificationResource{}
+	_ resource.ResourceWithModifyPlan  = &notificationResource{}
 )
 
 func NewNotificationResource() resource.Resource {
@@ -36,36 +39,37 @@ func NewNotificationResource() resource.Resource {
 }
 
 type notificationResource struct {
-	client *notification.Client
+	client             *notification.Client
+	defaultWorkspaceId types.Int64
 }
 
 type notificationResourceModel struct {
-	Path                     types.String `tfsdk:"path"`
-	GroupId                  types.Int64  `tfsdk:"group_id"`
-	GroupIds                 types.List   `tfsdk:"group_ids"`
-	TriggeringGroupIds       types.List   `tfsdk:"triggering_group_ids"`
-	TriggeringUserIds        types.List   `tfsdk:"triggering_user_ids"`
-	TriggerByShareRecipients types.Bool   `tfsdk:"trigger_by_share_recipients"`
-	NotifyUserActions        types.Bool   `tfsdk:"notify_user_actions"`
-	NotifyOnCopy             types.Bool   `tfsdk:"notify_on_copy"`
-	NotifyOnDelete           types.Bool   `tfsdk:"notify_on_delete"`
-	NotifyOnDownload         types.Bool   `tfsdk:"notify_on_download"`
-	NotifyOnMove             types.Bool   `tfsdk:"notify_on_move"`
-	NotifyOnUpload           types.Bool   `tfsdk:"notify_on_upload"`
-	Recursive                types.Bool   `tfsdk:"recursive"`
-	SendInterval             types.String `tfsdk:"send_interval"`
-	Subject                  types.String `tfsdk:"subject"`
-	Message                  types.String `tfsdk:"message"`
-	TriggeringFilenames      types.List   `tfsdk:"triggering_filenames"`
-	WorkspaceId              types.Int64  `tfsdk:"workspace_id"`
-	UserId                   types.Int64  `tfsdk:"user_id"`
-	Username                 types.String `tfsdk:"username"`
-	Id                       types.Int64  `tfsdk:"id"`
-	GroupName                types.String `tfsdk:"group_name"`
-	GroupNames               types.List   `tfsdk:"group_names"`
-	Unsubscribed             types.Bool   `tfsdk:"unsubscribed"`
-	UnsubscribedReason       types.String `tfsdk:"unsubscribed_reason"`
-	SuppressedEmail          types.Bool   `tfsdk:"suppressed_email"`
+	Path                     lib.NormalizedPath `tfsdk:"path"`
+	GroupId                  types.Int64        `tfsdk:"group_id"`
+	GroupIds                 types.List         `tfsdk:"group_ids"`
+	TriggeringGroupIds       types.List         `tfsdk:"triggering_group_ids"`
+	TriggeringUserIds        types.List         `tfsdk:"triggering_user_ids"`
+	TriggerByShareRecipients types.Bool         `tfsdk:"trigger_by_share_recipients"`
+	NotifyUserActions        types.Bool         `tfsdk:"notify_user_actions"`
+	NotifyOnCopy             types.Bool         `tfsdk:"notify_on_copy"`
+	NotifyOnDelete           types.Bool         `tfsdk:"notify_on_delete"`
+	NotifyOnDownload         types.Bool         `tfsdk:"notify_on_download"`
+	NotifyOnMove             types.Bool         `tfsdk:"notify_on_move"`
+	NotifyOnUpload           types.Bool         `tfsdk:"notify_on_upload"`
+	Recursive                types.Bool         `tfsdk:"recursive"`
+	SendInterval             types.String       `tfsdk:"send_interval"`
+	Subject                  types.String       `tfsdk:"subject"`
+	Message                  types.String       `tfsdk:"message"`
+	TriggeringFilenames      types.List         `tfsdk:"triggering_filenames"`
+	WorkspaceId              types.Int64        `tfsdk:"workspace_id"`
+	UserId                   types.Int64        `tfsdk:"user_id"`
+	Username                 types.String       `tfsdk:"username"`
+	Id                       types.Int64        `tfsdk:"id"`
+	GroupName                types.String       `tfsdk:"group_name"`
+	GroupNames               types.List         `tfsdk:"group_names"`
+	Unsubscribed             types.Bool         `tfsdk:"unsubscribed"`
+	UnsubscribedReason       types.String       `tfsdk:"unsubscribed_reason"`
+	SuppressedEmail          types.Bool         `tfsdk:"suppressed_email"`
 }
 
 func (r *notificationResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -73,18 +77,19 @@ func (r *notificationResource) Configure(_ context.Context, req resource.Configu
 		return
 	}
 
-	sdk_config, ok := req.ProviderData.(files_sdk.Config)
+	provider_data, ok := req.ProviderData.(providerResourceData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected files_sdk.Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected providerResourceData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.client = &notification.Client{Config: sdk_config}
+	r.client = &notification.Client{Config: provider_data.Config}
+	r.defaultWorkspaceId = provider_data.DefaultWorkspaceId
 }
 
 func (r *notificationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -103,6 +108,7 @@ func (r *notificationResource) Schema(_ context.Context, _ resource.SchemaReques
 					stringplanmodifier.UseStateForUnknown(),
 					stringplanmodifier.RequiresReplace(),
 				},
+				CustomType: lib.NormalizedPathType{},
 			},
 			"group_id": schema.Int64Attribute{
 				Description: "ID of Group to receive notifications",
@@ -302,6 +308,21 @@ func (r *notificationResource) Schema(_ context.Context, _ resource.SchemaReques
 	}
 }
 
+func (r *notificationResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.Int64Attribute{
+				Description:       "Notification ID",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+func (r *notificationResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyPlanDefaultWorkspaceId(ctx, r.defaultWorkspaceId, req, resp)
+}
+
 func (r *notificationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan notificationResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -385,6 +406,12 @@ func (r *notificationResource) Create(ctx context.Context, req resource.CreateRe
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.Identity.SetAttribute(ctx, path.Root("id"), plan.Id)
+	resp.Diagnostics.Append(diags...)
 }
 
 func (r *notificationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -420,6 +447,12 @@ func (r *notificationResource) Read(ctx context.Context, req resource.ReadReques
 
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.Identity.SetAttribute(ctx, path.Root("id"), state.Id)
+	resp.Diagnostics.Append(diags...)
 }
 
 func (r *notificationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -516,6 +549,12 @@ func (r *notificationResource) Update(ctx context.Context, req resource.UpdateRe
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.Identity.SetAttribute(ctx, path.Root("id"), plan.Id)
+	resp.Diagnostics.Append(diags...)
 }
 
 func (r *notificationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -539,6 +578,13 @@ func (r *notificationResource) Delete(ctx context.Context, req resource.DeleteRe
 }
 
 func (r *notificationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID == "" {
+		var id types.Int64
+		resp.Diagnostics.Append(req.Identity.GetAttribute(ctx, path.Root("id"), &id)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+		return
+	}
+
 	idParts := strings.SplitN(req.ID, ",", 1)
 
 	if len(idParts) != 1 || idParts[0] == "" {
@@ -565,7 +611,7 @@ func (r *notificationResource) populateResourceModel(ctx context.Context, notifi
 	var propDiags diag.Diagnostics
 
 	state.Id = types.Int64Value(notification.Id)
-	state.Path = types.StringValue(notification.Path)
+	state.Path = lib.NormalizedPathValue(notification.Path)
 	state.GroupId = types.Int64Value(notification.GroupId)
 	state.GroupName = types.StringValue(notification.GroupName)
 	state.GroupIds, propDiags = types.ListValueFrom(ctx, types.Int64Type, notification.GroupIds)
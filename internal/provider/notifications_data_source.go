@@ -0,0 +1,156 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	files_sdk "github.com/Files-com/files-sdk-go/v3"
+	notification "github.com/Files-com/files-sdk-go/v3/notification"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &notificationsDataSource{}
+	_ datasource.DataSourceWithConfigure = &notificationsDataSource{}
+)
+
+func NewNotificationsDataSource() datasource.DataSource {
+	return &notificationsDataSource{}
+}
+
+type notificationsDataSource struct {
+	client *notification.Client
+}
+
+type notificationsDataSourceModel struct {
+	SortBy           types.Map    `tfsdk:"sort_by"`
+	Filter           types.Map    `tfsdk:"filter"`
+	FilterPrefix     types.Map    `tfsdk:"filter_prefix"`
+	Path             types.String `tfsdk:"path"`
+	IncludeAncestors types.Bool   `tfsdk:"include_ancestors"`
+	GroupId          types.String `tfsdk:"group_id"`
+	Notifications    types.List   `tfsdk:"notifications"`
+}
+
+func (r *notificationsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	sdk_config, ok := req.ProviderData.(files_sdk.Config)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected files_sdk.Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = &notification.Client{Config: sdk_config}
+}
+
+func (r *notificationsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_notifications"
+}
+
+func (r *notificationsDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists every Notification matching the given arguments. Filtering and sorting are performed by the Files.com API and all pages of results are returned.",
+		Attributes: map[string]schema.Attribute{
+			"sort_by": schema.MapAttribute{
+				Description: "If set, sort records by the specified field in either `asc` or `desc` direction.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"filter": schema.MapAttribute{
+				Description: "If set, return records where the specified field is equal to the supplied value.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"filter_prefix": schema.MapAttribute{
+				Description: "If set, return records where the specified field is prefixed by the supplied value.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"path": schema.StringAttribute{
+				Description: "Show notifications for this Path.",
+				Optional:    true,
+			},
+			"include_ancestors": schema.BoolAttribute{
+				Description: "If `include_ancestors` is `true` and `path` is specified, include notifications for any parent paths. Ignored if `path` is not specified.",
+				Optional:    true,
+			},
+			"group_id": schema.StringAttribute{
+				Description: "If set, return records where the specified field is equal to the supplied value.",
+				Optional:    true,
+			},
+			"notifications": schema.ListNestedAttribute{
+				Description: "Notifications matching the given arguments.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: listItemAttributes(dataSourceSchema(ctx, NewNotificationDataSource()).Attributes),
+				},
+			},
+		},
+	}
+}
+
+func (r *notificationsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data notificationsDataSourceModel
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var propDiags diag.Diagnostics
+	paramsNotificationList := files_sdk.NotificationListParams{}
+	paramsNotificationList.SortBy, propDiags = listFilterValue(ctx, path.Root("sort_by"), data.SortBy)
+	resp.Diagnostics.Append(propDiags...)
+	paramsNotificationList.Filter, propDiags = listFilterValue(ctx, path.Root("filter"), data.Filter)
+	resp.Diagnostics.Append(propDiags...)
+	paramsNotificationList.FilterPrefix, propDiags = listFilterValue(ctx, path.Root("filter_prefix"), data.FilterPrefix)
+	resp.Diagnostics.Append(propDiags...)
+	paramsNotificationList.Path = data.Path.ValueString()
+	if !data.IncludeAncestors.IsNull() && !data.IncludeAncestors.IsUnknown() {
+		paramsNotificationList.IncludeAncestors = data.IncludeAncestors.ValueBoolPointer()
+	}
+	paramsNotificationList.GroupId = data.GroupId.ValueString()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	notificationIt, err := r.client.List(paramsNotificationList, files_sdk.WithContext(ctx))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Listing Files Notifications",
+			"Could not list notifications: "+err.Error(),
+		)
+		return
+	}
+
+	notifications, err := listAll[files_sdk.Notification](notificationIt)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Listing Files Notifications",
+			"Could not list notifications: "+err.Error(),
+		)
+		return
+	}
+
+	data.Notifications, diags = listItems(ctx, dataSourceSchema(ctx, NewNotificationDataSource()).Attributes, notifications, (&notificationDataSource{}).populateDataSourceModel)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, data)
+	resp.Diagnostics.Append(diags...)
+}
@@ -8,10 +8,12 @@ import (
 
 	files_sdk "github.com/Files-com/files-sdk-go/v3"
 	partner_channel "github.com/Files-com/files-sdk-go/v3/partnerchannel"
+	"github.com/Files-com/terraform-provider-files/lib"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
@@ -25,6 +27,8 @@ var (
 	_ resource.Resource                = &partnerChannelResource{}
 	_ resource.ResourceWithConfigure   = &partnerChannelResource{}
 	_ resource.ResourceWithImportState = &partnerChannelResource{}
+	_ resource.ResourceWithIdentity    = &partnerChannelResource{}
+	_ resource.ResourceWithModifyPlan  = &partnerChannelResource{}
 )
 
 func NewPartnerChannelResource() resource.Resource {
@@ -32,26 +36,27 @@ func NewPartnerChannelResource() resource.Resource {
 }
 
 type partnerChannelResource struct {
-	client *partner_channel.Client
+	client             *partner_channel.Client
+	defaultWorkspaceId types.Int64
 }
 
 type partnerChannelResourceModel struct {
-	PartnerId                      types.Int64  `tfsdk:"partner_id"`
-	Path                           types.String `tfsdk:"path"`
-	WorkspaceId                    types.Int64  `tfsdk:"workspace_id"`
-	ToPartnerFolderName            types.String `tfsdk:"to_partner_folder_name"`
-	FromPartnerFolderName          types.String `tfsdk:"from_partner_folder_name"`
-	FromPartnerRoutePath           types.String `tfsdk:"from_partner_route_path"`
-	ToPartnerRoutePath             types.String `tfsdk:"to_partner_route_path"`
-	ToPartnerManagedFolderPaths    types.List   `tfsdk:"to_partner_managed_folder_paths"`
-	FromPartnerManagedFolderPaths  types.List   `tfsdk:"from_partner_managed_folder_paths"`
-	Id                             types.Int64  `tfsdk:"id"`
-	PartnerChannelTemplateId       types.Int64  `tfsdk:"partner_channel_template_id"`
-	EffectiveToPartnerFolderName   types.String `tfsdk:"effective_to_partner_folder_name"`
-	EffectiveFromPartnerFolderName types.String `tfsdk:"effective_from_partner_folder_name"`
-	ChannelPath                    types.String `tfsdk:"channel_path"`
-	ToPartnerFolderPath            types.String `tfsdk:"to_partner_folder_path"`
-	FromPartnerFolderPath          types.String `tfsdk:"from_partner_folder_path"`
+	PartnerId                      types.Int64        `tfsdk:"partner_id"`
+	Path                           lib.NormalizedPath `tfsdk:"path"`
+	WorkspaceId                    types.Int64        `tfsdk:"workspace_id"`
+	ToPartnerFolderName            types.String       `tfsdk:"to_partner_folder_name"`
+	FromPartnerFolderName          types.String       `tfsdk:"from_partner_folder_name"`
+	FromPartnerRoutePath           lib.NormalizedPath `tfsdk:"from_partner_route_path"`
+	ToPartnerRoutePath             lib.NormalizedPath `tfsdk:"to_partner_route_path"`
+	ToPartnerManagedFolderPaths    types.List         `tfsdk:"to_partner_managed_folder_paths"`
+	FromPartnerManagedFolderPaths  types.List         `tfsdk:"from_partner_managed_folder_paths"`
+	Id                             types.Int64        `tfsdk:"id"`
+	PartnerChannelTemplateId       types.Int64        `tfsdk:"partner_channel_template_id"`
+	EffectiveToPartnerFolderName   types.String       `tfsdk:"effective_to_partner_folder_name"`
+	EffectiveFromPartnerFolderName types.String       `tfsdk:"effective_from_partner_folder_name"`
+	ChannelPath                    lib.NormalizedPath `tfsdk:"channel_path"`
+	ToPartnerFolderPath            lib.NormalizedPath `tfsdk:"to_partner_folder_path"`
+	FromPartnerFolderPath          lib.NormalizedPath `tfsdk:"from_partner_folder_path"`
 }
 
 func (r *partnerChannelResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -59,18 +64,19 @@ func (r *partnerChannelResource) Configure(_ context.Context, req resource.Confi
 		return
 	}
 
-	sdk_config, ok := req.ProviderData.(files_sdk.Config)
+	provider_data, ok := req.ProviderData.(providerResourceData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected files_sdk.Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected providerResourceData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.client = &partner_channel.Client{Config: sdk_config}
+	r.client = &partner_channel.Client{Config: provider_data.Config}
+	r.defaultWorkspaceId = provider_data.DefaultWorkspaceId
 }
 
 func (r *partnerChannelResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -91,6 +97,7 @@ func (r *partnerChannelResource) Schema(_ context.Context, _ resource.SchemaRequ
 			"path": schema.StringAttribute{
 				Description: "Channel path relative to the Partner root folder. This must be slash-delimited, but it must neither start nor end with a slash. Maximum of 5000 characters.",
 				Required:    true,
+				CustomType:  lib.NormalizedPathType{},
 			},
 			"workspace_id": schema.Int64Attribute{
 				Description: "ID of the Workspace associated with this Partner Channel.",
@@ -124,6 +131,7 @@ func (r *partnerChannelResource) Schema(_ context.Context, _ resource.SchemaRequ
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
 				},
+				CustomType: lib.NormalizedPathType{},
 			},
 			"to_partner_route_path": schema.StringAttribute{
 				Description: "Optional route path for files delivered to the Partner.",
@@ -132,12 +140,13 @@ func (r *partnerChannelResource) Schema(_ context.Context, _ resource.SchemaRequ
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
 				},
+				CustomType: lib.NormalizedPathType{},
 			},
 			"to_partner_managed_folder_paths": schema.ListAttribute{
 				Description: "Managed folder paths inside the to-Partner folder.",
 				Computed:    true,
 				Optional:    true,
-				ElementType: types.StringType,
+				ElementType: lib.NormalizedPathType{},
 				PlanModifiers: []planmodifier.List{
 					listplanmodifier.UseStateForUnknown(),
 				},
@@ -146,7 +155,7 @@ func (r *partnerChannelResource) Schema(_ context.Context, _ resource.SchemaRequ
 				Description: "Managed folder paths inside the from-Partner folder.",
 				Computed:    true,
 				Optional:    true,
-				ElementType: types.StringType,
+				ElementType: lib.NormalizedPathType{},
 				PlanModifiers: []planmodifier.List{
 					listplanmodifier.UseStateForUnknown(),
 				},
@@ -173,19 +182,37 @@ func (r *partnerChannelResource) Schema(_ context.Context, _ resource.SchemaRequ
 			"channel_path": schema.StringAttribute{
 				Description: "Resolved Channel folder path.",
 				Computed:    true,
+				CustomType:  lib.NormalizedPathType{},
 			},
 			"to_partner_folder_path": schema.StringAttribute{
 				Description: "Resolved to-Partner folder path.",
 				Computed:    true,
+				CustomType:  lib.NormalizedPathType{},
 			},
 			"from_partner_folder_path": schema.StringAttribute{
 				Description: "Resolved from-Partner folder path.",
 				Computed:    true,
+				CustomType:  lib.NormalizedPathType{},
 			},
 		},
 	}
 }
 
+func (r *partnerChannelResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.Int64Attribute{
+				Description:       "The unique ID of the Partner Channel.",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+func (r *partnerChannelResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyPlanDefaultWorkspaceId(ctx, r.defaultWorkspaceId, req, resp)
+}
+
 func (r *partnerChannelResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan partnerChannelResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -238,6 +265,12 @@ func (r *partnerChannelResource) Create(ctx context.Context, req resource.Create
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.Identity.SetAttribute(ctx, path.Root("id"), plan.Id)
+	resp.Diagnostics.Append(diags...)
 }
 
 func (r *partnerChannelResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -273,6 +306,12 @@ func (r *partnerChannelResource) Read(ctx context.Context, req resource.ReadRequ
 
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.Identity.SetAttribute(ctx, path.Root("id"), state.Id)
+	resp.Diagnostics.Append(diags...)
 }
 
 func (r *partnerChannelResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -342,6 +381,12 @@ func (r *partnerChannelResource) Update(ctx context.Context, req resource.Update
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.Identity.SetAttribute(ctx, path.Root("id"), plan.Id)
+	resp.Diagnostics.Append(diags...)
 }
 
 func (r *partnerChannelResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -365,6 +410,13 @@ func (r *partnerChannelResource) Delete(ctx context.Context, req resource.Delete
 }
 
 func (r *partnerChannelResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID == "" {
+		var id types.Int64
+		resp.Diagnostics.Append(req.Identity.GetAttribute(ctx, path.Root("id"), &id)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+		return
+	}
+
 	idParts := strings.SplitN(req.ID, ",", 1)
 
 	if len(idParts) != 1 || idParts[0] == "" {
@@ -394,20 +446,20 @@ func (r *partnerChannelResource) populateResourceModel(ctx context.Context, part
 	state.WorkspaceId = types.Int64Value(partnerChannel.WorkspaceId)
 	state.PartnerId = types.Int64Value(partnerChannel.PartnerId)
 	state.PartnerChannelTemplateId = types.Int64Value(partnerChannel.PartnerChannelTemplateId)
-	state.Path = types.StringValue(partnerChannel.Path)
+	state.Path = lib.NormalizedPathValue(partnerChannel.Path)
 	state.ToPartnerFolderName = types.StringValue(partnerChannel.ToPartnerFolderName)
 	state.FromPartnerFolderName = types.StringValue(partnerChannel.FromPartnerFolderName)
-	state.FromPartnerRoutePath = types.StringValue(partnerChannel.FromPartnerRoutePath)
-	state.ToPartnerRoutePath = types.StringValue(partnerChannel.ToPartnerRoutePath)
-	state.ToPartnerManagedFolderPaths, propDiags = types.ListValueFrom(ctx, types.StringType, partnerChannel.ToPartnerManagedFolderPaths)
+	state.FromPartnerRoutePath = lib.NormalizedPathValue(partnerChannel.FromPartnerRoutePath)
+	state.ToPartnerRoutePath = lib.NormalizedPathValue(partnerChannel.ToPartnerRoutePath)
+	state.ToPartnerManagedFolderPaths, propDiags = types.ListValueFrom(ctx, lib.NormalizedPathType{}, partnerChannel.ToPartnerManagedFolderPaths)
 	diags.Append(propDiags...)
-	state.FromPartnerManagedFolderPaths, propDiags = types.ListValueFrom(ctx, types.StringType, partnerChannel.FromPartnerManagedFolderPaths)
+	state.FromPartnerManagedFolderPaths, propDiags = types.ListValueFrom(ctx, lib.NormalizedPathType{}, partnerChannel.FromPartnerManagedFolderPaths)
 	diags.Append(propDiags...)
 	state.EffectiveToPartnerFolderName = types.StringValue(partnerChannel.EffectiveToPartnerFolderName)
 	state.EffectiveFromPartnerFolderName = types.StringValue(partnerChannel.EffectiveFromPartnerFolderName)
-	state.ChannelPath = types.StringValue(partnerChannel.ChannelPath)
-	state.ToPartnerFolderPath = types.StringValue(partnerChannel.ToPartnerFolderPath)
-	state.FromPartnerFolderPath = types.StringValue(partnerChannel.FromPartnerFolderPath)
+	state.ChannelPath = lib.NormalizedPathValue(partnerChannel.ChannelPath)
+	state.ToPartnerFolderPath = lib.NormalizedPathValue(partnerChannel.ToPartnerFolderPath)
+	state.FromPartnerFolderPath = lib.NormalizedPathValue(partnerChannel.FromPartnerFolderPath)
 
 	return
 }
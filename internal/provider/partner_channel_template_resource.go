@@ -8,10 +8,12 @@ import (
 
 	files_sdk "github.com/Files-com/files-sdk-go/v3"
 	partner_channel_template "github.com/Files-com/files-sdk-go/v3/partnerchanneltemplate"
+	"github.com/Files-com/terraform-provider-files/lib"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
@@ -25,6 +27,8 @@ var (
 	_ resource.Resource                = &partnerChannelTemplateResource{}
 	_ resource.ResourceWithConfigure   = &partnerChannelTemplateResource{}
 	_ resource.ResourceWithImportState = &partnerChannelTemplateResource{}
+	_ resource.ResourceWithIdentity    = &partnerChannelTemplateResource{}
+	_ resource.ResourceWithModifyPlan  = &partnerChannelTemplateResource{}
 )
 
 func NewPartnerChannelTemplateResource() resource.Resource {
@@ -32,22 +36,23 @@ func NewPartnerChannelTemplateResource() resource.Resource {
 }
 
 type partnerChannelTemplateResource struct {
-	client *partner_channel_template.Client
+	client             *partner_channel_template.Client
+	defaultWorkspaceId types.Int64
 }
 
 type partnerChannelTemplateResourceModel struct {
-	Name                           types.String `tfsdk:"name"`
-	Path                           types.String `tfsdk:"path"`
-	WorkspaceId                    types.Int64  `tfsdk:"workspace_id"`
-	ToPartnerFolderName            types.String `tfsdk:"to_partner_folder_name"`
-	FromPartnerFolderName          types.String `tfsdk:"from_partner_folder_name"`
-	FromPartnerRoutePathPattern    types.String `tfsdk:"from_partner_route_path_pattern"`
-	ToPartnerRoutePathPattern      types.String `tfsdk:"to_partner_route_path_pattern"`
-	ToPartnerManagedFolderPaths    types.List   `tfsdk:"to_partner_managed_folder_paths"`
-	FromPartnerManagedFolderPaths  types.List   `tfsdk:"from_partner_managed_folder_paths"`
-	Id                             types.Int64  `tfsdk:"id"`
-	EffectiveToPartnerFolderName   types.String `tfsdk:"effective_to_partner_folder_name"`
-	EffectiveFromPartnerFolderName types.String `tfsdk:"effective_from_partner_folder_name"`
+	Name                           types.String       `tfsdk:"name"`
+	Path                           lib.NormalizedPath `tfsdk:"path"`
+	WorkspaceId                    types.Int64        `tfsdk:"workspace_id"`
+	ToPartnerFolderName            types.String       `tfsdk:"to_partner_folder_name"`
+	FromPartnerFolderName          types.String       `tfsdk:"from_partner_folder_name"`
+	FromPartnerRoutePathPattern    lib.NormalizedPath `tfsdk:"from_partner_route_path_pattern"`
+	ToPartnerRoutePathPattern      lib.NormalizedPath `tfsdk:"to_partner_route_path_pattern"`
+	ToPartnerManagedFolderPaths    types.List         `tfsdk:"to_partner_managed_folder_paths"`
+	FromPartnerManagedFolderPaths  types.List         `tfsdk:"from_partner_managed_folder_paths"`
+	Id                             types.Int64        `tfsdk:"id"`
+	EffectiveToPartnerFolderName   types.String       `tfsdk:"effective_to_partner_folder_name"`
+	EffectiveFromPartnerFolderName types.String       `tfsdk:"effective_from_partner_folder_name"`
 }
 
 func (r *partnerChannelTemplateResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -55,18 +60,19 @@ func (r *partnerChannelTemplateResource) Configure(_ context.Context, req resour
 		return
 	}
 
-	sdk_config, ok := req.ProviderData.(files_sdk.Config)
+	provider_data, ok := req.ProviderData.(providerResourceData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected files_sdk.Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected providerResourceData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.client = &partner_channel_template.Client{Config: sdk_config}
+	r.client = &partner_channel_template.Client{Config: provider_data.Config}
+	r.defaultWorkspaceId = provider_data.DefaultWorkspaceId
 }
 
 func (r *partnerChannelTemplateResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -84,6 +90,7 @@ func (r *partnerChannelTemplateResource) Schema(_ context.Context, _ resource.Sc
 			"path": schema.StringAttribute{
 				Description: "Channel path relative to the Partner root folder. This must be slash-delimited, but it must neither start nor end with a slash. Maximum of 5000 characters.",
 				Required:    true,
+				CustomType:  lib.NormalizedPathType{},
 			},
 			"workspace_id": schema.Int64Attribute{
 				Description: "ID of the Workspace associated with this Partner Channel Template.",
@@ -117,6 +124,7 @@ func (r *partnerChannelTemplateResource) Schema(_ context.Context, _ resource.Sc
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
 				},
+				CustomType: lib.NormalizedPathType{},
 			},
 			"to_partner_route_path_pattern": schema.StringAttribute{
 				Description: "Optional route path pattern for files delivered to the Partner. Supports {{partner_name}}.",
@@ -125,12 +133,13 @@ func (r *partnerChannelTemplateResource) Schema(_ context.Context, _ resource.Sc
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
 				},
+				CustomType: lib.NormalizedPathType{},
 			},
 			"to_partner_managed_folder_paths": schema.ListAttribute{
 				Description: "Managed folder paths inside the to-Partner folder.",
 				Computed:    true,
 				Optional:    true,
-				ElementType: types.StringType,
+				ElementType: lib.NormalizedPathType{},
 				PlanModifiers: []planmodifier.List{
 					listplanmodifier.UseStateForUnknown(),
 				},
@@ -139,7 +148,7 @@ func (r *partnerChannelTemplateResource) Schema(_ context.Context, _ resource.Sc
 				Description: "Managed folder paths inside the from-Partner folder.",
 				Computed:    true,
 				Optional:    true,
-				ElementType: types.StringType,
+				ElementType: lib.NormalizedPathType{},
 				PlanModifiers: []planmodifier.List{
 					listplanmodifier.UseStateForUnknown(),
 				},
@@ -163,6 +172,21 @@ func (r *partnerChannelTemplateResource) Schema(_ context.Context, _ resource.Sc
 	}
 }
 
+func (r *partnerChannelTemplateResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.Int64Attribute{
+				Description:       "The unique ID of the Partner Channel Template.",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+func (r *partnerChannelTemplateResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyPlanDefaultWorkspaceId(ctx, r.defaultWorkspaceId, req, resp)
+}
+
 func (r *partnerChannelTemplateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan partnerChannelTemplateResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -215,6 +239,12 @@ func (r *partnerChannelTemplateResource) Create(ctx context.Context, req resourc
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.Identity.SetAttribute(ctx, path.Root("id"), plan.Id)
+	resp.Diagnostics.Append(diags...)
 }
 
 func (r *partnerChannelTemplateResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -250,6 +280,12 @@ func (r *partnerChannelTemplateResource) Read(ctx context.Context, req resource.
 
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.Identity.SetAttribute(ctx, path.Root("id"), state.Id)
+	resp.Diagnostics.Append(diags...)
 }
 
 func (r *partnerChannelTemplateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -322,6 +358,12 @@ func (r *partnerChannelTemplateResource) Update(ctx context.Context, req resourc
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.Identity.SetAttribute(ctx, path.Root("id"), plan.Id)
+	resp.Diagnostics.Append(diags...)
 }
 
 func (r *partnerChannelTemplateResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -345,6 +387,13 @@ func (r *partnerChannelTemplateResource) Delete(ctx context.Context, req resourc
 }
 
 func (r *partnerChannelTemplateResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID == "" {
+		var id types.Int64
+		resp.Diagnostics.Append(req.Identity.GetAttribute(ctx, path.Root("id"), &id)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+		return
+	}
+
 	idParts := strings.SplitN(req.ID, ",", 1)
 
 	if len(idParts) != 1 || idParts[0] == "" {
@@ -373,14 +422,14 @@ func (r *partnerChannelTemplateResource) populateResourceModel(ctx context.Conte
 	state.Id = types.Int64Value(partnerChannelTemplate.Id)
 	state.WorkspaceId = types.Int64Value(partnerChannelTemplate.WorkspaceId)
 	state.Name = types.StringValue(partnerChannelTemplate.Name)
-	state.Path = types.StringValue(partnerChannelTemplate.Path)
+	state.Path = lib.NormalizedPathValue(partnerChannelTemplate.Path)
 	state.ToPartnerFolderName = types.StringValue(partnerChannelTemplate.ToPartnerFolderName)
 	state.FromPartnerFolderName = types.StringValue(partnerChannelTemplate.FromPartnerFolderName)
-	state.FromPartnerRoutePathPattern = types.StringValue(partnerChannelTemplate.FromPartnerRoutePathPattern)
-	state.ToPartnerRoutePathPattern = types.StringValue(partnerChannelTemplate.ToPartnerRoutePathPattern)
-	state.ToPartnerManagedFolderPaths, propDiags = types.ListValueFrom(ctx, types.StringType, partnerChannelTemplate.ToPartnerManagedFolderPaths)
+	state.FromPartnerRoutePathPattern = lib.NormalizedPathValue(partnerChannelTemplate.FromPartnerRoutePathPattern)
+	state.ToPartnerRoutePathPattern = lib.NormalizedPathValue(partnerChannelTemplate.ToPartnerRoutePathPattern)
+	state.ToPartnerManagedFolderPaths, propDiags = types.ListValueFrom(ctx, lib.NormalizedPathType{}, partnerChannelTemplate.ToPartnerManagedFolderPaths)
 	diags.Append(propDiags...)
-	state.FromPartnerManagedFolderPaths, propDiags = types.ListValueFrom(ctx, types.StringType, partnerChannelTemplate.FromPartnerManagedFolderPaths)
+	state.FromPartnerManagedFolderPaths, propDiags = types.ListValueFrom(ctx, lib.NormalizedPathType{}, partnerChannelTemplate.FromPartnerManagedFolderPaths)
 	diags.Append(propDiags...)
 	state.EffectiveToPartnerFolderName = types.StringValue(partnerChannelTemplate.EffectiveToPartnerFolderName)
 	state.EffectiveFromPartnerFolderName = types.StringValue(partnerChannelTemplate.EffectiveFromPartnerFolderName)
@@ -7,15 +7,18 @@ import (
 	files_sdk "github.com/Files-com/files-sdk-go/v3"
 	partner "github.com/Files-com/files-sdk-go/v3/partner"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 var (
-	_ datasource.DataSource              = &partnerDataSource{}
-	_ datasource.DataSourceWithConfigure = &partnerDataSource{}
+	_ datasource.DataSource                     = &partnerDataSource{}
+	_ datasource.DataSourceWithConfigure        = &partnerDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &partnerDataSource{}
 )
 
 func NewPartnerDataSource() datasource.DataSource {
@@ -74,11 +77,12 @@ func (r *partnerDataSource) Metadata(_ context.Context, req datasource.MetadataR
 
 func (r *partnerDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Description: "A Partner is a first-class entity that cleanly represents an external organization, enables delegated administration, and enforces strict boundaries.",
+		Description: "A Partner is a first-class entity that cleanly represents an external organization, enables delegated administration, and enforces strict boundaries.\n\nLook up a partner either by `id` or by `name`. A `name` lookup fails if no partner, or more than one partner, has that name.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.Int64Attribute{
 				Description: "The unique ID of the Partner.",
-				Required:    true,
+				Optional:    true,
+				Computed:    true,
 			},
 			"allow_bypassing_2fa_policies": schema.BoolAttribute{
 				Description: "Allow Partner Admins to change Two-Factor Authentication requirements for Partner Users.",
@@ -114,6 +118,7 @@ func (r *partnerDataSource) Schema(_ context.Context, _ datasource.SchemaRequest
 			},
 			"name": schema.StringAttribute{
 				Description: "The name of the Partner.",
+				Optional:    true,
 				Computed:    true,
 			},
 			"notes": schema.StringAttribute{
@@ -162,6 +167,15 @@ func (r *partnerDataSource) Schema(_ context.Context, _ datasource.SchemaRequest
 	}
 }
 
+func (r *partnerDataSource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("id"),
+			path.MatchRoot("name"),
+		),
+	}
+}
+
 func (r *partnerDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	var data partnerDataSourceModel
 	diags := req.Config.Get(ctx, &data)
@@ -170,6 +184,29 @@ func (r *partnerDataSource) Read(ctx context.Context, req datasource.ReadRequest
 		return
 	}
 
+	if data.Id.IsNull() {
+		paramsPartnerList := files_sdk.PartnerListParams{}
+
+		partnerIt, err := r.client.List(paramsPartnerList, files_sdk.WithContext(ctx))
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Reading Files Partner",
+				"Could not list partners: "+err.Error(),
+			)
+			return
+		}
+
+		match, diags := lookupByKey(partnerIt, "Error Reading Files Partner", "partner", "name", data.Name.ValueString(),
+			func(p files_sdk.Partner) string { return p.Name },
+			func(p files_sdk.Partner) int64 { return p.Id },
+		)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		data.Id = types.Int64Value(match.Id)
+	}
+
 	paramsPartnerFind := files_sdk.PartnerFindParams{}
 	paramsPartnerFind.Id = data.Id.ValueInt64()
 
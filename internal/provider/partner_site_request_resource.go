@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 
@@ -27,6 +28,7 @@ var (
 	_ resource.Resource                = &partnerSiteRequestResource{}
 	_ resource.ResourceWithConfigure   = &partnerSiteRequestResource{}
 	_ resource.ResourceWithImportState = &partnerSiteRequestResource{}
+	_ resource.ResourceWithIdentity    = &partnerSiteRequestResource{}
 )
 
 func NewPartnerSiteRequestResource() resource.Resource {
@@ -53,18 +55,18 @@ func (r *partnerSiteRequestResource) Configure(_ context.Context, req resource.C
 		return
 	}
 
-	sdk_config, ok := req.ProviderData.(files_sdk.Config)
+	provider_data, ok := req.ProviderData.(providerResourceData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected files_sdk.Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected providerResourceData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.client = &partner_site_request.Client{Config: sdk_config}
+	r.client = &partner_site_request.Client{Config: provider_data.Config}
 }
 
 func (r *partnerSiteRequestResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -123,6 +125,17 @@ func (r *partnerSiteRequestResource) Schema(_ context.Context, _ resource.Schema
 	}
 }
 
+func (r *partnerSiteRequestResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.Int64Attribute{
+				Description:       "Partner Site Request ID",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
 func (r *partnerSiteRequestResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan partnerSiteRequestResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -162,6 +175,12 @@ func (r *partnerSiteRequestResource) Create(ctx context.Context, req resource.Cr
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.Identity.SetAttribute(ctx, path.Root("id"), plan.Id)
+	resp.Diagnostics.Append(diags...)
 }
 
 func (r *partnerSiteRequestResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -222,6 +241,12 @@ func (r *partnerSiteRequestResource) Read(ctx context.Context, req resource.Read
 
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.Identity.SetAttribute(ctx, path.Root("id"), state.Id)
+	resp.Diagnostics.Append(diags...)
 }
 
 func (r *partnerSiteRequestResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -252,6 +277,13 @@ func (r *partnerSiteRequestResource) Delete(ctx context.Context, req resource.De
 }
 
 func (r *partnerSiteRequestResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID == "" {
+		var id types.Int64
+		resp.Diagnostics.Append(req.Identity.GetAttribute(ctx, path.Root("id"), &id)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+		return
+	}
+
 	idParts := strings.SplitN(req.ID, ",", 1)
 
 	if len(idParts) != 1 || idParts[0] == "" {
@@ -0,0 +1,46 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func runPathFunction(t *testing.T, f function.Function, args ...attr.Value) string {
+	ctx := context.Background()
+	resp := &function.RunResponse{Result: function.NewResultData(types.StringUnknown())}
+	f.Run(ctx, function.RunRequest{Arguments: function.NewArgumentsData(args)}, resp)
+	if resp.Error != nil {
+		t.Fatal(resp.Error)
+	}
+
+	return resp.Result.Value().(types.String).ValueString()
+}
+
+func TestNormalizePathFunction(t *testing.T) {
+	f := NewNormalizePathFunction()
+	assert.Equal(t, "reports/q1/cafe.txt", runPathFunction(t, f, types.StringValue(`/Reports\Q1//Café.txt `)))
+	assert.Equal(t, "a/b/c/hello", runPathFunction(t, f, types.StringValue("a/b/c/../../hello")))
+}
+
+func TestPathJoinFunction(t *testing.T) {
+	f := NewPathJoinFunction()
+	elements, _ := types.TupleValue(
+		[]attr.Type{types.StringType, types.StringType, types.StringType},
+		[]attr.Value{types.StringValue("Partners/"), types.StringValue("/Acme"), types.StringValue("Inbox")},
+	)
+	assert.Equal(t, "Partners/Acme/Inbox", runPathFunction(t, f, elements))
+
+	empty, _ := types.TupleValue([]attr.Type{}, []attr.Value{})
+	assert.Equal(t, "", runPathFunction(t, f, empty))
+}
+
+func TestPathParentFunction(t *testing.T) {
+	f := NewPathParentFunction()
+	assert.Equal(t, "Partners/Acme", runPathFunction(t, f, types.StringValue("/Partners/Acme/Inbox/")))
+	assert.Equal(t, "", runPathFunction(t, f, types.StringValue("Partners")))
+}
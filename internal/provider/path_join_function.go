@@ -0,0 +1,44 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/Files-com/terraform-provider-files/lib"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var (
+	_ function.Function = &pathJoinFunction{}
+)
+
+func NewPathJoinFunction() function.Function {
+	return &pathJoinFunction{}
+}
+
+type pathJoinFunction struct{}
+
+func (f *pathJoinFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "path_join"
+}
+
+func (f *pathJoinFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Join path elements into a Files.com path",
+		Description: "Joins the given path elements with slashes. Backslashes become slashes, and empty, `.` and `..` segments are removed, so the result has no leading or trailing slash as the Files.com API expects. Capitalization is preserved.",
+		VariadicParameter: function.StringParameter{
+			Name:        "elements",
+			Description: "The path elements to join.",
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *pathJoinFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var elements []string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &elements))
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, lib.JoinPath(elements...)))
+}
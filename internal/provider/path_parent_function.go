@@ -0,0 +1,46 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/Files-com/terraform-provider-files/lib"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var (
+	_ function.Function = &pathParentFunction{}
+)
+
+func NewPathParentFunction() function.Function {
+	return &pathParentFunction{}
+}
+
+type pathParentFunction struct{}
+
+func (f *pathParentFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "path_parent"
+}
+
+func (f *pathParentFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Return the parent folder of a Files.com path",
+		Description: "Returns the path of the folder containing the given file or folder, cleaned in the same way as `path_join`. The parent of a top level file or folder is the root folder, an empty string.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "path",
+				Description: "The path of a file or folder.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *pathParentFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var path string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &path))
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, lib.ParentPath(path)))
+}
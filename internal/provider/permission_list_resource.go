@@ -0,0 +1,151 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	files_sdk "github.com/Files-com/files-sdk-go/v3"
+	permission "github.com/Files-com/files-sdk-go/v3/permission"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ list.ListResource              = &permissionListResource{}
+	_ list.ListResourceWithConfigure = &permissionListResource{}
+)
+
+func NewPermissionListResource() list.ListResource {
+	return &permissionListResource{}
+}
+
+type permissionListResource struct {
+	client *permission.Client
+}
+
+type permissionListResourceModel struct {
+	Filter        types.Map    `tfsdk:"filter"`
+	FilterPrefix  types.Map    `tfsdk:"filter_prefix"`
+	Path          types.String `tfsdk:"path"`
+	IncludeGroups types.Bool   `tfsdk:"include_groups"`
+	GroupId       types.String `tfsdk:"group_id"`
+	PartnerId     types.String `tfsdk:"partner_id"`
+	UserId        types.String `tfsdk:"user_id"`
+}
+
+func (r *permissionListResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	sdk_config, ok := req.ProviderData.(files_sdk.Config)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected List Resource Configure Type",
+			fmt.Sprintf("Expected files_sdk.Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = &permission.Client{Config: sdk_config}
+}
+
+func (r *permissionListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_permission"
+}
+
+func (r *permissionListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists every Permission matching the given arguments so `terraform query` can import them.",
+		Attributes: map[string]schema.Attribute{
+			"filter": schema.MapAttribute{
+				Description: "If set, return records where the specified field is equal to the supplied value.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"filter_prefix": schema.MapAttribute{
+				Description: "If set, return records where the specified field is prefixed by the supplied value.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"path": schema.StringAttribute{
+				Description: "Permission path.  If provided, will scope all permissions(including upward) to this path.",
+				Optional:    true,
+			},
+			"include_groups": schema.BoolAttribute{
+				Description: "If searching by user or group, also include user's permissions that are inherited from its groups?",
+				Optional:    true,
+			},
+			"group_id": schema.StringAttribute{
+				Description: "Group ID.  If provided, will scope permissions to this group.",
+				Optional:    true,
+			},
+			"partner_id": schema.StringAttribute{
+				Description: "Partner ID.  If provided, will scope permissions to this partner.",
+				Optional:    true,
+			},
+			"user_id": schema.StringAttribute{
+				Description: "User ID.  If provided, will scope permissions to this user.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func (r *permissionListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var data permissionListResourceModel
+	diags := req.Config.Get(ctx, &data)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	var propDiags diag.Diagnostics
+	paramsPermissionList := files_sdk.PermissionListParams{}
+	paramsPermissionList.Filter, propDiags = listFilterValue(ctx, path.Root("filter"), data.Filter)
+	diags.Append(propDiags...)
+	paramsPermissionList.FilterPrefix, propDiags = listFilterValue(ctx, path.Root("filter_prefix"), data.FilterPrefix)
+	diags.Append(propDiags...)
+	paramsPermissionList.Path = data.Path.ValueString()
+	if !data.IncludeGroups.IsNull() && !data.IncludeGroups.IsUnknown() {
+		paramsPermissionList.IncludeGroups = data.IncludeGroups.ValueBoolPointer()
+	}
+	paramsPermissionList.GroupId = data.GroupId.ValueString()
+	paramsPermissionList.PartnerId = data.PartnerId.ValueString()
+	paramsPermissionList.UserId = data.UserId.ValueString()
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	permissionIt, err := r.client.List(paramsPermissionList, files_sdk.WithContext(ctx))
+	if err != nil {
+		diags.AddError(
+			"Error Listing Files Permissions",
+			"Could not list permissions: "+err.Error(),
+		)
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	stream.Results = listResults(ctx, req, permissionIt, "Error Listing Files Permissions", "Could not list permissions: ", func(permission files_sdk.Permission, result *list.ListResult) {
+		grantee := permission.Username
+		if permission.GroupName != "" {
+			grantee = permission.GroupName
+		} else if permission.PartnerName != "" {
+			grantee = permission.PartnerName
+		}
+		result.DisplayName = fmt.Sprintf("%s on /%s for %s", permission.Permission, permission.Path, grantee)
+
+		var identity permissionResourceIdentityModel
+		(&permissionResource{}).populateIdentityModel(permission, &identity)
+		result.Diagnostics.Append(result.Identity.Set(ctx, identity)...)
+	}, (&permissionResource{}).populateResourceModel)
+}
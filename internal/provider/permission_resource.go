@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
@@ -29,6 +30,7 @@ var (
 	_ resource.Resource                = &permissionResource{}
 	_ resource.ResourceWithConfigure   = &permissionResource{}
 	_ resource.ResourceWithImportState = &permissionResource{}
+	_ resource.ResourceWithIdentity    = &permissionResource{}
 )
 
 func NewPermissionResource() resource.Resource {
@@ -40,19 +42,27 @@ type permissionResource struct {
 }
 
 type permissionResourceModel struct {
-	Path        types.String `tfsdk:"path"`
-	UserId      types.Int64  `tfsdk:"user_id"`
-	Username    types.String `tfsdk:"username"`
-	GroupId     types.Int64  `tfsdk:"group_id"`
-	GroupName   types.String `tfsdk:"group_name"`
-	GroupIds    types.List   `tfsdk:"group_ids"`
-	PartnerId   types.Int64  `tfsdk:"partner_id"`
-	Permission  types.String `tfsdk:"permission"`
-	Recursive   types.Bool   `tfsdk:"recursive"`
-	SiteId      types.Int64  `tfsdk:"site_id"`
-	Id          types.Int64  `tfsdk:"id"`
-	GroupNames  types.List   `tfsdk:"group_names"`
-	PartnerName types.String `tfsdk:"partner_name"`
+	Path        lib.NormalizedPath `tfsdk:"path"`
+	UserId      types.Int64        `tfsdk:"user_id"`
+	Username    types.String       `tfsdk:"username"`
+	GroupId     types.Int64        `tfsdk:"group_id"`
+	GroupName   types.String       `tfsdk:"group_name"`
+	GroupIds    types.List         `tfsdk:"group_ids"`
+	PartnerId   types.Int64        `tfsdk:"partner_id"`
+	Permission  types.String       `tfsdk:"permission"`
+	Recursive   types.Bool         `tfsdk:"recursive"`
+	SiteId      types.Int64        `tfsdk:"site_id"`
+	Id          types.Int64        `tfsdk:"id"`
+	GroupNames  types.List         `tfsdk:"group_names"`
+	PartnerName types.String       `tfsdk:"partner_name"`
+}
+
+type permissionResourceIdentityModel struct {
+	Path       types.String `tfsdk:"path"`
+	UserId     types.Int64  `tfsdk:"user_id"`
+	GroupId    types.Int64  `tfsdk:"group_id"`
+	PartnerId  types.Int64  `tfsdk:"partner_id"`
+	Permission types.String `tfsdk:"permission"`
 }
 
 func (r *permissionResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -60,18 +70,18 @@ func (r *permissionResource) Configure(_ context.Context, req resource.Configure
 		return
 	}
 
-	sdk_config, ok := req.ProviderData.(files_sdk.Config)
+	provider_data, ok := req.ProviderData.(providerResourceData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected files_sdk.Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected providerResourceData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.client = &permission.Client{Config: sdk_config}
+	r.client = &permission.Client{Config: provider_data.Config}
 }
 
 func (r *permissionResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -88,6 +98,7 @@ func (r *permissionResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
+				CustomType: lib.NormalizedPathType{},
 			},
 			"user_id": schema.Int64Attribute{
 				Description: "User ID",
@@ -194,6 +205,33 @@ func (r *permissionResource) Schema(_ context.Context, _ resource.SchemaRequest,
 	}
 }
 
+func (r *permissionResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"path": identityschema.StringAttribute{
+				Description:       "Folder path",
+				RequiredForImport: true,
+			},
+			"user_id": identityschema.Int64Attribute{
+				Description:       "User ID, for a permission granted to a user",
+				OptionalForImport: true,
+			},
+			"group_id": identityschema.Int64Attribute{
+				Description:       "Group ID, for a permission granted to a group",
+				OptionalForImport: true,
+			},
+			"partner_id": identityschema.Int64Attribute{
+				Description:       "Partner ID, for a permission granted to a partner",
+				OptionalForImport: true,
+			},
+			"permission": identityschema.StringAttribute{
+				Description:       "Permission type",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
 func (r *permissionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan permissionResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -244,6 +282,14 @@ func (r *permissionResource) Create(ctx context.Context, req resource.CreateRequ
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var identity permissionResourceIdentityModel
+	r.populateIdentityModel(permission, &identity)
+	diags = resp.Identity.Set(ctx, identity)
+	resp.Diagnostics.Append(diags...)
 }
 
 func (r *permissionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -304,6 +350,14 @@ func (r *permissionResource) Read(ctx context.Context, req resource.ReadRequest,
 
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var identity permissionResourceIdentityModel
+	r.populateIdentityModel(*permission, &identity)
+	diags = resp.Identity.Set(ctx, identity)
+	resp.Diagnostics.Append(diags...)
 }
 
 func (r *permissionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -334,6 +388,52 @@ func (r *permissionResource) Delete(ctx context.Context, req resource.DeleteRequ
 }
 
 func (r *permissionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID == "" {
+		var identity permissionResourceIdentityModel
+		resp.Diagnostics.Append(req.Identity.Get(ctx, &identity)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		permission, err := r.findByIdentity(ctx, identity)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Importing Files Permission",
+				"Could not find permission: "+err.Error(),
+			)
+			return
+		}
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), permission.Id)...)
+
+		r.populateIdentityModel(permission, &identity)
+		resp.Diagnostics.Append(resp.Identity.Set(ctx, identity)...)
+		return
+	}
+
+	// An identifier that isn't a number is taken to be
+	// path:group_name:permission.
+	if _, err := strconv.ParseInt(req.ID, 10, 64); err != nil {
+		folderPath, groupName, permissionName, ok := parsePermissionImportKey(req.ID)
+		if !ok {
+			resp.Diagnostics.AddError(
+				"Unexpected Import Identifier",
+				fmt.Sprintf("Expected import identifier with format: id or path:group_name:permission. Got: %q", req.ID),
+			)
+			return
+		}
+
+		permission, err := r.findByGroupName(ctx, folderPath, groupName, permissionName)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Importing Files Permission",
+				fmt.Sprintf("Could not import permission %q: %s", req.ID, err),
+			)
+			return
+		}
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), permission.Id)...)
+		return
+	}
+
 	idParts := strings.SplitN(req.ID, ",", 1)
 
 	if len(idParts) != 1 || idParts[0] == "" {
@@ -356,11 +456,120 @@ func (r *permissionResource) ImportState(ctx context.Context, req resource.Impor
 
 }
 
+// findByIdentity returns the permission on exactly identity.path that is
+// granted to the identity's user, group or partner.
+func (r *permissionResource) findByIdentity(ctx context.Context, identity permissionResourceIdentityModel) (files_sdk.Permission, error) {
+	paramsPermissionList := files_sdk.PermissionListParams{}
+	paramsPermissionList.Path = lib.CleanPath(identity.Path.ValueString())
+
+	permissionIt, err := r.client.List(paramsPermissionList, files_sdk.WithContext(ctx))
+	if err != nil {
+		return files_sdk.Permission{}, err
+	}
+	permissions, err := listAll[files_sdk.Permission](permissionIt)
+	if err != nil {
+		return files_sdk.Permission{}, err
+	}
+
+	var matches []files_sdk.Permission
+	for _, permission := range permissions {
+		var candidate permissionResourceIdentityModel
+		r.populateIdentityModel(permission, &candidate)
+		if lib.NormalizePathForComparison(candidate.Path.ValueString()) == lib.NormalizePathForComparison(identity.Path.ValueString()) &&
+			candidate.UserId.Equal(identity.UserId) &&
+			candidate.GroupId.Equal(identity.GroupId) &&
+			candidate.PartnerId.Equal(identity.PartnerId) &&
+			candidate.Permission.Equal(identity.Permission) {
+			matches = append(matches, permission)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return files_sdk.Permission{}, fmt.Errorf("no %s permission found on path %q for the given user, group or partner", identity.Permission.ValueString(), identity.Path.ValueString())
+	case 1:
+		return matches[0], nil
+	default:
+		return files_sdk.Permission{}, fmt.Errorf("%d %s permissions found on path %q for the given user, group or partner", len(matches), identity.Permission.ValueString(), identity.Path.ValueString())
+	}
+}
+
+// findByGroupName returns the permission on exactly folderPath that grants
+// permissionName to the group named groupName.
+func (r *permissionResource) findByGroupName(ctx context.Context, folderPath string, groupName string, permissionName string) (files_sdk.Permission, error) {
+	paramsPermissionList := files_sdk.PermissionListParams{}
+	paramsPermissionList.Path = lib.CleanPath(folderPath)
+
+	permissionIt, err := r.client.List(paramsPermissionList, files_sdk.WithContext(ctx))
+	if err != nil {
+		return files_sdk.Permission{}, err
+	}
+	permissions, err := listAll[files_sdk.Permission](permissionIt)
+	if err != nil {
+		return files_sdk.Permission{}, err
+	}
+
+	var matches []files_sdk.Permission
+	for _, permission := range permissions {
+		if lib.NormalizePathForComparison(permission.Path) == lib.NormalizePathForComparison(folderPath) &&
+			permission.GroupId != 0 &&
+			strings.EqualFold(permission.GroupName, groupName) &&
+			permission.Permission == permissionName {
+			matches = append(matches, permission)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return files_sdk.Permission{}, fmt.Errorf("no %s permission found on path %q for group %q", permissionName, folderPath, groupName)
+	case 1:
+		return matches[0], nil
+	default:
+		return files_sdk.Permission{}, fmt.Errorf("%d %s permissions found on path %q for group %q (ids %s), import one of them by id instead", len(matches), permissionName, folderPath, groupName, joinIds(matches, func(p files_sdk.Permission) int64 { return p.Id }))
+	}
+}
+
+// parsePermissionImportKey splits a path:group_name:permission import
+// identifier. Paths may contain colons, so the group name and permission are
+// taken from the end. The path may be empty to import a permission on the
+// root folder.
+func parsePermissionImportKey(id string) (folderPath string, groupName string, permission string, ok bool) {
+	i := strings.LastIndex(id, ":")
+	if i < 0 {
+		return "", "", "", false
+	}
+	j := strings.LastIndex(id[:i], ":")
+	if j < 0 {
+		return "", "", "", false
+	}
+	folderPath, groupName, permission = id[:j], id[j+1:i], id[i+1:]
+	return folderPath, groupName, permission, groupName != "" && permission != ""
+}
+
+// populateIdentityModel sets identity from permission. Only one of user_id,
+// group_id and partner_id is set; the others are null.
+func (r *permissionResource) populateIdentityModel(permission files_sdk.Permission, identity *permissionResourceIdentityModel) {
+	identity.Path = types.StringValue(permission.Path)
+	identity.UserId = types.Int64Null()
+	if permission.UserId != 0 {
+		identity.UserId = types.Int64Value(permission.UserId)
+	}
+	identity.GroupId = types.Int64Null()
+	if permission.GroupId != 0 {
+		identity.GroupId = types.Int64Value(permission.GroupId)
+	}
+	identity.PartnerId = types.Int64Null()
+	if permission.PartnerId != 0 {
+		identity.PartnerId = types.Int64Value(permission.PartnerId)
+	}
+	identity.Permission = types.StringValue(permission.Permission)
+}
+
 func (r *permissionResource) populateResourceModel(ctx context.Context, permission files_sdk.Permission, state *permissionResourceModel) (diags diag.Diagnostics) {
 	var propDiags diag.Diagnostics
 
 	state.Id = types.Int64Value(permission.Id)
-	state.Path = types.StringValue(permission.Path)
+	state.Path = lib.NormalizedPathValue(permission.Path)
 	state.UserId = types.Int64Value(permission.UserId)
 	state.Username = types.StringValue(permission.Username)
 	state.GroupId = types.Int64Value(permission.GroupId)
@@ -41,3 +41,22 @@ resource "files_permission" "root_permission" {
 		},
 	})
 }
+
+func TestParsePermissionImportKey(t *testing.T) {
+	for _, tc := range []struct {
+		id, path, group, permission string
+		ok                          bool
+	}{
+		{"Partners/Acme:partners:full", "Partners/Acme", "partners", "full", true},
+		{":admins:readonly", "", "admins", "readonly", true},
+		{"Reports/Q1: Sales:finance:list", "Reports/Q1: Sales", "finance", "list", true},
+		{"Partners/Acme:full", "", "", "", false},
+		{"Partners/Acme::full", "", "", "", false},
+		{"Partners/Acme:partners:", "", "", "", false},
+	} {
+		folderPath, group, permission, ok := parsePermissionImportKey(tc.id)
+		if ok != tc.ok || (ok && (folderPath != tc.path || group != tc.group || permission != tc.permission)) {
+			t.Errorf("parsePermissionImportKey(%q) = %q, %q, %q, %v", tc.id, folderPath, group, permission, ok)
+		}
+	}
+}
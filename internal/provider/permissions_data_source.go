@@ -0,0 +1,168 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	files_sdk "github.com/Files-com/files-sdk-go/v3"
+	permission "github.com/Files-com/files-sdk-go/v3/permission"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &permissionsDataSource{}
+	_ datasource.DataSourceWithConfigure = &permissionsDataSource{}
+)
+
+func NewPermissionsDataSource() datasource.DataSource {
+	return &permissionsDataSource{}
+}
+
+type permissionsDataSource struct {
+	client *permission.Client
+}
+
+type permissionsDataSourceModel struct {
+	SortBy        types.Map    `tfsdk:"sort_by"`
+	Filter        types.Map    `tfsdk:"filter"`
+	FilterPrefix  types.Map    `tfsdk:"filter_prefix"`
+	Path          types.String `tfsdk:"path"`
+	IncludeGroups types.Bool   `tfsdk:"include_groups"`
+	GroupId       types.String `tfsdk:"group_id"`
+	PartnerId     types.String `tfsdk:"partner_id"`
+	UserId        types.String `tfsdk:"user_id"`
+	Permissions   types.List   `tfsdk:"permissions"`
+}
+
+func (r *permissionsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	sdk_config, ok := req.ProviderData.(files_sdk.Config)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected files_sdk.Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = &permission.Client{Config: sdk_config}
+}
+
+func (r *permissionsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_permissions"
+}
+
+func (r *permissionsDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists every Permission matching the given arguments. Filtering and sorting are performed by the Files.com API and all pages of results are returned.",
+		Attributes: map[string]schema.Attribute{
+			"sort_by": schema.MapAttribute{
+				Description: "If set, sort records by the specified field in either `asc` or `desc` direction.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"filter": schema.MapAttribute{
+				Description: "If set, return records where the specified field is equal to the supplied value.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"filter_prefix": schema.MapAttribute{
+				Description: "If set, return records where the specified field is prefixed by the supplied value.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"path": schema.StringAttribute{
+				Description: "Permission path.  If provided, will scope all permissions(including upward) to this path.",
+				Optional:    true,
+			},
+			"include_groups": schema.BoolAttribute{
+				Description: "If searching by user or group, also include user's permissions that are inherited from its groups?",
+				Optional:    true,
+			},
+			"group_id": schema.StringAttribute{
+				Description: "Group ID.  If provided, will scope permissions to this group.",
+				Optional:    true,
+			},
+			"partner_id": schema.StringAttribute{
+				Description: "Partner ID.  If provided, will scope permissions to this partner.",
+				Optional:    true,
+			},
+			"user_id": schema.StringAttribute{
+				Description: "User ID.  If provided, will scope permissions to this user.",
+				Optional:    true,
+			},
+			"permissions": schema.ListNestedAttribute{
+				Description: "Permissions matching the given arguments.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: listItemAttributes(dataSourceSchema(ctx, NewPermissionDataSource()).Attributes),
+				},
+			},
+		},
+	}
+}
+
+func (r *permissionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data permissionsDataSourceModel
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var propDiags diag.Diagnostics
+	paramsPermissionList := files_sdk.PermissionListParams{}
+	paramsPermissionList.SortBy, propDiags = listFilterValue(ctx, path.Root("sort_by"), data.SortBy)
+	resp.Diagnostics.Append(propDiags...)
+	paramsPermissionList.Filter, propDiags = listFilterValue(ctx, path.Root("filter"), data.Filter)
+	resp.Diagnostics.Append(propDiags...)
+	paramsPermissionList.FilterPrefix, propDiags = listFilterValue(ctx, path.Root("filter_prefix"), data.FilterPrefix)
+	resp.Diagnostics.Append(propDiags...)
+	paramsPermissionList.Path = data.Path.ValueString()
+	if !data.IncludeGroups.IsNull() && !data.IncludeGroups.IsUnknown() {
+		paramsPermissionList.IncludeGroups = data.IncludeGroups.ValueBoolPointer()
+	}
+	paramsPermissionList.GroupId = data.GroupId.ValueString()
+	paramsPermissionList.PartnerId = data.PartnerId.ValueString()
+	paramsPermissionList.UserId = data.UserId.ValueString()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	permissionIt, err := r.client.List(paramsPermissionList, files_sdk.WithContext(ctx))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Listing Files Permissions",
+			"Could not list permissions: "+err.Error(),
+		)
+		return
+	}
+
+	permissions, err := listAll[files_sdk.Permission](permissionIt)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Listing Files Permissions",
+			"Could not list permissions: "+err.Error(),
+		)
+		return
+	}
+
+	data.Permissions, diags = listItems(ctx, dataSourceSchema(ctx, NewPermissionDataSource()).Attributes, permissions, (&permissionDataSource{}).populateDataSourceModel)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, data)
+	resp.Diagnostics.Append(diags...)
+}
@@ -1,24 +1,42 @@
 package provider
 
 import (
+	"cmp"
 	"context"
+	"errors"
+	"io/fs"
+	"net/url"
 	"os"
 	"strings"
+	"time"
 
 	files_sdk "github.com/Files-com/files-sdk-go/v3"
 	"github.com/Files-com/terraform-provider-files/lib"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 var (
-	_ provider.Provider = &filesProvider{}
+	_ provider.Provider                       = &filesProvider{}
+	_ provider.ProviderWithActions            = &filesProvider{}
+	_ provider.ProviderWithEphemeralResources = &filesProvider{}
+	_ provider.ProviderWithFunctions          = &filesProvider{}
+	_ provider.ProviderWithListResources      = &filesProvider{}
 )
 
 func New(version string) func() provider.Provider {
@@ -34,10 +52,27 @@ type filesProvider struct {
 }
 
 type filesProviderModel struct {
-	APIKey           types.String `tfsdk:"api_key"`
-	EndpointOverride types.String `tfsdk:"endpoint_override"`
-	Environment      types.String `tfsdk:"environment"`
-	FeatureFlags     types.List   `tfsdk:"feature_flags"`
+	APIKey                types.String `tfsdk:"api_key"`
+	Profile               types.String `tfsdk:"profile"`
+	CredentialsFile       types.String `tfsdk:"credentials_file"`
+	Username              types.String `tfsdk:"username"`
+	Password              types.String `tfsdk:"password"`
+	EndpointOverride      types.String `tfsdk:"endpoint_override"`
+	Environment           types.String `tfsdk:"environment"`
+	FeatureFlags          types.List   `tfsdk:"feature_flags"`
+	MaxRetries            types.Int64  `tfsdk:"max_retries"`
+	RetryMaxWait          types.Int64  `tfsdk:"retry_max_wait"`
+	RetryOnStatus         types.List   `tfsdk:"retry_on_status"`
+	RequestsPerSecond     types.Int64  `tfsdk:"requests_per_second"`
+	MaxConcurrentRequests types.Int64  `tfsdk:"max_concurrent_requests"`
+	ProxyURL              types.String `tfsdk:"proxy_url"`
+	CACertPEM             types.String `tfsdk:"ca_cert_pem"`
+	CACertFile            types.String `tfsdk:"ca_cert_file"`
+	ClientCert            types.String `tfsdk:"client_cert"`
+	ClientKey             types.String `tfsdk:"client_key"`
+	RequestTimeout        types.Int64  `tfsdk:"request_timeout"`
+	InsecureSkipVerify    types.Bool   `tfsdk:"insecure_skip_verify"`
+	DefaultWorkspaceId    types.Int64  `tfsdk:"default_workspace_id"`
 }
 
 func (p *filesProvider) Metadata(_ context.Context, _ provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -49,10 +84,34 @@ func (p *filesProvider) Schema(_ context.Context, _ provider.SchemaRequest, resp
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"api_key": schema.StringAttribute{
-				Description: "The API key used to authenticate with Files.com. It can also be sourced from the `FILES_API_KEY` environment variable.",
+				Description: "The API key used to authenticate with Files.com. It can also be sourced from the `FILES_API_KEY` environment variable or from `profile`.",
 				Optional:    true,
 				Sensitive:   true,
 			},
+			"profile": schema.StringAttribute{
+				Description: "The profile in `credentials_file` to read `api_key`, `endpoint_override` and `environment` from. It can also be sourced from the `FILES_PROFILE` environment variable. When no profile is selected, the `default` profile is used if the credentials file exists, and if it can't be read while the API key is set in the configuration or the environment, it is ignored with a warning. Values set in the provider configuration or the environment take precedence over the profile.",
+				Optional:    true,
+			},
+			"credentials_file": schema.StringAttribute{
+				Description: "The path of the shared credentials file. It can also be sourced from the `FILES_CREDENTIALS_FILE` environment variable. Defaults to `~/.config/files/credentials`.",
+				Optional:    true,
+			},
+			"username": schema.StringAttribute{
+				Description: "Log in as this user with `password` instead of using an API key. The provider logs in once and reuses the session within one provider process, which Terraform starts anew for each command such as `plan` or `apply`, and logs it out when Terraform is done with the provider. Any API key from the environment or a profile is ignored.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("api_key")),
+					stringvalidator.AlsoRequires(path.MatchRoot("password")),
+				},
+			},
+			"password": schema.StringAttribute{
+				Description: "The password for `username`.",
+				Optional:    true,
+				Sensitive:   true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("username")),
+				},
+			},
 			"endpoint_override": schema.StringAttribute{
 				Description: "Required if your site is configured to disable global acceleration. This can also be set to use a mock server in development or CI.",
 				Optional:    true,
@@ -64,6 +123,90 @@ func (p *filesProvider) Schema(_ context.Context, _ provider.SchemaRequest, resp
 				ElementType: types.StringType,
 				Optional:    true,
 			},
+			"max_retries": schema.Int64Attribute{
+				Description: "The maximum number of times a failed API request is retried. Defaults to `3`.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"retry_max_wait": schema.Int64Attribute{
+				Description: "The maximum number of seconds to wait before retrying a failed API request. Retries back off exponentially up to this limit, and a `Retry-After` header sent by the API is honored up to this limit. Defaults to `30`.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"retry_on_status": schema.ListAttribute{
+				Description: "HTTP status codes that cause an API request to be retried. Requests that fail to connect are always retried. Defaults to `429` and every `5xx` status other than `501`.",
+				ElementType: types.Int64Type,
+				Optional:    true,
+				Validators: []validator.List{
+					listvalidator.ValueInt64sAre(int64validator.Between(100, 599)),
+				},
+			},
+			"requests_per_second": schema.Int64Attribute{
+				Description: "The maximum number of API requests started per second, shared by every resource and data source using this provider. Retries count towards the limit. Unlimited by default.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"max_concurrent_requests": schema.Int64Attribute{
+				Description: "The maximum number of API requests waiting for a response at once, shared by every resource and data source using this provider. Unlimited by default.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"proxy_url": schema.StringAttribute{
+				Description: "The URL of an HTTP or HTTPS proxy to send API requests through. Defaults to the proxy set by the `HTTPS_PROXY` and `NO_PROXY` environment variables.",
+				Optional:    true,
+			},
+			"ca_cert_pem": schema.StringAttribute{
+				Description: "PEM encoded CA certificates to trust in addition to the system certificate pool, for example the certificate of a TLS inspecting proxy.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("ca_cert_file")),
+				},
+			},
+			"ca_cert_file": schema.StringAttribute{
+				Description: "The path of a file containing PEM encoded CA certificates to trust in addition to the system certificate pool.",
+				Optional:    true,
+			},
+			"client_cert": schema.StringAttribute{
+				Description: "A PEM encoded client certificate to present when connecting to the API or proxy.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("client_key")),
+				},
+			},
+			"client_key": schema.StringAttribute{
+				Description: "The PEM encoded private key for `client_cert`.",
+				Optional:    true,
+				Sensitive:   true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("client_cert")),
+				},
+			},
+			"request_timeout": schema.Int64Attribute{
+				Description: "The maximum number of seconds to wait for the API to start responding to a request. Requests that time out are retried. Defaults to `60`.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"default_workspace_id": schema.Int64Attribute{
+				Description: "The workspace that resources are created in when their `workspace_id` is not set. Resources that already exist keep their workspace, and resources that set `apply_to_all_workspaces` are not assigned to it.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"insecure_skip_verify": schema.BoolAttribute{
+				Description: "Do not verify the TLS certificate presented by the API. This exposes API keys and data to anyone able to intercept the connection, and should only be used for local testing. Prefer `ca_cert_pem` or `ca_cert_file`.",
+				Optional:    true,
+			},
 		},
 	}
 }
@@ -85,6 +228,42 @@ func (p *filesProvider) Configure(ctx context.Context, req provider.ConfigureReq
 		)
 	}
 
+	if config.Profile.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("profile"),
+			"Unknown Files API Profile",
+			"The provider cannot create the Files API client as there is an unknown configuration value for the Files API profile. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the FILES_PROFILE environment variable.",
+		)
+	}
+
+	if config.CredentialsFile.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("credentials_file"),
+			"Unknown Files API Credentials File",
+			"The provider cannot create the Files API client as there is an unknown configuration value for the Files API credentials file. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the FILES_CREDENTIALS_FILE environment variable.",
+		)
+	}
+
+	if config.Username.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("username"),
+			"Unknown Files API Username",
+			"The provider cannot create the Files API client as there is an unknown configuration value for the Files API username. "+
+				"Either target apply the source of the value first or set the value statically in the configuration.",
+		)
+	}
+
+	if config.Password.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("password"),
+			"Unknown Files API Password",
+			"The provider cannot create the Files API client as there is an unknown configuration value for the Files API password. "+
+				"Either target apply the source of the value first or set the value statically in the configuration.",
+		)
+	}
+
 	if config.EndpointOverride.IsUnknown() {
 		resp.Diagnostics.AddAttributeError(
 			path.Root("endpoint_override"),
@@ -112,19 +291,166 @@ func (p *filesProvider) Configure(ctx context.Context, req provider.ConfigureReq
 		)
 	}
 
+	if config.MaxRetries.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("max_retries"),
+			"Unknown Files API Max Retries",
+			"The provider cannot create the Files API client as there is an unknown configuration value for the Files API max retries. "+
+				"Either target apply the source of the value first or set the value statically in the configuration.",
+		)
+	}
+
+	if config.RetryMaxWait.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("retry_max_wait"),
+			"Unknown Files API Retry Max Wait",
+			"The provider cannot create the Files API client as there is an unknown configuration value for the Files API retry max wait. "+
+				"Either target apply the source of the value first or set the value statically in the configuration.",
+		)
+	}
+
+	if config.RetryOnStatus.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("retry_on_status"),
+			"Unknown Files API Retry On Status",
+			"The provider cannot create the Files API client as there is an unknown configuration value for the Files API retry on status. "+
+				"Either target apply the source of the value first or set the value statically in the configuration.",
+		)
+	}
+
+	if config.RequestsPerSecond.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("requests_per_second"),
+			"Unknown Files API Requests Per Second",
+			"The provider cannot create the Files API client as there is an unknown configuration value for the Files API requests per second. "+
+				"Either target apply the source of the value first or set the value statically in the configuration.",
+		)
+	}
+
+	if config.MaxConcurrentRequests.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("max_concurrent_requests"),
+			"Unknown Files API Max Concurrent Requests",
+			"The provider cannot create the Files API client as there is an unknown configuration value for the Files API max concurrent requests. "+
+				"Either target apply the source of the value first or set the value statically in the configuration.",
+		)
+	}
+
+	if config.ProxyURL.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("proxy_url"),
+			"Unknown Files API Proxy URL",
+			"The provider cannot create the Files API client as there is an unknown configuration value for the Files API proxy URL. "+
+				"Either target apply the source of the value first or set the value statically in the configuration.",
+		)
+	}
+
+	if config.CACertPEM.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("ca_cert_pem"),
+			"Unknown Files API CA Cert PEM",
+			"The provider cannot create the Files API client as there is an unknown configuration value for the Files API CA cert PEM. "+
+				"Either target apply the source of the value first or set the value statically in the configuration.",
+		)
+	}
+
+	if config.CACertFile.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("ca_cert_file"),
+			"Unknown Files API CA Cert File",
+			"The provider cannot create the Files API client as there is an unknown configuration value for the Files API CA cert file. "+
+				"Either target apply the source of the value first or set the value statically in the configuration.",
+		)
+	}
+
+	if config.ClientCert.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("client_cert"),
+			"Unknown Files API Client Cert",
+			"The provider cannot create the Files API client as there is an unknown configuration value for the Files API client cert. "+
+				"Either target apply the source of the value first or set the value statically in the configuration.",
+		)
+	}
+
+	if config.ClientKey.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("client_key"),
+			"Unknown Files API Client Key",
+			"The provider cannot create the Files API client as there is an unknown configuration value for the Files API client key. "+
+				"Either target apply the source of the value first or set the value statically in the configuration.",
+		)
+	}
+
+	if config.RequestTimeout.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("request_timeout"),
+			"Unknown Files API Request Timeout",
+			"The provider cannot create the Files API client as there is an unknown configuration value for the Files API request timeout. "+
+				"Either target apply the source of the value first or set the value statically in the configuration.",
+		)
+	}
+
+	if config.InsecureSkipVerify.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("insecure_skip_verify"),
+			"Unknown Files API Insecure Skip Verify",
+			"The provider cannot create the Files API client as there is an unknown configuration value for the Files API insecure skip verify. "+
+				"Either target apply the source of the value first or set the value statically in the configuration.",
+		)
+	}
+
+	if config.DefaultWorkspaceId.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("default_workspace_id"),
+			"Unknown Files API Default Workspace ID",
+			"The provider cannot create the Files API client as there is an unknown configuration value for the Files API default workspace ID. "+
+				"Either target apply the source of the value first or set the value statically in the configuration.",
+		)
+	}
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	apiKey := os.Getenv("FILES_API_KEY")
+	// Each setting is taken from the provider configuration first, then from
+	// the environment, and finally from the selected credentials profile.
+	profileName := os.Getenv("FILES_PROFILE")
+	if !config.Profile.IsNull() {
+		profileName = config.Profile.ValueString()
+	}
+	credentialsFile := os.Getenv("FILES_CREDENTIALS_FILE")
+	if !config.CredentialsFile.IsNull() {
+		credentialsFile = config.CredentialsFile.ValueString()
+	}
+
+	apiKey := ""
 	endpointOverride := ""
 	environment := ""
+	username := ""
+	password := ""
 	featureFlags := []string{}
+	maxRetries := lib.DefaultMaxRetries
+	retryMaxWait := lib.DefaultRetryMaxWait
+	retryOnStatus := []int{}
+	requestsPerSecond := 0
+	maxConcurrentRequests := 0
+	transportOptions := lib.TransportOptions{}
+	customTransport := false
 
+	if value := os.Getenv("FILES_API_KEY"); value != "" {
+		tflog.Info(ctx, "Using API key from the FILES_API_KEY environment variable")
+		apiKey = value
+	}
 	if !config.APIKey.IsNull() {
 		tflog.Info(ctx, "Using API key from configuration")
 		apiKey = config.APIKey.ValueString()
 	}
+	if !config.Username.IsNull() {
+		tflog.Info(ctx, "Using username and password from configuration")
+		username = config.Username.ValueString()
+		password = config.Password.ValueString()
+		apiKey = ""
+	}
 	if !config.EndpointOverride.IsNull() {
 		tflog.Info(ctx, "Using endpoint override from configuration")
 		endpointOverride = config.EndpointOverride.ValueString()
@@ -133,19 +459,109 @@ func (p *filesProvider) Configure(ctx context.Context, req provider.ConfigureReq
 		tflog.Info(ctx, "Using environment from configuration")
 		environment = config.Environment.ValueString()
 	}
+	// The profile is only read for settings that nothing else provides, so a
+	// broken default credentials file can't get in the way of an API key set
+	// in the configuration or the environment.
+	if (apiKey == "" && username == "") || endpointOverride == "" || environment == "" {
+		profile := p.readProfile(ctx, credentialsFile, profileName, apiKey == "" && username == "", &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if username == "" {
+			apiKey = cmp.Or(apiKey, profile.APIKey)
+		}
+		endpointOverride = cmp.Or(endpointOverride, profile.EndpointOverride)
+		environment = cmp.Or(environment, profile.Environment)
+	}
 	if !config.FeatureFlags.IsNull() {
 		tflog.Info(ctx, "Using feature flags from configuration")
 		diags = config.FeatureFlags.ElementsAs(ctx, &featureFlags, false)
 		resp.Diagnostics.Append(diags...)
 	}
+	if !config.MaxRetries.IsNull() {
+		tflog.Info(ctx, "Using max retries from configuration")
+		maxRetries = int(config.MaxRetries.ValueInt64())
+	}
+	if !config.RetryMaxWait.IsNull() {
+		tflog.Info(ctx, "Using retry max wait from configuration")
+		retryMaxWait = time.Duration(config.RetryMaxWait.ValueInt64()) * time.Second
+	}
+	if !config.RetryOnStatus.IsNull() {
+		tflog.Info(ctx, "Using retry on status from configuration")
+		diags = config.RetryOnStatus.ElementsAs(ctx, &retryOnStatus, false)
+		resp.Diagnostics.Append(diags...)
+	}
+	if !config.RequestsPerSecond.IsNull() {
+		tflog.Info(ctx, "Using requests per second from configuration")
+		requestsPerSecond = int(config.RequestsPerSecond.ValueInt64())
+	}
+	if !config.MaxConcurrentRequests.IsNull() {
+		tflog.Info(ctx, "Using max concurrent requests from configuration")
+		maxConcurrentRequests = int(config.MaxConcurrentRequests.ValueInt64())
+	}
+	if !config.ProxyURL.IsNull() {
+		tflog.Info(ctx, "Using proxy URL from configuration")
+		proxyURL, err := url.Parse(config.ProxyURL.ValueString())
+		if err != nil || proxyURL.Scheme == "" || proxyURL.Host == "" {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("proxy_url"),
+				"Invalid Files API Proxy URL",
+				"The proxy URL must be an absolute URL such as http://proxy.example.com:3128.",
+			)
+		}
+		transportOptions.ProxyURL = proxyURL
+		customTransport = true
+	}
+	if !config.CACertPEM.IsNull() {
+		tflog.Info(ctx, "Using CA cert PEM from configuration")
+		transportOptions.CACertPEM = []byte(config.CACertPEM.ValueString())
+		customTransport = true
+	}
+	if !config.CACertFile.IsNull() {
+		tflog.Info(ctx, "Using CA cert file from configuration")
+		caCertPEM, err := os.ReadFile(config.CACertFile.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("ca_cert_file"),
+				"Unable to Read Files API CA Cert File",
+				"Could not read CA cert file: "+err.Error(),
+			)
+		}
+		transportOptions.CACertPEM = caCertPEM
+		customTransport = true
+	}
+	if !config.ClientCert.IsNull() {
+		tflog.Info(ctx, "Using client cert from configuration")
+		transportOptions.ClientCertPEM = []byte(config.ClientCert.ValueString())
+		transportOptions.ClientKeyPEM = []byte(config.ClientKey.ValueString())
+		customTransport = true
+	}
+	if !config.RequestTimeout.IsNull() {
+		tflog.Info(ctx, "Using request timeout from configuration")
+		transportOptions.ResponseHeaderTimeout = time.Duration(config.RequestTimeout.ValueInt64()) * time.Second
+		customTransport = true
+	}
+	if config.InsecureSkipVerify.ValueBool() {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("insecure_skip_verify"),
+			"Files API TLS Verification Disabled",
+			"The provider will not verify the TLS certificate presented by the Files.com API. "+
+				"Anyone able to intercept the connection can read and modify API keys, credentials and file data sent by Terraform. "+
+				"Use ca_cert_pem or ca_cert_file to trust a proxy's certificate instead, and only disable verification for local testing.",
+		)
+		transportOptions.InsecureSkipVerify = true
+		customTransport = true
+	}
 
-	if apiKey == "" {
+	if apiKey == "" && username == "" {
 		resp.Diagnostics.AddAttributeError(
 			path.Root("api_key"),
 			"Missing Files API Key",
 			"The provider cannot create the Files API client as there is a missing or empty value for the Files API key. "+
-				"Set the API key value in the configuration or use the FILES_API_KEY environment variable. "+
-				"If either is already set, ensure the value is not empty.",
+				"Set the API key value in the configuration, use the FILES_API_KEY environment variable, add api_key to the selected profile in the credentials file, "+
+				"or set username and password in the configuration. "+
+				"The configuration takes precedence over the environment, which takes precedence over the profile. "+
+				"If any of these is already set, ensure the value is not empty.",
 		)
 	}
 
@@ -167,10 +583,112 @@ func (p *filesProvider) Configure(ctx context.Context, req provider.ConfigureReq
 	}
 	sdkConfig = sdkConfig.Init()
 	sdkConfig.Client.Logger = sdkConfig.Logger
+	sdkConfig.Client.RetryMax = maxRetries
+	sdkConfig.Client.RetryWaitMax = retryMaxWait
+	sdkConfig.Client.Backoff = lib.RetryBackoff(retryMaxWait)
+	if len(retryOnStatus) > 0 {
+		sdkConfig.Client.CheckRetry = lib.RetryOnStatus(retryOnStatus, sdkConfig.Client.CheckRetry)
+	}
+	if customTransport || requestsPerSecond > 0 || maxConcurrentRequests > 0 {
+		// The SDK's HTTP client is shared by every Config, so change a copy of it.
+		httpClient := *sdkConfig.Client.HTTPClient
+		if customTransport {
+			transport, err := lib.NewTransport(transportOptions)
+			if err != nil {
+				resp.Diagnostics.AddError(
+					"Unable to Create Files API Client",
+					"Could not configure the HTTP transport: "+err.Error(),
+				)
+				return
+			}
+			httpClient.Transport = transport
+		}
+		if requestsPerSecond > 0 || maxConcurrentRequests > 0 {
+			httpClient.Transport = lib.NewLimitedTransport(httpClient.Transport, float64(requestsPerSecond), maxConcurrentRequests)
+		}
+		sdkConfig.Client.HTTPClient = &httpClient
+	}
 	sdkConfig.UserAgent = "Files.com Terraform " + strings.TrimSpace(p.version) // Set this after Init() to avoid overwriting.
 
+	if username != "" {
+		sessionId, err := loginSessions.login(ctx, sdkConfig, username, password)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("username"),
+				"Unable to Create Files Session",
+				"Could not log in to Files.com as "+username+": "+err.Error(),
+			)
+			return
+		}
+		sdkConfig.SessionId = sessionId
+	}
+
 	resp.DataSourceData = sdkConfig
-	resp.ResourceData = sdkConfig
+	resp.ResourceData = providerResourceData{
+		Config:             sdkConfig,
+		DefaultWorkspaceId: config.DefaultWorkspaceId,
+	}
+	resp.EphemeralResourceData = sdkConfig
+	resp.ActionData = sdkConfig
+	resp.ListResourceData = sdkConfig
+}
+
+// readProfile reads the named profile from the credentials file. Without a
+// profile name, the default profile is read if the credentials file exists.
+// A default profile that can't be read is only an error when the API key
+// must come from it.
+func (p *filesProvider) readProfile(ctx context.Context, credentialsFile string, profileName string, required bool, diags *diag.Diagnostics) lib.Profile {
+	explicitFile := credentialsFile != ""
+	if !explicitFile {
+		var err error
+		credentialsFile, err = lib.DefaultCredentialsFile()
+		if err != nil {
+			if profileName != "" {
+				diags.AddAttributeError(
+					path.Root("credentials_file"),
+					"Unable to Locate Files Credentials File",
+					"Could not determine the default credentials file location: "+err.Error()+". "+
+						"Set credentials_file in the configuration or use the FILES_CREDENTIALS_FILE environment variable.",
+				)
+			}
+			return lib.Profile{}
+		}
+	}
+
+	explicitProfile := profileName != ""
+	if !explicitProfile {
+		profileName = lib.DefaultProfile
+	}
+
+	profile, err := lib.ReadProfile(credentialsFile, profileName)
+	if err != nil {
+		if !explicitProfile && (errors.Is(err, lib.ErrProfileNotFound) || (!explicitFile && errors.Is(err, fs.ErrNotExist))) {
+			return lib.Profile{}
+		}
+		attribute := "profile"
+		if errors.Is(err, fs.ErrNotExist) {
+			attribute = "credentials_file"
+		}
+		if !explicitProfile && !explicitFile && !required {
+			diags.AddAttributeWarning(
+				path.Root(attribute),
+				"Ignoring Files Credentials Profile",
+				"Could not read profile "+profileName+": "+err.Error()+". "+
+					"The API key is set in the configuration or the environment, so the default profile is ignored.",
+			)
+			return lib.Profile{}
+		}
+		diags.AddAttributeError(
+			path.Root(attribute),
+			"Unable to Read Files Credentials Profile",
+			"Could not read profile "+profileName+": "+err.Error()+". "+
+				"Values set in the configuration or the environment take precedence over the profile, but a selected profile must exist.",
+		)
+		return lib.Profile{}
+	}
+
+	tflog.Info(ctx, "Using profile "+profileName+" from "+credentialsFile)
+	return profile
 }
 
 func (p *filesProvider) DataSources(_ context.Context) []func() datasource.DataSource {
@@ -179,12 +697,16 @@ func (p *filesProvider) DataSources(_ context.Context) []func() datasource.DataS
 		NewAiAssistantPersonalityDataSource,
 		NewAiTaskDataSource,
 		NewApiKeyDataSource,
+		NewApiKeysDataSource,
 		NewAs2PartnerDataSource,
 		NewAs2StationDataSource,
 		NewAutomationDataSource,
+		NewAutomationsDataSource,
 		NewAutomationRunDataSource,
 		NewBehaviorDataSource,
+		NewBehaviorsDataSource,
 		NewBundleDataSource,
+		NewBundlesDataSource,
 		NewBundleNotificationDataSource,
 		NewChatSessionDataSource,
 		NewChildSiteManagementPolicyDataSource,
@@ -202,11 +724,14 @@ func (p *filesProvider) DataSources(_ context.Context) []func() datasource.DataS
 		NewExternalEventDataSource,
 		NewFileDataSource,
 		NewFileCommentDataSource,
+		NewFileContentDataSource,
 		NewFileMigrationDataSource,
 		NewFolderDataSource,
+		NewFoldersDataSource,
 		NewFormFieldSetDataSource,
 		NewGpgKeyDataSource,
 		NewGroupDataSource,
+		NewGroupsDataSource,
 		NewGroupUserDataSource,
 		NewHistoryExportDataSource,
 		NewHolidayCalendarDataSource,
@@ -220,6 +745,7 @@ func (p *filesProvider) DataSources(_ context.Context) []func() datasource.DataS
 		NewMessageReactionDataSource,
 		NewMetadataCategoryDataSource,
 		NewNotificationDataSource,
+		NewNotificationsDataSource,
 		NewPartnerDataSource,
 		NewPartnerChannelDataSource,
 		NewPartnerChannelTemplateDataSource,
@@ -227,10 +753,12 @@ func (p *filesProvider) DataSources(_ context.Context) []func() datasource.DataS
 		NewPaymentDataSource,
 		NewPendingWorkEventDataSource,
 		NewPermissionDataSource,
+		NewPermissionsDataSource,
 		NewProjectDataSource,
 		NewPublicKeyDataSource,
 		NewRemoteMountBackendDataSource,
 		NewRemoteServerDataSource,
+		NewRemoteServersDataSource,
 		NewRemoteServerCredentialDataSource,
 		NewRequestDataSource,
 		NewScheduleDataSource,
@@ -248,8 +776,10 @@ func (p *filesProvider) DataSources(_ context.Context) []func() datasource.DataS
 		NewSsoStrategyDataSource,
 		NewStyleDataSource,
 		NewSyncDataSource,
+		NewSyncsDataSource,
 		NewSyncRunDataSource,
 		NewUserDataSource,
+		NewUsersDataSource,
 		NewUserAdditionalEmailRecipientDataSource,
 		NewUserLifecycleRuleDataSource,
 		NewUserRequestDataSource,
@@ -273,6 +803,7 @@ func (p *filesProvider) Resources(_ context.Context) []func() resource.Resource
 		NewClickwrapResource,
 		NewCustomDomainResource,
 		NewDesktopConfigurationProfileResource,
+		NewDirectoryResource,
 		NewEventChannelResource,
 		NewEventSubscriptionResource,
 		NewEventTargetResource,
@@ -312,6 +843,7 @@ func (p *filesProvider) Resources(_ context.Context) []func() resource.Resource
 		NewShareGroupResource,
 		NewSiemHttpDestinationResource,
 		NewSiteResource,
+		NewSiteSettingResource,
 		NewSnapshotResource,
 		NewStyleResource,
 		NewSyncResource,
@@ -322,3 +854,40 @@ func (p *filesProvider) Resources(_ context.Context) []func() resource.Resource
 		NewWorkspaceResource,
 	}
 }
+
+func (p *filesProvider) EphemeralResources(_ context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		NewApiKeyEphemeralResource,
+		NewSessionEphemeralResource,
+	}
+}
+
+func (p *filesProvider) ListResources(_ context.Context) []func() list.ListResource {
+	return []func() list.ListResource{
+		NewAutomationListResource,
+		NewBehaviorListResource,
+		NewBundleListResource,
+		NewFolderListResource,
+		NewGroupListResource,
+		NewPermissionListResource,
+		NewRemoteServerListResource,
+		NewSyncListResource,
+		NewUserListResource,
+	}
+}
+
+func (p *filesProvider) Actions(_ context.Context) []func() action.Action {
+	return []func() action.Action{
+		NewAiTaskRunAction,
+		NewAutomationRunAction,
+		NewSyncRunAction,
+	}
+}
+
+func (p *filesProvider) Functions(_ context.Context) []func() function.Function {
+	return []func() function.Function{
+		NewNormalizePathFunction,
+		NewPathJoinFunction,
+		NewPathParentFunction,
+	}
+}
@@ -13,10 +13,17 @@ import (
 	files_sdk "github.com/Files-com/files-sdk-go/v3"
 	"github.com/dnaeon/go-vcr/cassette"
 	"github.com/dnaeon/go-vcr/recorder"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	frameworkresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 const (
@@ -67,7 +74,13 @@ func (t *testProvider) Configure(ctx context.Context, req provider.ConfigureRequ
 	config := files_sdk.Config{}.Init().SetCustomClient(client)
 
 	resp.DataSourceData = config
-	resp.ResourceData = config
+	resp.ResourceData = providerResourceData{
+		Config:             config,
+		DefaultWorkspaceId: resp.ResourceData.(providerResourceData).DefaultWorkspaceId,
+	}
+	resp.EphemeralResourceData = config
+	resp.ActionData = config
+	resp.ListResourceData = config
 }
 
 func getCachedClient(testName string, resp *provider.ConfigureResponse) *http.Client {
@@ -132,3 +145,99 @@ func closeRecorder(t *testing.T) {
 		clientsLock.Unlock()
 	}
 }
+
+// resourceSchema returns the schema of r and the Terraform type of its values,
+// for tests that call resource methods directly.
+func resourceSchema(r frameworkresource.Resource) (schema.Schema, tftypes.Object) {
+	ctx := context.Background()
+	resp := &frameworkresource.SchemaResponse{}
+	r.Schema(ctx, frameworkresource.SchemaRequest{}, resp)
+	return resp.Schema, resp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+}
+
+// objectValue returns a value of objectType with every attribute null except
+// the given ones.
+func objectValue(objectType tftypes.Object, values map[string]tftypes.Value) tftypes.Value {
+	attributes := map[string]tftypes.Value{}
+	for name, attributeType := range objectType.AttributeTypes {
+		attributes[name] = tftypes.NewValue(attributeType, nil)
+	}
+	for name, value := range values {
+		attributes[name] = value
+	}
+	return tftypes.NewValue(objectType, attributes)
+}
+
+// assertAttributeError checks that diags holds a single error with summary at
+// attributePath, or no error at all when attributePath is empty.
+func assertAttributeError(t *testing.T, diags diag.Diagnostics, summary string, attributePath path.Path) {
+	t.Helper()
+	if len(attributePath.Steps()) == 0 {
+		assert.False(t, diags.HasError(), diags)
+		return
+	}
+	if assert.Len(t, diags, 1) {
+		assert.Equal(t, summary, diags[0].Summary())
+		assert.Equal(t, attributePath, diags[0].(diag.DiagnosticWithPath).Path())
+	}
+}
+
+// testPrivateState is an in-memory private state.
+type testPrivateState map[string][]byte
+
+func (p testPrivateState) GetKey(_ context.Context, key string) ([]byte, diag.Diagnostics) {
+	return p[key], nil
+}
+
+func (p testPrivateState) SetKey(_ context.Context, key string, value []byte) diag.Diagnostics {
+	p[key] = value
+	return nil
+}
+
+// configureProvider starts a provider server and configures it with the given
+// provider attributes, for tests that talk to the provider over the protocol.
+func configureProvider(t *testing.T, values map[string]tftypes.Value) (tfprotov6.ProviderServer, *tfprotov6.GetProviderSchemaResponse, []*tfprotov6.Diagnostic) {
+	t.Helper()
+	ctx := context.Background()
+	providerServer, err := providerserver.NewProtocol6WithError(New("test")())()
+	require.NoError(t, err)
+	schemaResp, err := providerServer.GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+	require.NoError(t, err)
+	configureResp, err := providerServer.ConfigureProvider(ctx, &tfprotov6.ConfigureProviderRequest{
+		Config: dynamicValue(t, schemaResp.Provider, values),
+	})
+	require.NoError(t, err)
+	return providerServer, schemaResp, configureResp.Diagnostics
+}
+
+// dynamicValue encodes a value of schema with every attribute null except the
+// given ones.
+func dynamicValue(t *testing.T, schema *tfprotov6.Schema, values map[string]tftypes.Value) *tfprotov6.DynamicValue {
+	t.Helper()
+	value, err := tfprotov6.NewDynamicValue(schema.ValueType(), objectValue(schema.ValueType().(tftypes.Object), values))
+	require.NoError(t, err)
+	return &value
+}
+
+func TestConfigureIgnoresUnreadableDefaultProfile(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	t.Setenv("XDG_CONFIG_HOME", "")
+	t.Setenv("FILES_API_KEY", "")
+	t.Setenv("FILES_PROFILE", "")
+	t.Setenv("FILES_CREDENTIALS_FILE", "")
+	credentialsFile := filepath.Join(home, ".config", "files", "credentials")
+	require.NoError(t, os.MkdirAll(filepath.Dir(credentialsFile), 0o700))
+	require.NoError(t, os.WriteFile(credentialsFile, []byte("[default\napi_key = broken\n"), 0o600))
+
+	_, _, diags := configureProvider(t, map[string]tftypes.Value{"api_key": tftypes.NewValue(tftypes.String, "configured-api-key")})
+	if assert.Len(t, diags, 1) {
+		assert.Equal(t, tfprotov6.DiagnosticSeverityWarning, diags[0].Severity)
+	}
+
+	_, _, diags = configureProvider(t, map[string]tftypes.Value{})
+	if assert.Len(t, diags, 1) {
+		assert.Equal(t, tfprotov6.DiagnosticSeverityError, diags[0].Severity)
+		assert.Equal(t, "Unable to Read Files Credentials Profile", diags[0].Summary)
+	}
+}
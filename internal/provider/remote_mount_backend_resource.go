@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
@@ -28,6 +29,7 @@ var (
 	_ resource.Resource                = &remoteMountBackendResource{}
 	_ resource.ResourceWithConfigure   = &remoteMountBackendResource{}
 	_ resource.ResourceWithImportState = &remoteMountBackendResource{}
+	_ resource.ResourceWithIdentity    = &remoteMountBackendResource{}
 )
 
 func NewRemoteMountBackendResource() resource.Resource {
@@ -63,18 +65,18 @@ func (r *remoteMountBackendResource) Configure(_ context.Context, req resource.C
 		return
 	}
 
-	sdk_config, ok := req.ProviderData.(files_sdk.Config)
+	provider_data, ok := req.ProviderData.(providerResourceData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected files_sdk.Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected providerResourceData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.client = &remote_mount_backend.Client{Config: sdk_config}
+	r.client = &remote_mount_backend.Client{Config: provider_data.Config}
 }
 
 func (r *remoteMountBackendResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -167,6 +169,9 @@ func (r *remoteMountBackendResource) Schema(_ context.Context, _ resource.Schema
 					int64planmodifier.UseStateForUnknown(),
 				},
 			},
+			// remote_path is a path on the remote server, so it is compared exactly
+			// rather than with lib.NormalizedPathType, because its file system may be
+			// case sensitive.
 			"remote_path": schema.StringAttribute{
 				Description: "Path on the remote server to treat as the root of this mount.",
 				Computed:    true,
@@ -209,6 +214,17 @@ func (r *remoteMountBackendResource) Schema(_ context.Context, _ resource.Schema
 	}
 }
 
+func (r *remoteMountBackendResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.Int64Attribute{
+				Description:       "Unique identifier for this backend.",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
 func (r *remoteMountBackendResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan remoteMountBackendResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -285,6 +301,12 @@ func (r *remoteMountBackendResource) Create(ctx context.Context, req resource.Cr
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.Identity.SetAttribute(ctx, path.Root("id"), plan.Id)
+	resp.Diagnostics.Append(diags...)
 }
 
 func (r *remoteMountBackendResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -320,6 +342,12 @@ func (r *remoteMountBackendResource) Read(ctx context.Context, req resource.Read
 
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.Identity.SetAttribute(ctx, path.Root("id"), state.Id)
+	resp.Diagnostics.Append(diags...)
 }
 
 func (r *remoteMountBackendResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -416,6 +444,12 @@ func (r *remoteMountBackendResource) Update(ctx context.Context, req resource.Up
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.Identity.SetAttribute(ctx, path.Root("id"), plan.Id)
+	resp.Diagnostics.Append(diags...)
 }
 
 func (r *remoteMountBackendResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -439,6 +473,13 @@ func (r *remoteMountBackendResource) Delete(ctx context.Context, req resource.De
 }
 
 func (r *remoteMountBackendResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID == "" {
+		var id types.Int64
+		resp.Diagnostics.Append(req.Identity.GetAttribute(ctx, path.Root("id"), &id)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+		return
+	}
+
 	idParts := strings.SplitN(req.ID, ",", 1)
 
 	if len(idParts) != 1 || idParts[0] == "" {
@@ -9,10 +9,12 @@ import (
 	files_sdk "github.com/Files-com/files-sdk-go/v3"
 	remote_server_credential "github.com/Files-com/files-sdk-go/v3/remoteservercredential"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 
@@ -26,6 +28,8 @@ var (
 	_ resource.Resource                = &remoteServerCredentialResource{}
 	_ resource.ResourceWithConfigure   = &remoteServerCredentialResource{}
 	_ resource.ResourceWithImportState = &remoteServerCredentialResource{}
+	_ resource.ResourceWithIdentity    = &remoteServerCredentialResource{}
+	_ resource.ResourceWithModifyPlan  = &remoteServerCredentialResource{}
 )
 
 func NewRemoteServerCredentialResource() resource.Resource {
@@ -33,49 +37,69 @@ func NewRemoteServerCredentialResource() resource.Resource {
 }
 
 type remoteServerCredentialResource struct {
-	client *remote_server_credential.Client
+	client             *remote_server_credential.Client
+	defaultWorkspaceId types.Int64
 }
 
 type remoteServerCredentialResourceModel struct {
-	WorkspaceId                             types.Int64  `tfsdk:"workspace_id"`
-	Name                                    types.String `tfsdk:"name"`
-	Description                             types.String `tfsdk:"description"`
-	ServerType                              types.String `tfsdk:"server_type"`
-	AwsAccessKey                            types.String `tfsdk:"aws_access_key"`
-	S3AssumeRoleArn                         types.String `tfsdk:"s3_assume_role_arn"`
-	S3AssumeRoleDurationSeconds             types.Int64  `tfsdk:"s3_assume_role_duration_seconds"`
-	GoogleCloudStorageS3CompatibleAccessKey types.String `tfsdk:"google_cloud_storage_s3_compatible_access_key"`
-	WasabiAccessKey                         types.String `tfsdk:"wasabi_access_key"`
-	S3CompatibleAccessKey                   types.String `tfsdk:"s3_compatible_access_key"`
-	FilebaseAccessKey                       types.String `tfsdk:"filebase_access_key"`
-	CloudflareAccessKey                     types.String `tfsdk:"cloudflare_access_key"`
-	LinodeAccessKey                         types.String `tfsdk:"linode_access_key"`
-	SharepointTenantId                      types.String `tfsdk:"sharepoint_tenant_id"`
-	SharepointClientId                      types.String `tfsdk:"sharepoint_client_id"`
-	Username                                types.String `tfsdk:"username"`
-	Password                                types.String `tfsdk:"password"`
-	PrivateKey                              types.String `tfsdk:"private_key"`
-	PrivateKeyPassphrase                    types.String `tfsdk:"private_key_passphrase"`
-	AwsSecretKey                            types.String `tfsdk:"aws_secret_key"`
-	AzureBlobStorageAccessKey               types.String `tfsdk:"azure_blob_storage_access_key"`
-	AzureBlobStorageSasToken                types.String `tfsdk:"azure_blob_storage_sas_token"`
-	AzureFilesStorageAccessKey              types.String `tfsdk:"azure_files_storage_access_key"`
-	AzureFilesStorageSasToken               types.String `tfsdk:"azure_files_storage_sas_token"`
-	BackblazeB2ApplicationKey               types.String `tfsdk:"backblaze_b2_application_key"`
-	BackblazeB2KeyId                        types.String `tfsdk:"backblaze_b2_key_id"`
-	CloudflareSecretKey                     types.String `tfsdk:"cloudflare_secret_key"`
-	FilebaseSecretKey                       types.String `tfsdk:"filebase_secret_key"`
-	GoogleCloudStorageCredentialsJson       types.String `tfsdk:"google_cloud_storage_credentials_json"`
-	GoogleCloudStorageS3CompatibleSecretKey types.String `tfsdk:"google_cloud_storage_s3_compatible_secret_key"`
-	LinodeSecretKey                         types.String `tfsdk:"linode_secret_key"`
-	S3CompatibleSecretKey                   types.String `tfsdk:"s3_compatible_secret_key"`
-	SharepointClientCertificate             types.String `tfsdk:"sharepoint_client_certificate"`
-	SharepointClientSecret                  types.String `tfsdk:"sharepoint_client_secret"`
-	WasabiSecretKey                         types.String `tfsdk:"wasabi_secret_key"`
-	CopyValuesFromCredentialId              types.Int64  `tfsdk:"copy_values_from_credential_id"`
-	Id                                      types.Int64  `tfsdk:"id"`
-	S3AssumeRoleExternalId                  types.String `tfsdk:"s3_assume_role_external_id"`
-	SharepointAppCredentialType             types.String `tfsdk:"sharepoint_app_credential_type"`
+	WorkspaceId                                    types.Int64  `tfsdk:"workspace_id"`
+	Name                                           types.String `tfsdk:"name"`
+	Description                                    types.String `tfsdk:"description"`
+	ServerType                                     types.String `tfsdk:"server_type"`
+	AwsAccessKey                                   types.String `tfsdk:"aws_access_key"`
+	S3AssumeRoleArn                                types.String `tfsdk:"s3_assume_role_arn"`
+	S3AssumeRoleDurationSeconds                    types.Int64  `tfsdk:"s3_assume_role_duration_seconds"`
+	GoogleCloudStorageS3CompatibleAccessKey        types.String `tfsdk:"google_cloud_storage_s3_compatible_access_key"`
+	WasabiAccessKey                                types.String `tfsdk:"wasabi_access_key"`
+	S3CompatibleAccessKey                          types.String `tfsdk:"s3_compatible_access_key"`
+	FilebaseAccessKey                              types.String `tfsdk:"filebase_access_key"`
+	CloudflareAccessKey                            types.String `tfsdk:"cloudflare_access_key"`
+	LinodeAccessKey                                types.String `tfsdk:"linode_access_key"`
+	SharepointTenantId                             types.String `tfsdk:"sharepoint_tenant_id"`
+	SharepointClientId                             types.String `tfsdk:"sharepoint_client_id"`
+	Username                                       types.String `tfsdk:"username"`
+	Password                                       types.String `tfsdk:"password"`
+	PasswordVersion                                types.Int64  `tfsdk:"password_version"`
+	PrivateKey                                     types.String `tfsdk:"private_key"`
+	PrivateKeyVersion                              types.Int64  `tfsdk:"private_key_version"`
+	PrivateKeyPassphrase                           types.String `tfsdk:"private_key_passphrase"`
+	PrivateKeyPassphraseVersion                    types.Int64  `tfsdk:"private_key_passphrase_version"`
+	AwsSecretKey                                   types.String `tfsdk:"aws_secret_key"`
+	AwsSecretKeyVersion                            types.Int64  `tfsdk:"aws_secret_key_version"`
+	AzureBlobStorageAccessKey                      types.String `tfsdk:"azure_blob_storage_access_key"`
+	AzureBlobStorageAccessKeyVersion               types.Int64  `tfsdk:"azure_blob_storage_access_key_version"`
+	AzureBlobStorageSasToken                       types.String `tfsdk:"azure_blob_storage_sas_token"`
+	AzureBlobStorageSasTokenVersion                types.Int64  `tfsdk:"azure_blob_storage_sas_token_version"`
+	AzureFilesStorageAccessKey                     types.String `tfsdk:"azure_files_storage_access_key"`
+	AzureFilesStorageAccessKeyVersion              types.Int64  `tfsdk:"azure_files_storage_access_key_version"`
+	AzureFilesStorageSasToken                      types.String `tfsdk:"azure_files_storage_sas_token"`
+	AzureFilesStorageSasTokenVersion               types.Int64  `tfsdk:"azure_files_storage_sas_token_version"`
+	BackblazeB2ApplicationKey                      types.String `tfsdk:"backblaze_b2_application_key"`
+	BackblazeB2ApplicationKeyVersion               types.Int64  `tfsdk:"backblaze_b2_application_key_version"`
+	BackblazeB2KeyId                               types.String `tfsdk:"backblaze_b2_key_id"`
+	BackblazeB2KeyIdVersion                        types.Int64  `tfsdk:"backblaze_b2_key_id_version"`
+	CloudflareSecretKey                            types.String `tfsdk:"cloudflare_secret_key"`
+	CloudflareSecretKeyVersion                     types.Int64  `tfsdk:"cloudflare_secret_key_version"`
+	FilebaseSecretKey                              types.String `tfsdk:"filebase_secret_key"`
+	FilebaseSecretKeyVersion                       types.Int64  `tfsdk:"filebase_secret_key_version"`
+	GoogleCloudStorageCredentialsJson              types.String `tfsdk:"google_cloud_storage_credentials_json"`
+	GoogleCloudStorageCredentialsJsonVersion       types.Int64  `tfsdk:"google_cloud_storage_credentials_json_version"`
+	GoogleCloudStorageS3CompatibleSecretKey        types.String `tfsdk:"google_cloud_storage_s3_compatible_secret_key"`
+	GoogleCloudStorageS3CompatibleSecretKeyVersion types.Int64  `tfsdk:"google_cloud_storage_s3_compatible_secret_key_version"`
+	LinodeSecretKey                                types.String `tfsdk:"linode_secret_key"`
+	LinodeSecretKeyVersion                         types.Int64  `tfsdk:"linode_secret_key_version"`
+	S3CompatibleSecretKey                          types.String `tfsdk:"s3_compatible_secret_key"`
+	S3CompatibleSecretKeyVersion                   types.Int64  `tfsdk:"s3_compatible_secret_key_version"`
+	SharepointClientCertificate                    types.String `tfsdk:"sharepoint_client_certificate"`
+	SharepointClientCertificateVersion             types.Int64  `tfsdk:"sharepoint_client_certificate_version"`
+	SharepointClientSecret                         types.String `tfsdk:"sharepoint_client_secret"`
+	SharepointClientSecretVersion                  types.Int64  `tfsdk:"sharepoint_client_secret_version"`
+	WasabiSecretKey                                types.String `tfsdk:"wasabi_secret_key"`
+	WasabiSecretKeyVersion                         types.Int64  `tfsdk:"wasabi_secret_key_version"`
+	CopyValuesFromCredentialId                     types.Int64  `tfsdk:"copy_values_from_credential_id"`
+	Id                                             types.Int64  `tfsdk:"id"`
+	S3AssumeRoleExternalId                         types.String `tfsdk:"s3_assume_role_external_id"`
+	SharepointAppCredentialType                    types.String `tfsdk:"sharepoint_app_credential_type"`
 }
 
 func (r *remoteServerCredentialResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -83,18 +107,19 @@ func (r *remoteServerCredentialResource) Configure(_ context.Context, req resour
 		return
 	}
 
-	sdk_config, ok := req.ProviderData.(files_sdk.Config)
+	provider_data, ok := req.ProviderData.(providerResourceData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected files_sdk.Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected providerResourceData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.client = &remote_server_credential.Client{Config: sdk_config}
+	r.client = &remote_server_credential.Client{Config: provider_data.Config}
+	r.defaultWorkspaceId = provider_data.DefaultWorkspaceId
 }
 
 func (r *remoteServerCredentialResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -242,96 +267,229 @@ func (r *remoteServerCredentialResource) Schema(_ context.Context, _ resource.Sc
 				Optional:    true,
 				WriteOnly:   true,
 			},
+			"password_version": schema.Int64Attribute{
+				Description: "Change this value to send the write-only `password` to Files.com again, for example after rotating it.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRoot("password")),
+				},
+			},
 			"private_key": schema.StringAttribute{
 				Description: "Private key, if needed.",
 				Optional:    true,
 				WriteOnly:   true,
 			},
+			"private_key_version": schema.Int64Attribute{
+				Description: "Change this value to send the write-only `private_key` to Files.com again, for example after rotating it.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRoot("private_key")),
+				},
+			},
 			"private_key_passphrase": schema.StringAttribute{
 				Description: "Passphrase for private key if needed.",
 				Optional:    true,
 				WriteOnly:   true,
 			},
+			"private_key_passphrase_version": schema.Int64Attribute{
+				Description: "Change this value to send the write-only `private_key_passphrase` to Files.com again, for example after rotating it.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRoot("private_key_passphrase")),
+				},
+			},
 			"aws_secret_key": schema.StringAttribute{
 				Description: "AWS: secret key.",
 				Optional:    true,
 				WriteOnly:   true,
 			},
+			"aws_secret_key_version": schema.Int64Attribute{
+				Description: "Change this value to send the write-only `aws_secret_key` to Files.com again, for example after rotating it.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRoot("aws_secret_key")),
+				},
+			},
 			"azure_blob_storage_access_key": schema.StringAttribute{
 				Description: "Azure Blob Storage: Access Key",
 				Optional:    true,
 				WriteOnly:   true,
 			},
+			"azure_blob_storage_access_key_version": schema.Int64Attribute{
+				Description: "Change this value to send the write-only `azure_blob_storage_access_key` to Files.com again, for example after rotating it.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRoot("azure_blob_storage_access_key")),
+				},
+			},
 			"azure_blob_storage_sas_token": schema.StringAttribute{
 				Description: "Azure Blob Storage: Shared Access Signature (SAS) token",
 				Optional:    true,
 				WriteOnly:   true,
 			},
+			"azure_blob_storage_sas_token_version": schema.Int64Attribute{
+				Description: "Change this value to send the write-only `azure_blob_storage_sas_token` to Files.com again, for example after rotating it.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRoot("azure_blob_storage_sas_token")),
+				},
+			},
 			"azure_files_storage_access_key": schema.StringAttribute{
 				Description: "Azure File Storage: Access Key",
 				Optional:    true,
 				WriteOnly:   true,
 			},
+			"azure_files_storage_access_key_version": schema.Int64Attribute{
+				Description: "Change this value to send the write-only `azure_files_storage_access_key` to Files.com again, for example after rotating it.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRoot("azure_files_storage_access_key")),
+				},
+			},
 			"azure_files_storage_sas_token": schema.StringAttribute{
 				Description: "Azure File Storage: Shared Access Signature (SAS) token",
 				Optional:    true,
 				WriteOnly:   true,
 			},
+			"azure_files_storage_sas_token_version": schema.Int64Attribute{
+				Description: "Change this value to send the write-only `azure_files_storage_sas_token` to Files.com again, for example after rotating it.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRoot("azure_files_storage_sas_token")),
+				},
+			},
 			"backblaze_b2_application_key": schema.StringAttribute{
 				Description: "Backblaze B2 Cloud Storage: applicationKey",
 				Optional:    true,
 				WriteOnly:   true,
 			},
+			"backblaze_b2_application_key_version": schema.Int64Attribute{
+				Description: "Change this value to send the write-only `backblaze_b2_application_key` to Files.com again, for example after rotating it.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRoot("backblaze_b2_application_key")),
+				},
+			},
 			"backblaze_b2_key_id": schema.StringAttribute{
 				Description: "Backblaze B2 Cloud Storage: keyID",
 				Optional:    true,
 				WriteOnly:   true,
 			},
+			"backblaze_b2_key_id_version": schema.Int64Attribute{
+				Description: "Change this value to send the write-only `backblaze_b2_key_id` to Files.com again, for example after rotating it.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRoot("backblaze_b2_key_id")),
+				},
+			},
 			"cloudflare_secret_key": schema.StringAttribute{
 				Description: "Cloudflare: Secret Key",
 				Optional:    true,
 				WriteOnly:   true,
 			},
+			"cloudflare_secret_key_version": schema.Int64Attribute{
+				Description: "Change this value to send the write-only `cloudflare_secret_key` to Files.com again, for example after rotating it.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRoot("cloudflare_secret_key")),
+				},
+			},
 			"filebase_secret_key": schema.StringAttribute{
 				Description: "Filebase: Secret Key",
 				Optional:    true,
 				WriteOnly:   true,
 			},
+			"filebase_secret_key_version": schema.Int64Attribute{
+				Description: "Change this value to send the write-only `filebase_secret_key` to Files.com again, for example after rotating it.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRoot("filebase_secret_key")),
+				},
+			},
 			"google_cloud_storage_credentials_json": schema.StringAttribute{
 				Description: "Google Cloud Storage: JSON file that contains the private key. To generate see https://cloud.google.com/storage/docs/json_api/v1/how-tos/authorizing#APIKey",
 				Optional:    true,
 				WriteOnly:   true,
 			},
+			"google_cloud_storage_credentials_json_version": schema.Int64Attribute{
+				Description: "Change this value to send the write-only `google_cloud_storage_credentials_json` to Files.com again, for example after rotating it.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRoot("google_cloud_storage_credentials_json")),
+				},
+			},
 			"google_cloud_storage_s3_compatible_secret_key": schema.StringAttribute{
 				Description: "Google Cloud Storage: S3-compatible secret key",
 				Optional:    true,
 				WriteOnly:   true,
 			},
+			"google_cloud_storage_s3_compatible_secret_key_version": schema.Int64Attribute{
+				Description: "Change this value to send the write-only `google_cloud_storage_s3_compatible_secret_key` to Files.com again, for example after rotating it.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRoot("google_cloud_storage_s3_compatible_secret_key")),
+				},
+			},
 			"linode_secret_key": schema.StringAttribute{
 				Description: "Linode: Secret Key",
 				Optional:    true,
 				WriteOnly:   true,
 			},
+			"linode_secret_key_version": schema.Int64Attribute{
+				Description: "Change this value to send the write-only `linode_secret_key` to Files.com again, for example after rotating it.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRoot("linode_secret_key")),
+				},
+			},
 			"s3_compatible_secret_key": schema.StringAttribute{
 				Description: "S3-compatible: Secret Key",
 				Optional:    true,
 				WriteOnly:   true,
 			},
+			"s3_compatible_secret_key_version": schema.Int64Attribute{
+				Description: "Change this value to send the write-only `s3_compatible_secret_key` to Files.com again, for example after rotating it.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRoot("s3_compatible_secret_key")),
+				},
+			},
 			"sharepoint_client_certificate": schema.StringAttribute{
 				Description: "SharePoint: PEM-encoded certificate and unencrypted private key for app-only authentication.",
 				Optional:    true,
 				WriteOnly:   true,
 			},
+			"sharepoint_client_certificate_version": schema.Int64Attribute{
+				Description: "Change this value to send the write-only `sharepoint_client_certificate` to Files.com again, for example after rotating it.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRoot("sharepoint_client_certificate")),
+				},
+			},
 			"sharepoint_client_secret": schema.StringAttribute{
 				Description: "SharePoint: Microsoft Entra application client secret for app-only authentication.",
 				Optional:    true,
 				WriteOnly:   true,
 			},
+			"sharepoint_client_secret_version": schema.Int64Attribute{
+				Description: "Change this value to send the write-only `sharepoint_client_secret` to Files.com again, for example after rotating it.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRoot("sharepoint_client_secret")),
+				},
+			},
 			"wasabi_secret_key": schema.StringAttribute{
 				Description: "Wasabi: Secret Key",
 				Optional:    true,
 				WriteOnly:   true,
 			},
+			"wasabi_secret_key_version": schema.Int64Attribute{
+				Description: "Change this value to send the write-only `wasabi_secret_key` to Files.com again, for example after rotating it.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRoot("wasabi_secret_key")),
+				},
+			},
 			"copy_values_from_credential_id": schema.Int64Attribute{
 				Description: "ID of Remote Server Credential to copy omitted values from.",
 				Optional:    true,
@@ -359,6 +517,21 @@ func (r *remoteServerCredentialResource) Schema(_ context.Context, _ resource.Sc
 	}
 }
 
+func (r *remoteServerCredentialResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.Int64Attribute{
+				Description:       "Remote Server Credential ID",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+func (r *remoteServerCredentialResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyPlanDefaultWorkspaceId(ctx, r.defaultWorkspaceId, req, resp)
+}
+
 func (r *remoteServerCredentialResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan remoteServerCredentialResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -432,6 +605,12 @@ func (r *remoteServerCredentialResource) Create(ctx context.Context, req resourc
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.Identity.SetAttribute(ctx, path.Root("id"), plan.Id)
+	resp.Diagnostics.Append(diags...)
 }
 
 func (r *remoteServerCredentialResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -467,6 +646,12 @@ func (r *remoteServerCredentialResource) Read(ctx context.Context, req resource.
 
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.Identity.SetAttribute(ctx, path.Root("id"), state.Id)
+	resp.Diagnostics.Append(diags...)
 }
 
 func (r *remoteServerCredentialResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -611,6 +796,12 @@ func (r *remoteServerCredentialResource) Update(ctx context.Context, req resourc
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.Identity.SetAttribute(ctx, path.Root("id"), plan.Id)
+	resp.Diagnostics.Append(diags...)
 }
 
 func (r *remoteServerCredentialResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -634,6 +825,13 @@ func (r *remoteServerCredentialResource) Delete(ctx context.Context, req resourc
 }
 
 func (r *remoteServerCredentialResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID == "" {
+		var id types.Int64
+		resp.Diagnostics.Append(req.Identity.GetAttribute(ctx, path.Root("id"), &id)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+		return
+	}
+
 	idParts := strings.SplitN(req.ID, ",", 1)
 
 	if len(idParts) != 1 || idParts[0] == "" {
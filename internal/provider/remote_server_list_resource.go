@@ -0,0 +1,110 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	files_sdk "github.com/Files-com/files-sdk-go/v3"
+	remote_server "github.com/Files-com/files-sdk-go/v3/remoteserver"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ list.ListResource              = &remoteServerListResource{}
+	_ list.ListResourceWithConfigure = &remoteServerListResource{}
+)
+
+func NewRemoteServerListResource() list.ListResource {
+	return &remoteServerListResource{}
+}
+
+type remoteServerListResource struct {
+	client *remote_server.Client
+}
+
+type remoteServerListResourceModel struct {
+	Filter       types.Map `tfsdk:"filter"`
+	FilterPrefix types.Map `tfsdk:"filter_prefix"`
+}
+
+func (r *remoteServerListResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	sdk_config, ok := req.ProviderData.(files_sdk.Config)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected List Resource Configure Type",
+			fmt.Sprintf("Expected files_sdk.Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = &remote_server.Client{Config: sdk_config}
+}
+
+func (r *remoteServerListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_remote_server"
+}
+
+func (r *remoteServerListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists every Remote Server matching the given arguments so `terraform query` can import them.",
+		Attributes: map[string]schema.Attribute{
+			"filter": schema.MapAttribute{
+				Description: "If set, return records where the specified field is equal to the supplied value.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"filter_prefix": schema.MapAttribute{
+				Description: "If set, return records where the specified field is prefixed by the supplied value.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+func (r *remoteServerListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var data remoteServerListResourceModel
+	diags := req.Config.Get(ctx, &data)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	var propDiags diag.Diagnostics
+	paramsRemoteServerList := files_sdk.RemoteServerListParams{}
+	paramsRemoteServerList.Filter, propDiags = listFilterValue(ctx, path.Root("filter"), data.Filter)
+	diags.Append(propDiags...)
+	paramsRemoteServerList.FilterPrefix, propDiags = listFilterValue(ctx, path.Root("filter_prefix"), data.FilterPrefix)
+	diags.Append(propDiags...)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	remoteServerIt, err := r.client.List(paramsRemoteServerList, files_sdk.WithContext(ctx))
+	if err != nil {
+		diags.AddError(
+			"Error Listing Files Remote Servers",
+			"Could not list remote servers: "+err.Error(),
+		)
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	stream.Results = listResults(ctx, req, remoteServerIt, "Error Listing Files Remote Servers", "Could not list remote servers: ", func(remoteServer files_sdk.RemoteServer, result *list.ListResult) {
+		result.DisplayName = remoteServer.Name
+		result.Diagnostics.Append(result.Identity.SetAttribute(ctx, path.Root("id"), remoteServer.Id)...)
+	}, (&remoteServerResource{}).populateResourceModel)
+}
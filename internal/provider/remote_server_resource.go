@@ -9,10 +9,12 @@ import (
 	files_sdk "github.com/Files-com/files-sdk-go/v3"
 	remote_server "github.com/Files-com/files-sdk-go/v3/remoteserver"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
@@ -27,6 +29,8 @@ var (
 	_ resource.Resource                = &remoteServerResource{}
 	_ resource.ResourceWithConfigure   = &remoteServerResource{}
 	_ resource.ResourceWithImportState = &remoteServerResource{}
+	_ resource.ResourceWithIdentity    = &remoteServerResource{}
+	_ resource.ResourceWithModifyPlan  = &remoteServerResource{}
 )
 
 func NewRemoteServerResource() resource.Resource {
@@ -34,111 +38,133 @@ func NewRemoteServerResource() resource.Resource {
 }
 
 type remoteServerResource struct {
-	client *remote_server.Client
+	client             *remote_server.Client
+	defaultWorkspaceId types.Int64
 }
 
 type remoteServerResourceModel struct {
-	Hostname                                types.String `tfsdk:"hostname"`
-	UploadStagingPath                       types.String `tfsdk:"upload_staging_path"`
-	AllowRelativePaths                      types.Bool   `tfsdk:"allow_relative_paths"`
-	Name                                    types.String `tfsdk:"name"`
-	Description                             types.String `tfsdk:"description"`
-	Port                                    types.Int64  `tfsdk:"port"`
-	BufferUploads                           types.String `tfsdk:"buffer_uploads"`
-	MaxConnections                          types.Int64  `tfsdk:"max_connections"`
-	PinToSiteRegion                         types.Bool   `tfsdk:"pin_to_site_region"`
-	RemoteServerCredentialId                types.Int64  `tfsdk:"remote_server_credential_id"`
-	S3Bucket                                types.String `tfsdk:"s3_bucket"`
-	S3Region                                types.String `tfsdk:"s3_region"`
-	AwsAccessKey                            types.String `tfsdk:"aws_access_key"`
-	S3AssumeRoleArn                         types.String `tfsdk:"s3_assume_role_arn"`
-	S3AssumeRoleDurationSeconds             types.Int64  `tfsdk:"s3_assume_role_duration_seconds"`
-	ServerCertificate                       types.String `tfsdk:"server_certificate"`
-	ServerHostKey                           types.String `tfsdk:"server_host_key"`
-	ServerType                              types.String `tfsdk:"server_type"`
-	WorkspaceId                             types.Int64  `tfsdk:"workspace_id"`
-	Ssl                                     types.String `tfsdk:"ssl"`
-	Username                                types.String `tfsdk:"username"`
-	GoogleCloudStorageBucket                types.String `tfsdk:"google_cloud_storage_bucket"`
-	GoogleCloudStorageAuthenticationMethod  types.String `tfsdk:"google_cloud_storage_authentication_method"`
-	GoogleCloudStorageOauthScope            types.String `tfsdk:"google_cloud_storage_oauth_scope"`
-	GoogleCloudStorageProjectId             types.String `tfsdk:"google_cloud_storage_project_id"`
-	GoogleCloudStorageS3CompatibleAccessKey types.String `tfsdk:"google_cloud_storage_s3_compatible_access_key"`
-	BackblazeB2S3Endpoint                   types.String `tfsdk:"backblaze_b2_s3_endpoint"`
-	BackblazeB2Bucket                       types.String `tfsdk:"backblaze_b2_bucket"`
-	WasabiBucket                            types.String `tfsdk:"wasabi_bucket"`
-	WasabiRegion                            types.String `tfsdk:"wasabi_region"`
-	WasabiAccessKey                         types.String `tfsdk:"wasabi_access_key"`
-	OneDriveAccountType                     types.String `tfsdk:"one_drive_account_type"`
-	SharepointTenantId                      types.String `tfsdk:"sharepoint_tenant_id"`
-	SharepointClientId                      types.String `tfsdk:"sharepoint_client_id"`
-	SharepointSiteUrl                       types.String `tfsdk:"sharepoint_site_url"`
-	AzureBlobStorageAccount                 types.String `tfsdk:"azure_blob_storage_account"`
-	AzureBlobStorageContainer               types.String `tfsdk:"azure_blob_storage_container"`
-	AzureBlobStorageHierarchicalNamespace   types.Bool   `tfsdk:"azure_blob_storage_hierarchical_namespace"`
-	AzureBlobStorageDnsSuffix               types.String `tfsdk:"azure_blob_storage_dns_suffix"`
-	AzureFilesStorageAccount                types.String `tfsdk:"azure_files_storage_account"`
-	AzureFilesStorageShareName              types.String `tfsdk:"azure_files_storage_share_name"`
-	AzureFilesStorageDnsSuffix              types.String `tfsdk:"azure_files_storage_dns_suffix"`
-	S3CompatibleBucket                      types.String `tfsdk:"s3_compatible_bucket"`
-	S3CompatibleEndpoint                    types.String `tfsdk:"s3_compatible_endpoint"`
-	S3CompatibleRegion                      types.String `tfsdk:"s3_compatible_region"`
-	S3CompatibleVirtualHostedStyle          types.Bool   `tfsdk:"s3_compatible_virtual_hosted_style"`
-	S3CompatibleAccessKey                   types.String `tfsdk:"s3_compatible_access_key"`
-	EnableDedicatedIps                      types.Bool   `tfsdk:"enable_dedicated_ips"`
-	FilesAgentPermissionSet                 types.String `tfsdk:"files_agent_permission_set"`
-	FilesAgentRoot                          types.String `tfsdk:"files_agent_root"`
-	FilesAgentVersion                       types.String `tfsdk:"files_agent_version"`
-	OutboundAgentId                         types.Int64  `tfsdk:"outbound_agent_id"`
-	FilebaseBucket                          types.String `tfsdk:"filebase_bucket"`
-	FilebaseAccessKey                       types.String `tfsdk:"filebase_access_key"`
-	CloudflareBucket                        types.String `tfsdk:"cloudflare_bucket"`
-	CloudflareAccessKey                     types.String `tfsdk:"cloudflare_access_key"`
-	CloudflareEndpoint                      types.String `tfsdk:"cloudflare_endpoint"`
-	DropboxTeams                            types.Bool   `tfsdk:"dropbox_teams"`
-	LinodeBucket                            types.String `tfsdk:"linode_bucket"`
-	LinodeAccessKey                         types.String `tfsdk:"linode_access_key"`
-	LinodeRegion                            types.String `tfsdk:"linode_region"`
-	UserId                                  types.Int64  `tfsdk:"user_id"`
-	Password                                types.String `tfsdk:"password"`
-	PrivateKey                              types.String `tfsdk:"private_key"`
-	PrivateKeyPassphrase                    types.String `tfsdk:"private_key_passphrase"`
-	ResetAuthentication                     types.Bool   `tfsdk:"reset_authentication"`
-	SharepointClientCertificate             types.String `tfsdk:"sharepoint_client_certificate"`
-	SharepointClientSecret                  types.String `tfsdk:"sharepoint_client_secret"`
-	SslCertificate                          types.String `tfsdk:"ssl_certificate"`
-	AwsSecretKey                            types.String `tfsdk:"aws_secret_key"`
-	AzureBlobStorageAccessKey               types.String `tfsdk:"azure_blob_storage_access_key"`
-	AzureBlobStorageSasToken                types.String `tfsdk:"azure_blob_storage_sas_token"`
-	AzureFilesStorageAccessKey              types.String `tfsdk:"azure_files_storage_access_key"`
-	AzureFilesStorageSasToken               types.String `tfsdk:"azure_files_storage_sas_token"`
-	BackblazeB2ApplicationKey               types.String `tfsdk:"backblaze_b2_application_key"`
-	BackblazeB2KeyId                        types.String `tfsdk:"backblaze_b2_key_id"`
-	CloudflareSecretKey                     types.String `tfsdk:"cloudflare_secret_key"`
-	FilebaseSecretKey                       types.String `tfsdk:"filebase_secret_key"`
-	GoogleCloudStorageCredentialsJson       types.String `tfsdk:"google_cloud_storage_credentials_json"`
-	GoogleCloudStorageS3CompatibleSecretKey types.String `tfsdk:"google_cloud_storage_s3_compatible_secret_key"`
-	LinodeSecretKey                         types.String `tfsdk:"linode_secret_key"`
-	S3CompatibleSecretKey                   types.String `tfsdk:"s3_compatible_secret_key"`
-	WasabiSecretKey                         types.String `tfsdk:"wasabi_secret_key"`
-	FilesApiKey                             types.String `tfsdk:"files_api_key"`
-	Id                                      types.Int64  `tfsdk:"id"`
-	Disabled                                types.Bool   `tfsdk:"disabled"`
-	AuthenticationMethod                    types.String `tfsdk:"authentication_method"`
-	RemoteHomePath                          types.String `tfsdk:"remote_home_path"`
-	PinnedRegion                            types.String `tfsdk:"pinned_region"`
-	S3AssumeRoleExternalId                  types.String `tfsdk:"s3_assume_role_external_id"`
-	AuthStatus                              types.String `tfsdk:"auth_status"`
-	AuthAccountName                         types.String `tfsdk:"auth_account_name"`
-	SharepointAppAuthentication             types.Bool   `tfsdk:"sharepoint_app_authentication"`
-	SharepointAppCredentialType             types.String `tfsdk:"sharepoint_app_credential_type"`
-	FilesAgentApiToken                      types.String `tfsdk:"files_agent_api_token"`
-	FilesAgentUpToDate                      types.Bool   `tfsdk:"files_agent_up_to_date"`
-	FilesAgentLatestVersion                 types.String `tfsdk:"files_agent_latest_version"`
-	FilesAgentSupportsPushUpdates           types.Bool   `tfsdk:"files_agent_supports_push_updates"`
-	DirectTransferAvailable                 types.Bool   `tfsdk:"direct_transfer_available"`
-	FilesApiKeyPrefix                       types.String `tfsdk:"files_api_key_prefix"`
-	SupportsVersioning                      types.Bool   `tfsdk:"supports_versioning"`
+	Hostname                                       types.String `tfsdk:"hostname"`
+	UploadStagingPath                              types.String `tfsdk:"upload_staging_path"`
+	AllowRelativePaths                             types.Bool   `tfsdk:"allow_relative_paths"`
+	Name                                           types.String `tfsdk:"name"`
+	Description                                    types.String `tfsdk:"description"`
+	Port                                           types.Int64  `tfsdk:"port"`
+	BufferUploads                                  types.String `tfsdk:"buffer_uploads"`
+	MaxConnections                                 types.Int64  `tfsdk:"max_connections"`
+	PinToSiteRegion                                types.Bool   `tfsdk:"pin_to_site_region"`
+	RemoteServerCredentialId                       types.Int64  `tfsdk:"remote_server_credential_id"`
+	S3Bucket                                       types.String `tfsdk:"s3_bucket"`
+	S3Region                                       types.String `tfsdk:"s3_region"`
+	AwsAccessKey                                   types.String `tfsdk:"aws_access_key"`
+	S3AssumeRoleArn                                types.String `tfsdk:"s3_assume_role_arn"`
+	S3AssumeRoleDurationSeconds                    types.Int64  `tfsdk:"s3_assume_role_duration_seconds"`
+	ServerCertificate                              types.String `tfsdk:"server_certificate"`
+	ServerHostKey                                  types.String `tfsdk:"server_host_key"`
+	ServerType                                     types.String `tfsdk:"server_type"`
+	WorkspaceId                                    types.Int64  `tfsdk:"workspace_id"`
+	Ssl                                            types.String `tfsdk:"ssl"`
+	Username                                       types.String `tfsdk:"username"`
+	GoogleCloudStorageBucket                       types.String `tfsdk:"google_cloud_storage_bucket"`
+	GoogleCloudStorageAuthenticationMethod         types.String `tfsdk:"google_cloud_storage_authentication_method"`
+	GoogleCloudStorageOauthScope                   types.String `tfsdk:"google_cloud_storage_oauth_scope"`
+	GoogleCloudStorageProjectId                    types.String `tfsdk:"google_cloud_storage_project_id"`
+	GoogleCloudStorageS3CompatibleAccessKey        types.String `tfsdk:"google_cloud_storage_s3_compatible_access_key"`
+	BackblazeB2S3Endpoint                          types.String `tfsdk:"backblaze_b2_s3_endpoint"`
+	BackblazeB2Bucket                              types.String `tfsdk:"backblaze_b2_bucket"`
+	WasabiBucket                                   types.String `tfsdk:"wasabi_bucket"`
+	WasabiRegion                                   types.String `tfsdk:"wasabi_region"`
+	WasabiAccessKey                                types.String `tfsdk:"wasabi_access_key"`
+	OneDriveAccountType                            types.String `tfsdk:"one_drive_account_type"`
+	SharepointTenantId                             types.String `tfsdk:"sharepoint_tenant_id"`
+	SharepointClientId                             types.String `tfsdk:"sharepoint_client_id"`
+	SharepointSiteUrl                              types.String `tfsdk:"sharepoint_site_url"`
+	AzureBlobStorageAccount                        types.String `tfsdk:"azure_blob_storage_account"`
+	AzureBlobStorageContainer                      types.String `tfsdk:"azure_blob_storage_container"`
+	AzureBlobStorageHierarchicalNamespace          types.Bool   `tfsdk:"azure_blob_storage_hierarchical_namespace"`
+	AzureBlobStorageDnsSuffix                      types.String `tfsdk:"azure_blob_storage_dns_suffix"`
+	AzureFilesStorageAccount                       types.String `tfsdk:"azure_files_storage_account"`
+	AzureFilesStorageShareName                     types.String `tfsdk:"azure_files_storage_share_name"`
+	AzureFilesStorageDnsSuffix                     types.String `tfsdk:"azure_files_storage_dns_suffix"`
+	S3CompatibleBucket                             types.String `tfsdk:"s3_compatible_bucket"`
+	S3CompatibleEndpoint                           types.String `tfsdk:"s3_compatible_endpoint"`
+	S3CompatibleRegion                             types.String `tfsdk:"s3_compatible_region"`
+	S3CompatibleVirtualHostedStyle                 types.Bool   `tfsdk:"s3_compatible_virtual_hosted_style"`
+	S3CompatibleAccessKey                          types.String `tfsdk:"s3_compatible_access_key"`
+	EnableDedicatedIps                             types.Bool   `tfsdk:"enable_dedicated_ips"`
+	FilesAgentPermissionSet                        types.String `tfsdk:"files_agent_permission_set"`
+	FilesAgentRoot                                 types.String `tfsdk:"files_agent_root"`
+	FilesAgentVersion                              types.String `tfsdk:"files_agent_version"`
+	OutboundAgentId                                types.Int64  `tfsdk:"outbound_agent_id"`
+	FilebaseBucket                                 types.String `tfsdk:"filebase_bucket"`
+	FilebaseAccessKey                              types.String `tfsdk:"filebase_access_key"`
+	CloudflareBucket                               types.String `tfsdk:"cloudflare_bucket"`
+	CloudflareAccessKey                            types.String `tfsdk:"cloudflare_access_key"`
+	CloudflareEndpoint                             types.String `tfsdk:"cloudflare_endpoint"`
+	DropboxTeams                                   types.Bool   `tfsdk:"dropbox_teams"`
+	LinodeBucket                                   types.String `tfsdk:"linode_bucket"`
+	LinodeAccessKey                                types.String `tfsdk:"linode_access_key"`
+	LinodeRegion                                   types.String `tfsdk:"linode_region"`
+	UserId                                         types.Int64  `tfsdk:"user_id"`
+	Password                                       types.String `tfsdk:"password"`
+	PasswordVersion                                types.Int64  `tfsdk:"password_version"`
+	PrivateKey                                     types.String `tfsdk:"private_key"`
+	PrivateKeyVersion                              types.Int64  `tfsdk:"private_key_version"`
+	PrivateKeyPassphrase                           types.String `tfsdk:"private_key_passphrase"`
+	PrivateKeyPassphraseVersion                    types.Int64  `tfsdk:"private_key_passphrase_version"`
+	ResetAuthentication                            types.Bool   `tfsdk:"reset_authentication"`
+	SharepointClientCertificate                    types.String `tfsdk:"sharepoint_client_certificate"`
+	SharepointClientCertificateVersion             types.Int64  `tfsdk:"sharepoint_client_certificate_version"`
+	SharepointClientSecret                         types.String `tfsdk:"sharepoint_client_secret"`
+	SharepointClientSecretVersion                  types.Int64  `tfsdk:"sharepoint_client_secret_version"`
+	SslCertificate                                 types.String `tfsdk:"ssl_certificate"`
+	SslCertificateVersion                          types.Int64  `tfsdk:"ssl_certificate_version"`
+	AwsSecretKey                                   types.String `tfsdk:"aws_secret_key"`
+	AwsSecretKeyVersion                            types.Int64  `tfsdk:"aws_secret_key_version"`
+	AzureBlobStorageAccessKey                      types.String `tfsdk:"azure_blob_storage_access_key"`
+	AzureBlobStorageAccessKeyVersion               types.Int64  `tfsdk:"azure_blob_storage_access_key_version"`
+	AzureBlobStorageSasToken                       types.String `tfsdk:"azure_blob_storage_sas_token"`
+	AzureBlobStorageSasTokenVersion                types.Int64  `tfsdk:"azure_blob_storage_sas_token_version"`
+	AzureFilesStorageAccessKey                     types.String `tfsdk:"azure_files_storage_access_key"`
+	AzureFilesStorageAccessKeyVersion              types.Int64  `tfsdk:"azure_files_storage_access_key_version"`
+	AzureFilesStorageSasToken                      types.String `tfsdk:"azure_files_storage_sas_token"`
+	AzureFilesStorageSasTokenVersion               types.Int64  `tfsdk:"azure_files_storage_sas_token_version"`
+	BackblazeB2ApplicationKey                      types.String `tfsdk:"backblaze_b2_application_key"`
+	BackblazeB2ApplicationKeyVersion               types.Int64  `tfsdk:"backblaze_b2_application_key_version"`
+	BackblazeB2KeyId                               types.String `tfsdk:"backblaze_b2_key_id"`
+	BackblazeB2KeyIdVersion                        types.Int64  `tfsdk:"backblaze_b2_key_id_version"`
+	CloudflareSecretKey                            types.String `tfsdk:"cloudflare_secret_key"`
+	CloudflareSecretKeyVersion                     types.Int64  `tfsdk:"cloudflare_secret_key_version"`
+	FilebaseSecretKey                              types.String `tfsdk:"filebase_secret_key"`
+	FilebaseSecretKeyVersion                       types.Int64  `tfsdk:"filebase_secret_key_version"`
+	GoogleCloudStorageCredentialsJson              types.String `tfsdk:"google_cloud_storage_credentials_json"`
+	GoogleCloudStorageCredentialsJsonVersion       types.Int64  `tfsdk:"google_cloud_storage_credentials_json_version"`
+	GoogleCloudStorageS3CompatibleSecretKey        types.String `tfsdk:"google_cloud_storage_s3_compatible_secret_key"`
+	GoogleCloudStorageS3CompatibleSecretKeyVersion types.Int64  `tfsdk:"google_cloud_storage_s3_compatible_secret_key_version"`
+	LinodeSecretKey                                types.String `tfsdk:"linode_secret_key"`
+	LinodeSecretKeyVersion                         types.Int64  `tfsdk:"linode_secret_key_version"`
+	S3CompatibleSecretKey                          types.String `tfsdk:"s3_compatible_secret_key"`
+	S3CompatibleSecretKeyVersion                   types.Int64  `tfsdk:"s3_compatible_secret_key_version"`
+	WasabiSecretKey                                types.String `tfsdk:"wasabi_secret_key"`
+	WasabiSecretKeyVersion                         types.Int64  `tfsdk:"wasabi_secret_key_version"`
+	FilesApiKey                                    types.String `tfsdk:"files_api_key"`
+	FilesApiKeyVersion                             types.Int64  `tfsdk:"files_api_key_version"`
+	Id                                             types.Int64  `tfsdk:"id"`
+	Disabled                                       types.Bool   `tfsdk:"disabled"`
+	AuthenticationMethod                           types.String `tfsdk:"authentication_method"`
+	RemoteHomePath                                 types.String `tfsdk:"remote_home_path"`
+	PinnedRegion                                   types.String `tfsdk:"pinned_region"`
+	S3AssumeRoleExternalId                         types.String `tfsdk:"s3_assume_role_external_id"`
+	AuthStatus                                     types.String `tfsdk:"auth_status"`
+	AuthAccountName                                types.String `tfsdk:"auth_account_name"`
+	SharepointAppAuthentication                    types.Bool   `tfsdk:"sharepoint_app_authentication"`
+	SharepointAppCredentialType                    types.String `tfsdk:"sharepoint_app_credential_type"`
+	FilesAgentApiToken                             types.String `tfsdk:"files_agent_api_token"`
+	FilesAgentUpToDate                             types.Bool   `tfsdk:"files_agent_up_to_date"`
+	FilesAgentLatestVersion                        types.String `tfsdk:"files_agent_latest_version"`
+	FilesAgentSupportsPushUpdates                  types.Bool   `tfsdk:"files_agent_supports_push_updates"`
+	DirectTransferAvailable                        types.Bool   `tfsdk:"direct_transfer_available"`
+	FilesApiKeyPrefix                              types.String `tfsdk:"files_api_key_prefix"`
+	SupportsVersioning                             types.Bool   `tfsdk:"supports_versioning"`
 }
 
 func (r *remoteServerResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -146,18 +172,19 @@ func (r *remoteServerResource) Configure(_ context.Context, req resource.Configu
 		return
 	}
 
-	sdk_config, ok := req.ProviderData.(files_sdk.Config)
+	provider_data, ok := req.ProviderData.(providerResourceData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected files_sdk.Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected providerResourceData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.client = &remote_server.Client{Config: sdk_config}
+	r.client = &remote_server.Client{Config: provider_data.Config}
+	r.defaultWorkspaceId = provider_data.DefaultWorkspaceId
 }
 
 func (r *remoteServerResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -176,6 +203,9 @@ func (r *remoteServerResource) Schema(_ context.Context, _ resource.SchemaReques
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			// Paths on the remote server, such as upload_staging_path and
+			// remote_home_path, are compared exactly rather than with
+			// lib.NormalizedPathType, because its file system may be case sensitive.
 			"upload_staging_path": schema.StringAttribute{
 				Description: "Upload staging path.  Applies to SFTP only.  If a path is provided here, files will first be uploaded to this path on the remote folder and the moved into the final correct path via an SFTP move command.  This is required by some remote MFT systems to emulate atomic uploads, which are otherwise not supoprted by SFTP.",
 				Computed:    true,
@@ -691,16 +721,37 @@ func (r *remoteServerResource) Schema(_ context.Context, _ resource.SchemaReques
 				Optional:    true,
 				WriteOnly:   true,
 			},
+			"password_version": schema.Int64Attribute{
+				Description: "Change this value to send the write-only `password` to Files.com again, for example after rotating it.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRoot("password")),
+				},
+			},
 			"private_key": schema.StringAttribute{
 				Description: "Private key, if needed.",
 				Optional:    true,
 				WriteOnly:   true,
 			},
+			"private_key_version": schema.Int64Attribute{
+				Description: "Change this value to send the write-only `private_key` to Files.com again, for example after rotating it.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRoot("private_key")),
+				},
+			},
 			"private_key_passphrase": schema.StringAttribute{
 				Description: "Passphrase for private key if needed.",
 				Optional:    true,
 				WriteOnly:   true,
 			},
+			"private_key_passphrase_version": schema.Int64Attribute{
+				Description: "Change this value to send the write-only `private_key_passphrase` to Files.com again, for example after rotating it.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRoot("private_key_passphrase")),
+				},
+			},
 			"reset_authentication": schema.BoolAttribute{
 				Description: "Reset authenticated account?",
 				Optional:    true,
@@ -711,91 +762,217 @@ func (r *remoteServerResource) Schema(_ context.Context, _ resource.SchemaReques
 				Optional:    true,
 				WriteOnly:   true,
 			},
+			"sharepoint_client_certificate_version": schema.Int64Attribute{
+				Description: "Change this value to send the write-only `sharepoint_client_certificate` to Files.com again, for example after rotating it.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRoot("sharepoint_client_certificate")),
+				},
+			},
 			"sharepoint_client_secret": schema.StringAttribute{
 				Description: "SharePoint: Microsoft Entra application client secret for app-only authentication.",
 				Optional:    true,
 				WriteOnly:   true,
 			},
+			"sharepoint_client_secret_version": schema.Int64Attribute{
+				Description: "Change this value to send the write-only `sharepoint_client_secret` to Files.com again, for example after rotating it.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRoot("sharepoint_client_secret")),
+				},
+			},
 			"ssl_certificate": schema.StringAttribute{
 				Description: "SSL client certificate.",
 				Optional:    true,
 				WriteOnly:   true,
 			},
+			"ssl_certificate_version": schema.Int64Attribute{
+				Description: "Change this value to send the write-only `ssl_certificate` to Files.com again, for example after rotating it.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRoot("ssl_certificate")),
+				},
+			},
 			"aws_secret_key": schema.StringAttribute{
 				Description: "AWS: secret key.",
 				Optional:    true,
 				WriteOnly:   true,
 			},
+			"aws_secret_key_version": schema.Int64Attribute{
+				Description: "Change this value to send the write-only `aws_secret_key` to Files.com again, for example after rotating it.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRoot("aws_secret_key")),
+				},
+			},
 			"azure_blob_storage_access_key": schema.StringAttribute{
 				Description: "Azure Blob Storage: Access Key",
 				Optional:    true,
 				WriteOnly:   true,
 			},
+			"azure_blob_storage_access_key_version": schema.Int64Attribute{
+				Description: "Change this value to send the write-only `azure_blob_storage_access_key` to Files.com again, for example after rotating it.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRoot("azure_blob_storage_access_key")),
+				},
+			},
 			"azure_blob_storage_sas_token": schema.StringAttribute{
 				Description: "Azure Blob Storage: Shared Access Signature (SAS) token",
 				Optional:    true,
 				WriteOnly:   true,
 			},
+			"azure_blob_storage_sas_token_version": schema.Int64Attribute{
+				Description: "Change this value to send the write-only `azure_blob_storage_sas_token` to Files.com again, for example after rotating it.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRoot("azure_blob_storage_sas_token")),
+				},
+			},
 			"azure_files_storage_access_key": schema.StringAttribute{
 				Description: "Azure File Storage: Access Key",
 				Optional:    true,
 				WriteOnly:   true,
 			},
+			"azure_files_storage_access_key_version": schema.Int64Attribute{
+				Description: "Change this value to send the write-only `azure_files_storage_access_key` to Files.com again, for example after rotating it.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRoot("azure_files_storage_access_key")),
+				},
+			},
 			"azure_files_storage_sas_token": schema.StringAttribute{
 				Description: "Azure File Storage: Shared Access Signature (SAS) token",
 				Optional:    true,
 				WriteOnly:   true,
 			},
+			"azure_files_storage_sas_token_version": schema.Int64Attribute{
+				Description: "Change this value to send the write-only `azure_files_storage_sas_token` to Files.com again, for example after rotating it.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRoot("azure_files_storage_sas_token")),
+				},
+			},
 			"backblaze_b2_application_key": schema.StringAttribute{
 				Description: "Backblaze B2 Cloud Storage: applicationKey",
 				Optional:    true,
 				WriteOnly:   true,
 			},
+			"backblaze_b2_application_key_version": schema.Int64Attribute{
+				Description: "Change this value to send the write-only `backblaze_b2_application_key` to Files.com again, for example after rotating it.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRoot("backblaze_b2_application_key")),
+				},
+			},
 			"backblaze_b2_key_id": schema.StringAttribute{
 				Description: "Backblaze B2 Cloud Storage: keyID",
 				Optional:    true,
 				WriteOnly:   true,
 			},
+			"backblaze_b2_key_id_version": schema.Int64Attribute{
+				Description: "Change this value to send the write-only `backblaze_b2_key_id` to Files.com again, for example after rotating it.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRoot("backblaze_b2_key_id")),
+				},
+			},
 			"cloudflare_secret_key": schema.StringAttribute{
 				Description: "Cloudflare: Secret Key",
 				Optional:    true,
 				WriteOnly:   true,
 			},
+			"cloudflare_secret_key_version": schema.Int64Attribute{
+				Description: "Change this value to send the write-only `cloudflare_secret_key` to Files.com again, for example after rotating it.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRoot("cloudflare_secret_key")),
+				},
+			},
 			"filebase_secret_key": schema.StringAttribute{
 				Description: "Filebase: Secret Key",
 				Optional:    true,
 				WriteOnly:   true,
 			},
+			"filebase_secret_key_version": schema.Int64Attribute{
+				Description: "Change this value to send the write-only `filebase_secret_key` to Files.com again, for example after rotating it.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRoot("filebase_secret_key")),
+				},
+			},
 			"google_cloud_storage_credentials_json": schema.StringAttribute{
 				Description: "Google Cloud Storage: JSON file that contains the private key. To generate see https://cloud.google.com/storage/docs/json_api/v1/how-tos/authorizing#APIKey",
 				Optional:    true,
 				WriteOnly:   true,
 			},
+			"google_cloud_storage_credentials_json_version": schema.Int64Attribute{
+				Description: "Change this value to send the write-only `google_cloud_storage_credentials_json` to Files.com again, for example after rotating it.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRoot("google_cloud_storage_credentials_json")),
+				},
+			},
 			"google_cloud_storage_s3_compatible_secret_key": schema.StringAttribute{
 				Description: "Google Cloud Storage: S3-compatible secret key",
 				Optional:    true,
 				WriteOnly:   true,
 			},
+			"google_cloud_storage_s3_compatible_secret_key_version": schema.Int64Attribute{
+				Description: "Change this value to send the write-only `google_cloud_storage_s3_compatible_secret_key` to Files.com again, for example after rotating it.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRoot("google_cloud_storage_s3_compatible_secret_key")),
+				},
+			},
 			"linode_secret_key": schema.StringAttribute{
 				Description: "Linode: Secret Key",
 				Optional:    true,
 				WriteOnly:   true,
 			},
+			"linode_secret_key_version": schema.Int64Attribute{
+				Description: "Change this value to send the write-only `linode_secret_key` to Files.com again, for example after rotating it.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRoot("linode_secret_key")),
+				},
+			},
 			"s3_compatible_secret_key": schema.StringAttribute{
 				Description: "S3-compatible: Secret Key",
 				Optional:    true,
 				WriteOnly:   true,
 			},
+			"s3_compatible_secret_key_version": schema.Int64Attribute{
+				Description: "Change this value to send the write-only `s3_compatible_secret_key` to Files.com again, for example after rotating it.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRoot("s3_compatible_secret_key")),
+				},
+			},
 			"wasabi_secret_key": schema.StringAttribute{
 				Description: "Wasabi: Secret Key",
 				Optional:    true,
 				WriteOnly:   true,
 			},
+			"wasabi_secret_key_version": schema.Int64Attribute{
+				Description: "Change this value to send the write-only `wasabi_secret_key` to Files.com again, for example after rotating it.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRoot("wasabi_secret_key")),
+				},
+			},
 			"files_api_key": schema.StringAttribute{
 				Description: "Files.com direct link: API key used once to pair the remote server.",
 				Optional:    true,
 				WriteOnly:   true,
 			},
+			"files_api_key_version": schema.Int64Attribute{
+				Description: "Change this value to send the write-only `files_api_key` to Files.com again, for example after rotating it.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRoot("files_api_key")),
+				},
+			},
 			"id": schema.Int64Attribute{
 				Description: "Remote Server ID",
 				Computed:    true,
@@ -874,6 +1051,21 @@ func (r *remoteServerResource) Schema(_ context.Context, _ resource.SchemaReques
 	}
 }
 
+func (r *remoteServerResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.Int64Attribute{
+				Description:       "Remote Server ID",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+func (r *remoteServerResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyPlanDefaultWorkspaceId(ctx, r.defaultWorkspaceId, req, resp)
+}
+
 func (r *remoteServerResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan remoteServerResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -1009,6 +1201,12 @@ func (r *remoteServerResource) Create(ctx context.Context, req resource.CreateRe
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.Identity.SetAttribute(ctx, path.Root("id"), plan.Id)
+	resp.Diagnostics.Append(diags...)
 }
 
 func (r *remoteServerResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -1044,6 +1242,12 @@ func (r *remoteServerResource) Read(ctx context.Context, req resource.ReadReques
 
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.Identity.SetAttribute(ctx, path.Root("id"), state.Id)
+	resp.Diagnostics.Append(diags...)
 }
 
 func (r *remoteServerResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -1332,6 +1536,12 @@ func (r *remoteServerResource) Update(ctx context.Context, req resource.UpdateRe
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.Identity.SetAttribute(ctx, path.Root("id"), plan.Id)
+	resp.Diagnostics.Append(diags...)
 }
 
 func (r *remoteServerResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -1355,6 +1565,42 @@ func (r *remoteServerResource) Delete(ctx context.Context, req resource.DeleteRe
 }
 
 func (r *remoteServerResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID == "" {
+		var id types.Int64
+		resp.Diagnostics.Append(req.Identity.GetAttribute(ctx, path.Root("id"), &id)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+		return
+	}
+
+	// An identifier that isn't a number is taken to be the remote server's name.
+	if _, err := strconv.ParseInt(req.ID, 10, 64); err != nil {
+		paramsRemoteServerList := files_sdk.RemoteServerListParams{}
+		paramsRemoteServerList.Filter = map[string]interface{}{"name": req.ID}
+
+		remoteServerIt, err := r.client.List(paramsRemoteServerList, files_sdk.WithContext(ctx))
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Importing Files Remote Server",
+				"Could not list remote servers: "+err.Error(),
+			)
+			return
+		}
+
+		id, err := importByKey(remoteServerIt, "remote server", "name", req.ID,
+			func(r files_sdk.RemoteServer) string { return r.Name },
+			func(r files_sdk.RemoteServer) int64 { return r.Id },
+		)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Importing Files Remote Server",
+				fmt.Sprintf("Could not import remote server %q: %s", req.ID, err),
+			)
+			return
+		}
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+		return
+	}
+
 	idParts := strings.SplitN(req.ID, ",", 1)
 
 	if len(idParts) != 1 || idParts[0] == "" {
@@ -0,0 +1,136 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	files_sdk "github.com/Files-com/files-sdk-go/v3"
+	remote_server "github.com/Files-com/files-sdk-go/v3/remoteserver"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &remoteServersDataSource{}
+	_ datasource.DataSourceWithConfigure = &remoteServersDataSource{}
+)
+
+func NewRemoteServersDataSource() datasource.DataSource {
+	return &remoteServersDataSource{}
+}
+
+type remoteServersDataSource struct {
+	client *remote_server.Client
+}
+
+type remoteServersDataSourceModel struct {
+	SortBy        types.Map  `tfsdk:"sort_by"`
+	Filter        types.Map  `tfsdk:"filter"`
+	FilterPrefix  types.Map  `tfsdk:"filter_prefix"`
+	RemoteServers types.List `tfsdk:"remote_servers"`
+}
+
+func (r *remoteServersDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	sdk_config, ok := req.ProviderData.(files_sdk.Config)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected files_sdk.Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = &remote_server.Client{Config: sdk_config}
+}
+
+func (r *remoteServersDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_remote_servers"
+}
+
+func (r *remoteServersDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists every Remote Server matching the given arguments. Filtering and sorting are performed by the Files.com API and all pages of results are returned.",
+		Attributes: map[string]schema.Attribute{
+			"sort_by": schema.MapAttribute{
+				Description: "If set, sort records by the specified field in either `asc` or `desc` direction.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"filter": schema.MapAttribute{
+				Description: "If set, return records where the specified field is equal to the supplied value.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"filter_prefix": schema.MapAttribute{
+				Description: "If set, return records where the specified field is prefixed by the supplied value.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"remote_servers": schema.ListNestedAttribute{
+				Description: "Remote Servers matching the given arguments.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: listItemAttributes(dataSourceSchema(ctx, NewRemoteServerDataSource()).Attributes),
+				},
+			},
+		},
+	}
+}
+
+func (r *remoteServersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data remoteServersDataSourceModel
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var propDiags diag.Diagnostics
+	paramsRemoteServerList := files_sdk.RemoteServerListParams{}
+	paramsRemoteServerList.SortBy, propDiags = listFilterValue(ctx, path.Root("sort_by"), data.SortBy)
+	resp.Diagnostics.Append(propDiags...)
+	paramsRemoteServerList.Filter, propDiags = listFilterValue(ctx, path.Root("filter"), data.Filter)
+	resp.Diagnostics.Append(propDiags...)
+	paramsRemoteServerList.FilterPrefix, propDiags = listFilterValue(ctx, path.Root("filter_prefix"), data.FilterPrefix)
+	resp.Diagnostics.Append(propDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	remoteServerIt, err := r.client.List(paramsRemoteServerList, files_sdk.WithContext(ctx))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Listing Files RemoteServers",
+			"Could not list remote_servers: "+err.Error(),
+		)
+		return
+	}
+
+	remoteservers, err := listAll[files_sdk.RemoteServer](remoteServerIt)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Listing Files RemoteServers",
+			"Could not list remote_servers: "+err.Error(),
+		)
+		return
+	}
+
+	data.RemoteServers, diags = listItems(ctx, dataSourceSchema(ctx, NewRemoteServerDataSource()).Attributes, remoteservers, (&remoteServerDataSource{}).populateDataSourceModel)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, data)
+	resp.Diagnostics.Append(diags...)
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 
@@ -25,6 +26,7 @@ var (
 	_ resource.Resource                = &requestResource{}
 	_ resource.ResourceWithConfigure   = &requestResource{}
 	_ resource.ResourceWithImportState = &requestResource{}
+	_ resource.ResourceWithIdentity    = &requestResource{}
 )
 
 func NewRequestResource() resource.Resource {
@@ -36,7 +38,7 @@ type requestResource struct {
 }
 
 type requestResourceModel struct {
-	Path            types.String            `tfsdk:"path"`
+	Path            lib.NormalizedPath      `tfsdk:"path"`
 	Destination     types.String            `tfsdk:"destination"`
 	UserIds         lib.SortedElementString `tfsdk:"user_ids"`
 	GroupIds        lib.SortedElementString `tfsdk:"group_ids"`
@@ -51,18 +53,18 @@ func (r *requestResource) Configure(_ context.Context, req resource.ConfigureReq
 		return
 	}
 
-	sdk_config, ok := req.ProviderData.(files_sdk.Config)
+	provider_data, ok := req.ProviderData.(providerResourceData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected files_sdk.Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected providerResourceData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.client = &request.Client{Config: sdk_config}
+	r.client = &request.Client{Config: provider_data.Config}
 }
 
 func (r *requestResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -79,6 +81,7 @@ func (r *requestResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
+				CustomType: lib.NormalizedPathType{},
 			},
 			"destination": schema.StringAttribute{
 				Description: "Destination filename",
@@ -126,6 +129,17 @@ func (r *requestResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 	}
 }
 
+func (r *requestResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.Int64Attribute{
+				Description:       "Request ID",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
 func (r *requestResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan requestResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -167,6 +181,12 @@ func (r *requestResource) Create(ctx context.Context, req resource.CreateRequest
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.Identity.SetAttribute(ctx, path.Root("id"), plan.Id)
+	resp.Diagnostics.Append(diags...)
 }
 
 func (r *requestResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -227,6 +247,12 @@ func (r *requestResource) Read(ctx context.Context, req resource.ReadRequest, re
 
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.Identity.SetAttribute(ctx, path.Root("id"), state.Id)
+	resp.Diagnostics.Append(diags...)
 }
 
 func (r *requestResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -257,6 +283,13 @@ func (r *requestResource) Delete(ctx context.Context, req resource.DeleteRequest
 }
 
 func (r *requestResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID == "" {
+		var id types.Int64
+		resp.Diagnostics.Append(req.Identity.GetAttribute(ctx, path.Root("id"), &id)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+		return
+	}
+
 	idParts := strings.SplitN(req.ID, ",", 1)
 
 	if len(idParts) != 1 || idParts[0] == "" {
@@ -281,7 +314,7 @@ func (r *requestResource) ImportState(ctx context.Context, req resource.ImportSt
 
 func (r *requestResource) populateResourceModel(ctx context.Context, request files_sdk.Request, state *requestResourceModel) (diags diag.Diagnostics) {
 	state.Id = types.Int64Value(request.Id)
-	state.Path = types.StringValue(request.Path)
+	state.Path = lib.NormalizedPathValue(request.Path)
 	state.Source = types.StringValue(request.Source)
 	state.Destination = types.StringValue(request.Destination)
 	state.AutomationId = types.Int64Value(request.AutomationId)
@@ -0,0 +1,93 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// defaultRunTimeout is how long the run actions wait for a run to finish when
+// timeout is not set.
+const defaultRunTimeout = time.Hour
+
+// runLogPageSize is how many log entries of a finished run are fetched to
+// explain why it failed.
+const runLogPageSize = 100
+
+// runLogLimit is how many log lines of a run are quoted in its diagnostic.
+const runLogLimit = 10
+
+// runPollInterval is how often the run actions check on a run they are
+// waiting for.
+var runPollInterval = 5 * time.Second
+
+// runTimeout returns the configured timeout in seconds as a duration.
+func runTimeout(timeout types.Int64) time.Duration {
+	if timeout.IsNull() {
+		return defaultRunTimeout
+	}
+	return time.Duration(timeout.ValueInt64()) * time.Second
+}
+
+// waitForRun calls poll every runPollInterval until it reports that the run is
+// done, returns an error or timeout passes. Giving up only stops Terraform
+// from waiting; the run itself carries on in Files.com.
+func waitForRun(ctx context.Context, timeout time.Duration, poll func(context.Context) (bool, error)) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	ticker := time.NewTicker(runPollInterval)
+	defer ticker.Stop()
+
+	for {
+		done, err := poll(ctx)
+		if err != nil || done {
+			if errors.Is(err, context.DeadlineExceeded) {
+				return fmt.Errorf("timed out after %s waiting for the run to finish", timeout)
+			}
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+				return fmt.Errorf("timed out after %s waiting for the run to finish", timeout)
+			}
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// sendProgress reports message to Terraform while an action is running.
+func sendProgress(resp *action.InvokeResponse, message string) {
+	if resp.SendProgress != nil {
+		resp.SendProgress(action.InvokeProgressEvent{Message: message})
+	}
+}
+
+// runLogLine formats a log entry of a run, prefixed by the path it concerns.
+func runLogLine(path string, message string) string {
+	if path == "" {
+		return message
+	}
+	return path + ": " + message
+}
+
+// runLogDetail quotes the first runLogLimit log lines of a run for its
+// diagnostic, and counts the ones left out.
+func runLogDetail(lines []string) string {
+	if len(lines) == 0 {
+		return ""
+	}
+	detail := "\n\nLog messages:\n" + strings.Join(lines[:min(len(lines), runLogLimit)], "\n")
+	if len(lines) > runLogLimit {
+		detail += fmt.Sprintf("\n... and %d more", len(lines)-runLogLimit)
+	}
+	return detail
+}
@@ -0,0 +1,48 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWaitForRun(t *testing.T) {
+	runPollInterval = time.Millisecond
+	defer func() { runPollInterval = 5 * time.Second }()
+
+	polls := 0
+	err := waitForRun(context.Background(), time.Minute, func(context.Context) (bool, error) {
+		polls++
+		return polls == 3, nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 3, polls)
+
+	err = waitForRun(context.Background(), time.Minute, func(context.Context) (bool, error) {
+		return false, errors.New("not found")
+	})
+	assert.EqualError(t, err, "not found")
+
+	err = waitForRun(context.Background(), 10*time.Millisecond, func(context.Context) (bool, error) {
+		return false, nil
+	})
+	assert.EqualError(t, err, "timed out after 10ms waiting for the run to finish")
+}
+
+func TestRunLogDetail(t *testing.T) {
+	assert.Equal(t, "", runLogDetail(nil))
+	assert.Equal(t, "\n\nLog messages:\nin/a.txt: Permission denied\nConnection reset", runLogDetail([]string{
+		runLogLine("in/a.txt", "Permission denied"),
+		runLogLine("", "Connection reset"),
+	}))
+
+	lines := []string{}
+	for i := range runLogLimit + 3 {
+		lines = append(lines, fmt.Sprint(i))
+	}
+	assert.Equal(t, "\n\nLog messages:\n0\n1\n2\n3\n4\n5\n6\n7\n8\n9\n... and 3 more", runLogDetail(lines))
+}
@@ -7,13 +7,16 @@ import (
 	"strings"
 
 	files_sdk "github.com/Files-com/files-sdk-go/v3"
+	sdk_lib "github.com/Files-com/files-sdk-go/v3/lib"
 	secret "github.com/Files-com/files-sdk-go/v3/secret"
 	"github.com/Files-com/terraform-provider-files/lib"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/dynamicplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
@@ -25,9 +28,12 @@ import (
 )
 
 var (
-	_ resource.Resource                = &secretResource{}
-	_ resource.ResourceWithConfigure   = &secretResource{}
-	_ resource.ResourceWithImportState = &secretResource{}
+	_ resource.Resource                   = &secretResource{}
+	_ resource.ResourceWithConfigure      = &secretResource{}
+	_ resource.ResourceWithImportState    = &secretResource{}
+	_ resource.ResourceWithIdentity       = &secretResource{}
+	_ resource.ResourceWithValidateConfig = &secretResource{}
+	_ resource.ResourceWithModifyPlan     = &secretResource{}
 )
 
 func NewSecretResource() resource.Resource {
@@ -35,7 +41,25 @@ func NewSecretResource() resource.Resource {
 }
 
 type secretResource struct {
-	client *secret.Client
+	client             *secret.Client
+	defaultWorkspaceId types.Int64
+}
+
+// secretValueFields lists the value field names accepted by each secret type.
+// Types that are not listed accept any field names.
+var secretValueFields = map[string]struct {
+	required []string
+	optional []string
+}{
+	"basic":       {required: []string{"username", "password"}},
+	"token":       {required: []string{"token"}},
+	"certificate": {required: []string{"certificate", "private_key"}, optional: []string{"private_key_password"}},
+}
+
+// secretCreateParams adds the write-only secret values to the create request.
+type secretCreateParams struct {
+	files_sdk.SecretCreateParams
+	Values map[string]string `url:"values,omitempty" json:"values,omitempty" path:"values"`
 }
 
 type secretResourceModel struct {
@@ -44,6 +68,8 @@ type secretResourceModel struct {
 	WorkspaceId     types.Int64   `tfsdk:"workspace_id"`
 	Description     types.String  `tfsdk:"description"`
 	Metadata        types.Dynamic `tfsdk:"metadata"`
+	Values          types.Map     `tfsdk:"values"`
+	ValuesVersion   types.Int64   `tfsdk:"values_version"`
 	Id              types.Int64   `tfsdk:"id"`
 	ValueFieldNames types.List    `tfsdk:"value_field_names"`
 	CreatedAt       types.String  `tfsdk:"created_at"`
@@ -55,18 +81,19 @@ func (r *secretResource) Configure(_ context.Context, req resource.ConfigureRequ
 		return
 	}
 
-	sdk_config, ok := req.ProviderData.(files_sdk.Config)
+	provider_data, ok := req.ProviderData.(providerResourceData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected files_sdk.Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected providerResourceData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.client = &secret.Client{Config: sdk_config}
+	r.client = &secret.Client{Config: provider_data.Config}
+	r.defaultWorkspaceId = provider_data.DefaultWorkspaceId
 }
 
 func (r *secretResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -113,6 +140,19 @@ func (r *secretResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 					dynamicplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"values": schema.MapAttribute{
+				Description: "Secret values, keyed by field name. `basic` secrets take `username` and `password`, `token` secrets take `token`, and `certificate` secrets take `certificate`, `private_key` and optionally `private_key_password`. `headers` and `key_value` secrets accept any field names. Values are only sent to Files.com and are never stored in state.",
+				Optional:    true,
+				WriteOnly:   true,
+				ElementType: types.StringType,
+			},
+			"values_version": schema.Int64Attribute{
+				Description: "Change this value to send the write-only `values` to Files.com again, for example after rotating them.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRoot("values")),
+				},
+			},
 			"id": schema.Int64Attribute{
 				Description: "Secret ID.",
 				Computed:    true,
@@ -137,6 +177,62 @@ func (r *secretResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 	}
 }
 
+func (r *secretResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.Int64Attribute{
+				Description:       "Secret ID.",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+func (r *secretResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config secretResourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.SecretType.IsUnknown() || config.Values.IsNull() || config.Values.IsUnknown() {
+		return
+	}
+
+	fields, ok := secretValueFields[config.SecretType.ValueString()]
+	if !ok {
+		return
+	}
+
+	allowed := map[string]bool{}
+	for _, name := range append(fields.required, fields.optional...) {
+		allowed[name] = true
+	}
+	for name := range config.Values.Elements() {
+		if !allowed[name] {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("values").AtMapKey(name),
+				"Invalid Secret Value Field",
+				fmt.Sprintf("Secrets of type %q do not accept a %q value. Expected: %s.", config.SecretType.ValueString(), name, strings.Join(append(fields.required, fields.optional...), ", ")),
+			)
+		}
+	}
+	for _, name := range fields.required {
+		if _, ok := config.Values.Elements()[name]; !ok {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("values"),
+				"Missing Secret Value Field",
+				fmt.Sprintf("Secrets of type %q require a %q value.", config.SecretType.ValueString(), name),
+			)
+		}
+	}
+}
+
+func (r *secretResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyPlanDefaultWorkspaceId(ctx, r.defaultWorkspaceId, req, resp)
+}
+
 func (r *secretResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan secretResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -151,7 +247,7 @@ func (r *secretResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
-	paramsSecretCreate := files_sdk.SecretCreateParams{}
+	paramsSecretCreate := secretCreateParams{}
 	paramsSecretCreate.Name = plan.Name.ValueString()
 	paramsSecretCreate.Description = plan.Description.ValueString()
 	paramsSecretCreate.SecretType = paramsSecretCreate.SecretType.Enum()[plan.SecretType.ValueString()]
@@ -159,12 +255,17 @@ func (r *secretResource) Create(ctx context.Context, req resource.CreateRequest,
 	resp.Diagnostics.Append(diags...)
 	paramsSecretCreate.Metadata = createMetadata
 	paramsSecretCreate.WorkspaceId = plan.WorkspaceId.ValueInt64()
+	if !config.Values.IsNull() && !config.Values.IsUnknown() {
+		diags = config.Values.ElementsAs(ctx, &paramsSecretCreate.Values, false)
+		resp.Diagnostics.Append(diags...)
+	}
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	secret, err := r.client.Create(paramsSecretCreate, files_sdk.WithContext(ctx))
+	secret := files_sdk.Secret{}
+	err := files_sdk.Resource(r.client.Config, sdk_lib.Resource{Method: "POST", Path: "/secrets", Params: paramsSecretCreate, Entity: &secret}, files_sdk.WithContext(ctx))
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Creating Files Secret",
@@ -181,6 +282,12 @@ func (r *secretResource) Create(ctx context.Context, req resource.CreateRequest,
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.Identity.SetAttribute(ctx, path.Root("id"), plan.Id)
+	resp.Diagnostics.Append(diags...)
 }
 
 func (r *secretResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -216,6 +323,12 @@ func (r *secretResource) Read(ctx context.Context, req resource.ReadRequest, res
 
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.Identity.SetAttribute(ctx, path.Root("id"), state.Id)
+	resp.Diagnostics.Append(diags...)
 }
 
 func (r *secretResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -248,6 +361,12 @@ func (r *secretResource) Update(ctx context.Context, req resource.UpdateRequest,
 	updateMetadata, diags := lib.DynamicToInterface(ctx, path.Root("metadata"), config.Metadata)
 	resp.Diagnostics.Append(diags...)
 	paramsSecretUpdate["metadata"] = updateMetadata
+	if !config.Values.IsNull() && !config.Values.IsUnknown() {
+		updateValues := map[string]string{}
+		diags = config.Values.ElementsAs(ctx, &updateValues, false)
+		resp.Diagnostics.Append(diags...)
+		paramsSecretUpdate["values"] = updateValues
+	}
 
 	if resp.Diagnostics.HasError() {
 		return
@@ -270,6 +389,12 @@ func (r *secretResource) Update(ctx context.Context, req resource.UpdateRequest,
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.Identity.SetAttribute(ctx, path.Root("id"), plan.Id)
+	resp.Diagnostics.Append(diags...)
 }
 
 func (r *secretResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -293,6 +418,13 @@ func (r *secretResource) Delete(ctx context.Context, req resource.DeleteRequest,
 }
 
 func (r *secretResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID == "" {
+		var id types.Int64
+		resp.Diagnostics.Append(req.Identity.GetAttribute(ctx, path.Root("id"), &id)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+		return
+	}
+
 	idParts := strings.SplitN(req.ID, ",", 1)
 
 	if len(idParts) != 1 || idParts[0] == "" {
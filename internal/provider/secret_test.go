@@ -0,0 +1,160 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	files_sdk "github.com/Files-com/files-sdk-go/v3"
+	secret "github.com/Files-com/files-sdk-go/v3/secret"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	frameworkresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSecretValidateConfig(t *testing.T) {
+	ctx := context.Background()
+	r := &secretResource{}
+	secretSchema, objectType := resourceSchema(r)
+
+	values := func(values map[string]string) tftypes.Value {
+		elements := map[string]tftypes.Value{}
+		for name, value := range values {
+			elements[name] = tftypes.NewValue(tftypes.String, value)
+		}
+		return tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, elements)
+	}
+
+	tests := []struct {
+		name       string
+		secretType string
+		values     tftypes.Value
+		summary    string
+		path       path.Path
+	}{
+		{
+			name:       "basic",
+			secretType: "basic",
+			values:     values(map[string]string{"username": "partner", "password": "secret"}),
+		},
+		{
+			name:       "unexpected field",
+			secretType: "token",
+			values:     values(map[string]string{"token": "secret", "password": "secret"}),
+			summary:    "Invalid Secret Value Field",
+			path:       path.Root("values").AtMapKey("password"),
+		},
+		{
+			name:       "missing field",
+			secretType: "certificate",
+			values:     values(map[string]string{"certificate": "-----BEGIN CERTIFICATE-----"}),
+			summary:    "Missing Secret Value Field",
+			path:       path.Root("values"),
+		},
+		{
+			name:       "any field names",
+			secretType: "headers",
+			values:     values(map[string]string{"X-Api-Key": "secret"}),
+		},
+		{
+			name:       "unknown values",
+			secretType: "basic",
+			values:     tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, tftypes.UnknownValue),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			resp := &frameworkresource.ValidateConfigResponse{}
+			r.ValidateConfig(ctx, frameworkresource.ValidateConfigRequest{
+				Config: tfsdk.Config{Schema: secretSchema, Raw: objectValue(objectType, map[string]tftypes.Value{
+					"name":        tftypes.NewValue(tftypes.String, "partner"),
+					"secret_type": tftypes.NewValue(tftypes.String, test.secretType),
+					"values":      test.values,
+				})},
+			}, resp)
+
+			if test.summary == "" {
+				assert.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+				return
+			}
+			assertAttributeError(t, resp.Diagnostics, test.summary, test.path)
+		})
+	}
+}
+
+func TestSecretValues(t *testing.T) {
+	ctx := context.Background()
+	requests := []map[string]interface{}{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		body := map[string]interface{}{}
+		json.NewDecoder(req.Body).Decode(&body)
+		body["request"] = req.Method + " " + req.URL.Path
+		requests = append(requests, body)
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(files_sdk.Secret{Id: 7, Name: "partner", SecretType: "basic", ValueFieldNames: []string{"username", "password"}})
+	}))
+	defer server.Close()
+
+	r := &secretResource{client: &secret.Client{Config: files_sdk.Config{APIKey: "api-key", EndpointOverride: server.URL}.Init()}}
+	secretSchema, objectType := resourceSchema(r)
+	identityResp := &frameworkresource.IdentitySchemaResponse{}
+	r.IdentitySchema(ctx, frameworkresource.IdentitySchemaRequest{}, identityResp)
+	identityType := identityResp.IdentitySchema.Type().TerraformType(ctx)
+
+	config := objectValue(objectType, map[string]tftypes.Value{
+		"name":        tftypes.NewValue(tftypes.String, "partner"),
+		"secret_type": tftypes.NewValue(tftypes.String, "basic"),
+		"values": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{
+			"username": tftypes.NewValue(tftypes.String, "partner"),
+			"password": tftypes.NewValue(tftypes.String, "secret"),
+		}),
+		"values_version": tftypes.NewValue(tftypes.Number, 2),
+	})
+	// Write-only values are never part of the plan or state.
+	plan := objectValue(objectType, map[string]tftypes.Value{
+		"name":           tftypes.NewValue(tftypes.String, "partner"),
+		"secret_type":    tftypes.NewValue(tftypes.String, "basic"),
+		"values_version": tftypes.NewValue(tftypes.Number, 2),
+		"id":             tftypes.NewValue(tftypes.Number, 7),
+	})
+
+	createResp := &frameworkresource.CreateResponse{
+		State:    tfsdk.State{Schema: secretSchema, Raw: tftypes.NewValue(objectType, nil)},
+		Identity: &tfsdk.ResourceIdentity{Schema: identityResp.IdentitySchema, Raw: tftypes.NewValue(identityType, nil)},
+	}
+	r.Create(ctx, frameworkresource.CreateRequest{
+		Config: tfsdk.Config{Schema: secretSchema, Raw: config},
+		Plan:   tfsdk.Plan{Schema: secretSchema, Raw: plan},
+	}, createResp)
+	require.False(t, createResp.Diagnostics.HasError(), createResp.Diagnostics)
+
+	updateResp := &frameworkresource.UpdateResponse{
+		State:    tfsdk.State{Schema: secretSchema, Raw: tftypes.NewValue(objectType, nil)},
+		Identity: &tfsdk.ResourceIdentity{Schema: identityResp.IdentitySchema, Raw: tftypes.NewValue(identityType, nil)},
+	}
+	r.Update(ctx, frameworkresource.UpdateRequest{
+		Config: tfsdk.Config{Schema: secretSchema, Raw: config},
+		Plan:   tfsdk.Plan{Schema: secretSchema, Raw: plan},
+		State:  tfsdk.State{Schema: secretSchema, Raw: plan},
+	}, updateResp)
+	require.False(t, updateResp.Diagnostics.HasError(), updateResp.Diagnostics)
+
+	values := map[string]interface{}{"username": "partner", "password": "secret"}
+	if assert.Len(t, requests, 2) {
+		assert.Equal(t, "POST /api/rest/v1/secrets", requests[0]["request"])
+		assert.Equal(t, values, requests[0]["values"])
+		assert.Equal(t, "PATCH /api/rest/v1/secrets/7", requests[1]["request"])
+		assert.Equal(t, values, requests[1]["values"])
+	}
+
+	var state secretResourceModel
+	updateResp.State.Get(ctx, &state)
+	assert.True(t, state.Values.IsNull())
+	assert.Equal(t, int64(7), state.Id.ValueInt64())
+}
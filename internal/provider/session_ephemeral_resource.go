@@ -0,0 +1,194 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	files_sdk "github.com/Files-com/files-sdk-go/v3"
+	session "github.com/Files-com/files-sdk-go/v3/session"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ ephemeral.EphemeralResource              = &sessionEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure = &sessionEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithClose     = &sessionEphemeralResource{}
+)
+
+func NewSessionEphemeralResource() ephemeral.EphemeralResource {
+	return &sessionEphemeralResource{}
+}
+
+type sessionEphemeralResource struct {
+	client *session.Client
+}
+
+type sessionEphemeralResourceModel struct {
+	Username            types.String `tfsdk:"username"`
+	Password            types.String `tfsdk:"password"`
+	Otp                 types.String `tfsdk:"otp"`
+	Id                  types.String `tfsdk:"id"`
+	Language            types.String `tfsdk:"language"`
+	ReadOnly            types.Bool   `tfsdk:"read_only"`
+	SftpInsecureCiphers types.Bool   `tfsdk:"sftp_insecure_ciphers"`
+}
+
+// sessionEphemeralResourcePrivate is kept in private state between Open and
+// Close so the session can be logged out.
+type sessionEphemeralResourcePrivate struct {
+	Id string `json:"id"`
+}
+
+func (r *sessionEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	sdk_config, ok := req.ProviderData.(files_sdk.Config)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected files_sdk.Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	// Sessions are created from a username and password, and are closed by
+	// authenticating as the session itself, so the provider's API key must
+	// not be sent with either request.
+	sdk_config.APIKey = ""
+	r.client = &session.Client{Config: sdk_config}
+}
+
+// withoutAPIKey removes the API key header from a request. Clearing
+// Config.APIKey alone isn't enough, since the SDK falls back to the
+// FILES_API_KEY environment variable.
+func withoutAPIKey() files_sdk.RequestResponseOption {
+	return files_sdk.RequestOption(func(req *http.Request) error {
+		req.Header.Del("X-FilesAPI-Key")
+		return nil
+	})
+}
+
+func (r *sessionEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_session"
+}
+
+func (r *sessionEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Logs in as a Files.com user for the duration of a single Terraform run. The session is created when Terraform opens the ephemeral resource and logged out when it is closed, so the session ID is never written to state or plan.\n\n\n\nSend the session `id` in the `X-FilesAPI-Auth` header to authenticate API requests as that user.",
+		Attributes: map[string]schema.Attribute{
+			"username": schema.StringAttribute{
+				Description: "Username to sign in as",
+				Required:    true,
+			},
+			"password": schema.StringAttribute{
+				Description: "Password for sign in",
+				Required:    true,
+				Sensitive:   true,
+			},
+			"otp": schema.StringAttribute{
+				Description: "If this user has a 2FA device, provide its OTP or code here.",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"id": schema.StringAttribute{
+				Description: "Session ID",
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"language": schema.StringAttribute{
+				Description: "Session language",
+				Computed:    true,
+			},
+			"read_only": schema.BoolAttribute{
+				Description: "Is this session read only?",
+				Computed:    true,
+			},
+			"sftp_insecure_ciphers": schema.BoolAttribute{
+				Description: "Are insecure SFTP ciphers allowed for this user? (If this is set to true, the site administrator has signaled that it is ok to use less secure SSH ciphers for this user.)",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (r *sessionEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data sessionEphemeralResourceModel
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	paramsSessionCreate := files_sdk.SessionCreateParams{}
+	paramsSessionCreate.Username = data.Username.ValueString()
+	paramsSessionCreate.Password = data.Password.ValueString()
+	paramsSessionCreate.Otp = data.Otp.ValueString()
+
+	session, err := r.client.Create(paramsSessionCreate, files_sdk.WithContext(ctx), withoutAPIKey())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Opening Files Session",
+			"Could not create session for username "+data.Username.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	private, err := json.Marshal(sessionEphemeralResourcePrivate{Id: session.Id})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Opening Files Session",
+			"Could not encode private state: "+err.Error(),
+		)
+		return
+	}
+	diags = resp.Private.SetKey(ctx, "session", private)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Id = types.StringValue(session.Id)
+	data.Language = types.StringValue(session.Language)
+	data.ReadOnly = types.BoolPointerValue(session.ReadOnly)
+	data.SftpInsecureCiphers = types.BoolPointerValue(session.SftpInsecureCiphers)
+
+	diags = resp.Result.Set(ctx, data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *sessionEphemeralResource) Close(ctx context.Context, req ephemeral.CloseRequest, resp *ephemeral.CloseResponse) {
+	privateBytes, diags := req.Private.GetKey(ctx, "session")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || privateBytes == nil {
+		return
+	}
+
+	var private sessionEphemeralResourcePrivate
+	if err := json.Unmarshal(privateBytes, &private); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Closing Files Session",
+			"Could not decode private state: "+err.Error(),
+		)
+		return
+	}
+
+	client := session.Client{Config: r.client.Config}
+	client.Config.SessionId = private.Id
+
+	err := client.Delete(files_sdk.WithContext(ctx), withoutAPIKey())
+	if err != nil && !files_sdk.IsNotExist(err) {
+		resp.Diagnostics.AddError(
+			"Error Closing Files Session",
+			"Could not delete session: "+err.Error(),
+		)
+	}
+}
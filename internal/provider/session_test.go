@@ -0,0 +1,91 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newSessionServer serves session create and delete, recording the method,
+// API key and session ID of each request.
+func newSessionServer(t *testing.T) (*httptest.Server, func() [][3]string) {
+	var lock sync.Mutex
+	requests := [][3]string{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		lock.Lock()
+		requests = append(requests, [3]string{r.Method, r.Header.Get("X-FilesAPI-Key"), r.Header.Get("X-FilesAPI-Auth")})
+		lock.Unlock()
+		if r.Method == http.MethodDelete {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{"id": "session-id", "language": "en"})
+	}))
+	t.Cleanup(server.Close)
+	return server, func() [][3]string {
+		lock.Lock()
+		defer lock.Unlock()
+		return append([][3]string{}, requests...)
+	}
+}
+
+func TestSessionEphemeralResourceWithoutAPIKey(t *testing.T) {
+	server, requests := newSessionServer(t)
+	t.Setenv("FILES_API_KEY", "environment-api-key")
+	t.Setenv("HOME", t.TempDir())
+
+	ctx := context.Background()
+	providerServer, schemaResp, diags := configureProvider(t, map[string]tftypes.Value{
+		"endpoint_override": tftypes.NewValue(tftypes.String, server.URL),
+	})
+	require.Empty(t, diags)
+
+	openResp, err := providerServer.OpenEphemeralResource(ctx, &tfprotov6.OpenEphemeralResourceRequest{
+		TypeName: "files_session",
+		Config: dynamicValue(t, schemaResp.EphemeralResourceSchemas["files_session"], map[string]tftypes.Value{
+			"username": tftypes.NewValue(tftypes.String, "partner"),
+			"password": tftypes.NewValue(tftypes.String, "secret"),
+		}),
+	})
+	require.NoError(t, err)
+	require.Empty(t, openResp.Diagnostics)
+
+	closeResp, err := providerServer.CloseEphemeralResource(ctx, &tfprotov6.CloseEphemeralResourceRequest{
+		TypeName: "files_session",
+		Private:  openResp.Private,
+	})
+	require.NoError(t, err)
+	require.Empty(t, closeResp.Diagnostics)
+
+	assert.Equal(t, [][3]string{{http.MethodPost, "", ""}, {http.MethodDelete, "", "session-id"}}, requests())
+}
+
+func TestLoginSessions(t *testing.T) {
+	server, requests := newSessionServer(t)
+	t.Setenv("FILES_API_KEY", "environment-api-key")
+	t.Setenv("HOME", t.TempDir())
+
+	config := map[string]tftypes.Value{
+		"endpoint_override": tftypes.NewValue(tftypes.String, server.URL),
+		"username":          tftypes.NewValue(tftypes.String, "admin"),
+		"password":          tftypes.NewValue(tftypes.String, "secret"),
+	}
+	for range 3 {
+		_, _, diags := configureProvider(t, config)
+		require.Empty(t, diags)
+	}
+	assert.Equal(t, [][3]string{{http.MethodPost, "", ""}}, requests())
+
+	CloseSessions(context.Background())
+	assert.Equal(t, [][3]string{{http.MethodPost, "", ""}, {http.MethodDelete, "", "session-id"}}, requests())
+	assert.Empty(t, loginSessions.sessions)
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/dynamicplanmodifier"
@@ -29,6 +30,7 @@ var (
 	_ resource.Resource                = &siemHttpDestinationResource{}
 	_ resource.ResourceWithConfigure   = &siemHttpDestinationResource{}
 	_ resource.ResourceWithImportState = &siemHttpDestinationResource{}
+	_ resource.ResourceWithIdentity    = &siemHttpDestinationResource{}
 )
 
 func NewSiemHttpDestinationResource() resource.Resource {
@@ -109,18 +111,18 @@ func (r *siemHttpDestinationResource) Configure(_ context.Context, req resource.
 		return
 	}
 
-	sdk_config, ok := req.ProviderData.(files_sdk.Config)
+	provider_data, ok := req.ProviderData.(providerResourceData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected files_sdk.Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected providerResourceData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.client = &siem_http_destination.Client{Config: sdk_config}
+	r.client = &siem_http_destination.Client{Config: provider_data.Config}
 }
 
 func (r *siemHttpDestinationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -505,6 +507,17 @@ func (r *siemHttpDestinationResource) Schema(_ context.Context, _ resource.Schem
 	}
 }
 
+func (r *siemHttpDestinationResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.Int64Attribute{
+				Description:       "SIEM HTTP Destination ID",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
 func (r *siemHttpDestinationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan siemHttpDestinationResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -603,6 +616,12 @@ func (r *siemHttpDestinationResource) Create(ctx context.Context, req resource.C
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.Identity.SetAttribute(ctx, path.Root("id"), plan.Id)
+	resp.Diagnostics.Append(diags...)
 }
 
 func (r *siemHttpDestinationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -638,6 +657,12 @@ func (r *siemHttpDestinationResource) Read(ctx context.Context, req resource.Rea
 
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.Identity.SetAttribute(ctx, path.Root("id"), state.Id)
+	resp.Diagnostics.Append(diags...)
 }
 
 func (r *siemHttpDestinationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -779,6 +804,12 @@ func (r *siemHttpDestinationResource) Update(ctx context.Context, req resource.U
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.Identity.SetAttribute(ctx, path.Root("id"), plan.Id)
+	resp.Diagnostics.Append(diags...)
 }
 
 func (r *siemHttpDestinationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -802,6 +833,13 @@ func (r *siemHttpDestinationResource) Delete(ctx context.Context, req resource.D
 }
 
 func (r *siemHttpDestinationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID == "" {
+		var id types.Int64
+		resp.Diagnostics.Append(req.Identity.GetAttribute(ctx, path.Root("id"), &id)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+		return
+	}
+
 	idParts := strings.SplitN(req.ID, ",", 1)
 
 	if len(idParts) != 1 || idParts[0] == "" {
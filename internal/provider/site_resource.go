@@ -234,6 +234,18 @@ type siteResourceModel struct {
 	TrialDaysLeft                                      types.Int64   `tfsdk:"trial_days_left"`
 	TrialUntil                                         types.String  `tfsdk:"trial_until"`
 	User                                               types.String  `tfsdk:"user"`
+	ResetOnDestroy                                     types.Bool    `tfsdk:"reset_on_destroy"`
+}
+
+// siteResetSettingsKey is the private state key of the settings recorded for
+// reset_on_destroy.
+const siteResetSettingsKey = "reset_settings"
+
+// privateState is the private state of a resource, which the framework
+// passes to each operation.
+type privateState interface {
+	GetKey(ctx context.Context, key string) ([]byte, diag.Diagnostics)
+	SetKey(ctx context.Context, key string, value []byte) diag.Diagnostics
 }
 
 func (r *siteResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -241,18 +253,18 @@ func (r *siteResource) Configure(_ context.Context, req resource.ConfigureReques
 		return
 	}
 
-	sdk_config, ok := req.ProviderData.(files_sdk.Config)
+	provider_data, ok := req.ProviderData.(providerResourceData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected files_sdk.Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected providerResourceData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.client = &site.Client{Config: sdk_config}
+	r.client = &site.Client{Config: provider_data.Config}
 }
 
 func (r *siteResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -261,7 +273,7 @@ func (r *siteResource) Metadata(_ context.Context, req resource.MetadataRequest,
 
 func (r *siteResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Description: "A Site is the place you'll come to update site settings, as well as manage site-wide API keys.\n\n\n\nMost site settings can be set via the API.",
+		Description: "A Site is the place you'll come to update site settings, as well as manage site-wide API keys.\n\n\n\nMost site settings can be set via the API.\n\n\n\nEvery account has exactly one site, so `files_site` never creates or deletes it. Creating the resource adopts the existing site and applies the configured settings. Destroying it only removes it from Terraform state, unless `reset_on_destroy` is set.",
 		Attributes: map[string]schema.Attribute{
 			"name": schema.StringAttribute{
 				Description: "Site name",
@@ -1635,6 +1647,10 @@ func (r *siteResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 					int64planmodifier.UseStateForUnknown(),
 				},
 			},
+			"reset_on_destroy": schema.BoolAttribute{
+				Description: "If `true`, destroying this resource restores every setting Terraform changed to the value it had before Terraform first changed it. Otherwise destroying it only removes the site from Terraform state and leaves its settings as they are. Settings Files.com doesn't return, such as passwords, are not restored.",
+				Optional:    true,
+			},
 			"admin_user_id": schema.Int64Attribute{
 				Description: "User ID for the main site administrator",
 				Computed:    true,
@@ -1744,10 +1760,45 @@ func (r *siteResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 }
 
 func (r *siteResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	resp.Diagnostics.AddError(
-		"Resource Create Not Implemented",
-		"This resource does not support creation. Please import the resource.",
-	)
+	var plan siteResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	var config siteResourceModel
+	diags = req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The site always exists, so creating the resource adopts it and applies
+	// the planned settings on top of its current ones.
+	site, err := r.client.Get(files_sdk.WithContext(ctx))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Files Site",
+			"Could not read site: "+err.Error(),
+		)
+		return
+	}
+
+	var current siteResourceModel
+	diags = r.populateResourceModel(ctx, site, &current)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = r.update(ctx, &plan, config, current, resp.Private)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
 }
 
 func (r *siteResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -1796,6 +1847,95 @@ func (r *siteResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		return
 	}
 
+	var state siteResourceModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = r.update(ctx, &plan, config, state, resp.Private)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// update applies the settings in config to the site and populates plan from
+// the result. Before a setting is changed for the first time, its value in
+// prior is recorded in private state so reset_on_destroy can restore it.
+func (r *siteResource) update(ctx context.Context, plan *siteResourceModel, config siteResourceModel, prior siteResourceModel, private privateState) (diags diag.Diagnostics) {
+	paramsSiteUpdate, propDiags := r.updateParams(ctx, config)
+	diags.Append(propDiags...)
+	if diags.HasError() {
+		return
+	}
+
+	priorParams, propDiags := r.updateParams(ctx, prior)
+	diags.Append(propDiags...)
+	resetSettings, propDiags := r.resetSettings(ctx, private)
+	diags.Append(propDiags...)
+	if diags.HasError() {
+		return
+	}
+
+	for key, value := range paramsSiteUpdate {
+		if _, ok := resetSettings[key]; ok || value == nil || priorParams[key] == nil {
+			continue
+		}
+		resetSettings[key] = priorParams[key]
+	}
+
+	resetSettingsJSON, err := json.Marshal(resetSettings)
+	if err != nil {
+		diags.AddError(
+			"Error Updating Files Site",
+			"Could not encode private state: "+err.Error(),
+		)
+		return
+	}
+
+	site, err := r.client.UpdateWithMap(paramsSiteUpdate, files_sdk.WithContext(ctx))
+	if err != nil {
+		diags.AddError(
+			"Error Updating Files Site",
+			"Could not update site, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	diags.Append(private.SetKey(ctx, siteResetSettingsKey, resetSettingsJSON)...)
+	diags.Append(r.populateResourceModel(ctx, site, plan)...)
+	return
+}
+
+// resetSettings returns the settings recorded by update, keyed by update
+// parameter.
+func (r *siteResource) resetSettings(ctx context.Context, private privateState) (map[string]interface{}, diag.Diagnostics) {
+	resetSettings := map[string]interface{}{}
+	privateBytes, diags := private.GetKey(ctx, siteResetSettingsKey)
+	if diags.HasError() || privateBytes == nil {
+		return resetSettings, diags
+	}
+
+	if err := json.Unmarshal(privateBytes, &resetSettings); err != nil {
+		diags.AddError(
+			"Error Reading Files Site",
+			"Could not decode private state: "+err.Error(),
+		)
+	}
+	return resetSettings, diags
+}
+
+// updateParams returns the site update parameters for every setting that is
+// set in config.
+func (r *siteResource) updateParams(ctx context.Context, config siteResourceModel) (map[string]interface{}, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var propDiags diag.Diagnostics
+
 	paramsSiteUpdate := map[string]interface{}{}
 	if !config.Name.IsNull() && !config.Name.IsUnknown() {
 		paramsSiteUpdate["name"] = config.Name.ValueString()
@@ -1899,16 +2039,16 @@ func (r *siteResource) Update(ctx context.Context, req resource.UpdateRequest, r
 	if !config.DisableAllAiFeatures.IsNull() && !config.DisableAllAiFeatures.IsUnknown() {
 		paramsSiteUpdate["disable_all_ai_features"] = config.DisableAllAiFeatures.ValueBool()
 	}
-	updateAiFeatureAvailability, diags := lib.DynamicToInterface(ctx, path.Root("ai_feature_availability"), config.AiFeatureAvailability)
-	resp.Diagnostics.Append(diags...)
+	updateAiFeatureAvailability, propDiags := lib.DynamicToInterface(ctx, path.Root("ai_feature_availability"), config.AiFeatureAvailability)
+	diags.Append(propDiags...)
 	paramsSiteUpdate["ai_feature_availability"] = updateAiFeatureAvailability
 	if !config.McpDcrEnabled.IsNull() && !config.McpDcrEnabled.IsUnknown() {
 		paramsSiteUpdate["mcp_dcr_enabled"] = config.McpDcrEnabled.ValueBool()
 	}
 	if !config.AdditionalTextFileTypes.IsNull() && !config.AdditionalTextFileTypes.IsUnknown() {
 		var updateAdditionalTextFileTypes []string
-		diags = config.AdditionalTextFileTypes.ElementsAs(ctx, &updateAdditionalTextFileTypes, false)
-		resp.Diagnostics.Append(diags...)
+		propDiags = config.AdditionalTextFileTypes.ElementsAs(ctx, &updateAdditionalTextFileTypes, false)
+		diags.Append(propDiags...)
 		paramsSiteUpdate["additional_text_file_types"] = updateAdditionalTextFileTypes
 	}
 	if !config.BundleRequireNote.IsNull() && !config.BundleRequireNote.IsUnknown() {
@@ -2133,8 +2273,8 @@ func (r *siteResource) Update(ctx context.Context, req resource.UpdateRequest, r
 	if !config.RevokeBundleAccessOnDisableOrDelete.IsNull() && !config.RevokeBundleAccessOnDisableOrDelete.IsUnknown() {
 		paramsSiteUpdate["revoke_bundle_access_on_disable_or_delete"] = config.RevokeBundleAccessOnDisableOrDelete.ValueBool()
 	}
-	updateBundleWatermarkValue, diags := lib.DynamicToInterface(ctx, path.Root("bundle_watermark_value"), config.BundleWatermarkValue)
-	resp.Diagnostics.Append(diags...)
+	updateBundleWatermarkValue, propDiags := lib.DynamicToInterface(ctx, path.Root("bundle_watermark_value"), config.BundleWatermarkValue)
+	diags.Append(propDiags...)
 	paramsSiteUpdate["bundle_watermark_value"] = updateBundleWatermarkValue
 	if !config.GroupAdminsCanAddUsers.IsNull() && !config.GroupAdminsCanAddUsers.IsUnknown() {
 		paramsSiteUpdate["group_admins_can_add_users"] = config.GroupAdminsCanAddUsers.ValueBool()
@@ -2165,8 +2305,8 @@ func (r *siteResource) Update(ctx context.Context, req resource.UpdateRequest, r
 	}
 	if !config.BundleRecipientBlacklistDomains.IsNull() && !config.BundleRecipientBlacklistDomains.IsUnknown() {
 		var updateBundleRecipientBlacklistDomains []string
-		diags = config.BundleRecipientBlacklistDomains.ElementsAs(ctx, &updateBundleRecipientBlacklistDomains, false)
-		resp.Diagnostics.Append(diags...)
+		propDiags = config.BundleRecipientBlacklistDomains.ElementsAs(ctx, &updateBundleRecipientBlacklistDomains, false)
+		diags.Append(propDiags...)
 		paramsSiteUpdate["bundle_recipient_blacklist_domains"] = updateBundleRecipientBlacklistDomains
 	}
 	if !config.AdminsBypassLockedSubfolders.IsNull() && !config.AdminsBypassLockedSubfolders.IsUnknown() {
@@ -2305,34 +2445,36 @@ func (r *siteResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		paramsSiteUpdate["uploads_via_email_authentication"] = config.UploadsViaEmailAuthentication.ValueBool()
 	}
 
+	return paramsSiteUpdate, diags
+}
+
+func (r *siteResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state siteResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	site, err := r.client.UpdateWithMap(paramsSiteUpdate, files_sdk.WithContext(ctx))
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error Updating Files Site",
-			"Could not update site, unexpected error: "+err.Error(),
-		)
+	// The site can't be deleted, so unless reset_on_destroy is set it is
+	// only removed from state.
+	if !state.ResetOnDestroy.ValueBool() {
 		return
 	}
 
-	diags = r.populateResourceModel(ctx, site, &plan)
+	resetSettings, diags := r.resetSettings(ctx, req.Private)
 	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
+	if resp.Diagnostics.HasError() || len(resetSettings) == 0 {
 		return
 	}
 
-	diags = resp.State.Set(ctx, plan)
-	resp.Diagnostics.Append(diags...)
-}
-
-func (r *siteResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	resp.Diagnostics.AddError(
-		"Resource Delete Not Implemented",
-		"This resource does not support deletion.",
-	)
+	_, err := r.client.UpdateWithMap(resetSettings, files_sdk.WithContext(ctx))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Files Site",
+			"Could not reset site settings: "+err.Error(),
+		)
+	}
 }
 
 func (r *siteResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
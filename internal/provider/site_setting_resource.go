@@ -0,0 +1,361 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+
+	files_sdk "github.com/Files-com/files-sdk-go/v3"
+	site "github.com/Files-com/files-sdk-go/v3/site"
+	"github.com/Files-com/terraform-provider-files/lib"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var (
+	_ resource.Resource                   = &siteSettingResource{}
+	_ resource.ResourceWithConfigure      = &siteSettingResource{}
+	_ resource.ResourceWithImportState    = &siteSettingResource{}
+	_ resource.ResourceWithIdentity       = &siteSettingResource{}
+	_ resource.ResourceWithValidateConfig = &siteSettingResource{}
+)
+
+func NewSiteSettingResource() resource.Resource {
+	return &siteSettingResource{}
+}
+
+type siteSettingResource struct {
+	client *site.Client
+}
+
+type siteSettingResourceModel struct {
+	Name  types.String `tfsdk:"name"`
+	Value types.String `tfsdk:"value"`
+}
+
+func (r *siteSettingResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	provider_data, ok := req.ProviderData.(providerResourceData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected providerResourceData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = &site.Client{Config: provider_data.Config}
+}
+
+func (r *siteSettingResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_site_setting"
+}
+
+func (r *siteSettingResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "A Site Setting manages a single setting of the site, and leaves every other setting alone.\n\n\n\nUse it instead of `files_site` when a configuration should own only some of the site's settings, for example so separate teams can each manage their own. Other changes to the site never show up as drift. Don't manage the same setting with both `files_site` and `files_site_setting`.\n\n\n\nDestroying a Site Setting stops managing it, and leaves the setting at its current value.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Description: "Name of the setting, as named by the matching `files_site` attribute, for example `welcome_email_enabled`.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"value": schema.StringAttribute{
+				Description: "Value of the setting. Booleans and numbers are given as strings, such as `\"true\"` or `\"30\"`. List and object settings are given as JSON, for example `jsonencode([\"csv\", \"log\"])`.",
+				Required:    true,
+			},
+		},
+	}
+}
+
+func (r *siteSettingResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"name": identityschema.StringAttribute{
+				Description:       "Name of the setting",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+func (r *siteSettingResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config siteSettingResourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.Name.IsNull() || config.Name.IsUnknown() {
+		return
+	}
+
+	attribute, ok := siteSettingAttributes(ctx)[config.Name.ValueString()]
+	if !ok {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("name"),
+			"Invalid Site Setting Name",
+			fmt.Sprintf("%q is not a site setting. The name must be one of the optional attributes of files_site.", config.Name.ValueString()),
+		)
+		return
+	}
+
+	if config.Value.IsNull() || config.Value.IsUnknown() {
+		return
+	}
+
+	if _, err := siteSettingParam(attribute, config.Value.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("value"),
+			"Invalid Site Setting Value",
+			fmt.Sprintf("Invalid value for site setting %s: %s", config.Name.ValueString(), err),
+		)
+	}
+}
+
+func (r *siteSettingResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan siteSettingResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = r.update(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.Identity.SetAttribute(ctx, path.Root("name"), plan.Name)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *siteSettingResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state siteSettingResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	site, err := r.client.Get(files_sdk.WithContext(ctx))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Files Site Setting",
+			"Could not read site: "+err.Error(),
+		)
+		return
+	}
+
+	value, diags := siteSettingValue(ctx, site, state.Name.ValueString())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Keep the configured spelling of a value that means the same thing,
+	// such as "1" for true.
+	if state.Value.IsNull() || !siteSettingValuesEqual(siteSettingAttributes(ctx)[state.Name.ValueString()], state.Value.ValueString(), value) {
+		state.Value = types.StringValue(value)
+	}
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.Identity.SetAttribute(ctx, path.Root("name"), state.Name)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *siteSettingResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan siteSettingResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = r.update(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.Identity.SetAttribute(ctx, path.Root("name"), plan.Name)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *siteSettingResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Every setting always has a value, so destroying a setting only stops
+	// managing it.
+}
+
+func (r *siteSettingResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("name"), path.Root("name"), req, resp)
+}
+
+// update sets the single site setting in plan.
+func (r *siteSettingResource) update(ctx context.Context, plan siteSettingResourceModel) (diags diag.Diagnostics) {
+	value, err := siteSettingParam(siteSettingAttributes(ctx)[plan.Name.ValueString()], plan.Value.ValueString())
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("value"),
+			"Invalid Site Setting Value",
+			fmt.Sprintf("Invalid value for site setting %s: %s", plan.Name.ValueString(), err),
+		)
+		return
+	}
+
+	paramsSiteUpdate := map[string]interface{}{
+		plan.Name.ValueString(): value,
+	}
+
+	_, err = r.client.UpdateWithMap(paramsSiteUpdate, files_sdk.WithContext(ctx))
+	if err != nil {
+		diags.AddError(
+			"Error Updating Files Site Setting",
+			"Could not update site setting "+plan.Name.ValueString()+": "+err.Error(),
+		)
+	}
+	return
+}
+
+// siteSettingAttributes returns the files_site attributes that can be set,
+// keyed by setting name. Every setting Files.com accepts is both optional and
+// computed, since the site always has a value for it.
+func siteSettingAttributes(ctx context.Context) map[string]schema.Attribute {
+	resp := &resource.SchemaResponse{}
+	(&siteResource{}).Schema(ctx, resource.SchemaRequest{}, resp)
+
+	attributes := map[string]schema.Attribute{}
+	for name, attribute := range resp.Schema.Attributes {
+		if attribute.IsOptional() && attribute.IsComputed() {
+			attributes[name] = attribute
+		}
+	}
+	return attributes
+}
+
+// siteSettingValue returns the value of the setting name of site, encoded as
+// files_site_setting's value attribute.
+func siteSettingValue(ctx context.Context, site files_sdk.Site, name string) (string, diag.Diagnostics) {
+	siteResp := &resource.SchemaResponse{}
+	(&siteResource{}).Schema(ctx, resource.SchemaRequest{}, siteResp)
+
+	var model siteResourceModel
+	diags := (&siteResource{}).populateResourceModel(ctx, site, &model)
+	if diags.HasError() {
+		return "", diags
+	}
+
+	state := tfsdk.State{
+		Schema: siteResp.Schema,
+		Raw:    tftypes.NewValue(siteResp.Schema.Type().TerraformType(ctx), nil),
+	}
+	diags.Append(state.Set(ctx, model)...)
+
+	var value attr.Value
+	diags.Append(state.GetAttribute(ctx, path.Root(name), &value)...)
+	if diags.HasError() || value == nil || value.IsNull() {
+		return "", diags
+	}
+
+	switch v := value.(type) {
+	case types.Bool:
+		return strconv.FormatBool(v.ValueBool()), diags
+	case types.Int64:
+		return strconv.FormatInt(v.ValueInt64(), 10), diags
+	case types.String:
+		return v.ValueString(), diags
+	}
+
+	param, propDiags := lib.AttributeToInterface(ctx, path.Root(name), value)
+	diags.Append(propDiags...)
+	encoded, err := json.Marshal(param)
+	if err != nil {
+		diags.AddError(
+			"Error Reading Files Site Setting",
+			"Could not encode site setting "+name+": "+err.Error(),
+		)
+	}
+	return string(encoded), diags
+}
+
+// siteSettingParam decodes value into the site update parameter for a
+// setting described by attribute.
+func siteSettingParam(attribute schema.Attribute, value string) (interface{}, error) {
+	switch attribute.(type) {
+	case schema.BoolAttribute:
+		return strconv.ParseBool(value)
+	case schema.Int64Attribute:
+		return strconv.ParseInt(value, 10, 64)
+	case schema.ListAttribute:
+		var list []string
+		if err := json.Unmarshal([]byte(value), &list); err != nil {
+			return nil, fmt.Errorf("expected a JSON list of strings: %w", err)
+		}
+		return list, nil
+	case schema.DynamicAttribute:
+		var param interface{}
+		if err := json.Unmarshal([]byte(value), &param); err != nil {
+			return nil, fmt.Errorf("expected JSON: %w", err)
+		}
+		return param, nil
+	}
+	return value, nil
+}
+
+// siteSettingValuesEqual reports whether a and b are the same value of the
+// setting described by attribute.
+func siteSettingValuesEqual(attribute schema.Attribute, a string, b string) bool {
+	if a == b {
+		return true
+	}
+	if attribute == nil {
+		return false
+	}
+	aParam, err := siteSettingParam(attribute, a)
+	if err != nil {
+		return false
+	}
+	bParam, err := siteSettingParam(attribute, b)
+	if err != nil {
+		return false
+	}
+	return reflect.DeepEqual(aParam, bParam)
+}
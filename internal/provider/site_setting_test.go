@@ -0,0 +1,39 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	files_sdk "github.com/Files-com/files-sdk-go/v3"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSiteSettingValue(t *testing.T) {
+	ctx := context.Background()
+	attributes := siteSettingAttributes(ctx)
+	assert.NotContains(t, attributes, "reset_on_destroy")
+	assert.NotContains(t, attributes, "id")
+
+	enabled := true
+	site := files_sdk.Site{
+		BundleExpiration:        30,
+		WelcomeEmailEnabled:     &enabled,
+		AdditionalTextFileTypes: []string{"csv", "log"},
+	}
+	for name, expected := range map[string]string{
+		"bundle_expiration":          "30",
+		"welcome_email_enabled":      "true",
+		"additional_text_file_types": `["csv","log"]`,
+	} {
+		value, diags := siteSettingValue(ctx, site, name)
+		assert.False(t, diags.HasError(), diags)
+		assert.Equal(t, expected, value, name)
+	}
+
+	assert.True(t, siteSettingValuesEqual(attributes["welcome_email_enabled"], "1", "true"))
+	assert.True(t, siteSettingValuesEqual(attributes["additional_text_file_types"], `["csv", "log"]`, `["csv","log"]`))
+	assert.False(t, siteSettingValuesEqual(attributes["bundle_expiration"], "30", "7"))
+
+	_, err := siteSettingParam(attributes["bundle_expiration"], "thirty")
+	assert.Error(t, err)
+}
@@ -1,11 +1,19 @@
 package provider
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestSiteResource(t *testing.T) {
@@ -40,3 +48,83 @@ func TestSiteResource(t *testing.T) {
 		},
 	})
 }
+
+func TestSiteCreateAndDelete(t *testing.T) {
+	requests := []map[string]interface{}{}
+	name := "Original Name"
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		body := map[string]interface{}{}
+		json.NewDecoder(req.Body).Decode(&body)
+		body["request"] = req.Method + " " + req.URL.Path
+		requests = append(requests, body)
+		if value, ok := body["name"].(string); ok {
+			name = value
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{"id": 1, "name": name})
+	}))
+	defer server.Close()
+	t.Setenv("FILES_API_KEY", "api-key")
+	t.Setenv("HOME", t.TempDir())
+
+	ctx := context.Background()
+	providerServer, schemaResp, diags := configureProvider(t, map[string]tftypes.Value{
+		"endpoint_override": tftypes.NewValue(tftypes.String, server.URL),
+	})
+	require.Empty(t, diags)
+	siteSchema := schemaResp.ResourceSchemas["files_site"]
+	null, err := tfprotov6.NewDynamicValue(siteSchema.ValueType(), tftypes.NewValue(siteSchema.ValueType(), nil))
+	require.NoError(t, err)
+
+	destroy := func(resetOnDestroy bool, private []byte) {
+		t.Helper()
+		requests = requests[:0]
+		resp, err := providerServer.ApplyResourceChange(ctx, &tfprotov6.ApplyResourceChangeRequest{
+			TypeName: "files_site",
+			PriorState: dynamicValue(t, siteSchema, map[string]tftypes.Value{
+				"name":             tftypes.NewValue(tftypes.String, "New Name"),
+				"reset_on_destroy": tftypes.NewValue(tftypes.Bool, resetOnDestroy),
+			}),
+			PlannedState:   &null,
+			Config:         &null,
+			PlannedPrivate: private,
+		})
+		require.NoError(t, err)
+		require.Empty(t, resp.Diagnostics)
+	}
+
+	// Creating the resource adopts the existing site and records the settings
+	// it changes.
+	values := map[string]tftypes.Value{
+		"name":             tftypes.NewValue(tftypes.String, "New Name"),
+		"reset_on_destroy": tftypes.NewValue(tftypes.Bool, true),
+	}
+	createResp, err := providerServer.ApplyResourceChange(ctx, &tfprotov6.ApplyResourceChangeRequest{
+		TypeName:     "files_site",
+		PriorState:   &null,
+		PlannedState: dynamicValue(t, siteSchema, values),
+		Config:       dynamicValue(t, siteSchema, values),
+	})
+	require.NoError(t, err)
+	require.Empty(t, createResp.Diagnostics)
+	if assert.Len(t, requests, 2) {
+		assert.Equal(t, map[string]interface{}{"request": "GET /api/rest/v1/site"}, requests[0])
+		assert.Equal(t, "PATCH /api/rest/v1/site", requests[1]["request"])
+		assert.Equal(t, "New Name", requests[1]["name"])
+	}
+	state, err := createResp.NewState.Unmarshal(siteSchema.ValueType())
+	require.NoError(t, err)
+	stateName, _, err := tftypes.WalkAttributePath(state, tftypes.NewAttributePath().WithAttributeName("name"))
+	require.NoError(t, err)
+	assert.Equal(t, tftypes.NewValue(tftypes.String, "New Name"), stateName)
+
+	// Destroying it without reset_on_destroy leaves the site alone.
+	destroy(false, createResp.Private)
+	assert.Empty(t, requests)
+
+	// With reset_on_destroy, the recorded settings are restored.
+	destroy(true, createResp.Private)
+	if assert.Len(t, requests, 1) {
+		assert.Equal(t, map[string]interface{}{"request": "PATCH /api/rest/v1/site", "name": "Original Name"}, requests[0])
+	}
+}
@@ -7,6 +7,7 @@ import (
 
 	files_sdk "github.com/Files-com/files-sdk-go/v3"
 	style "github.com/Files-com/files-sdk-go/v3/style"
+	"github.com/Files-com/terraform-provider-files/lib"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -28,11 +29,11 @@ type styleDataSource struct {
 }
 
 type styleDataSourceModel struct {
-	Path          types.String `tfsdk:"path"`
-	Id            types.Int64  `tfsdk:"id"`
-	Logo          types.String `tfsdk:"logo"`
-	LogoClickHref types.String `tfsdk:"logo_click_href"`
-	Thumbnail     types.String `tfsdk:"thumbnail"`
+	Path          lib.NormalizedPath `tfsdk:"path"`
+	Id            types.Int64        `tfsdk:"id"`
+	Logo          types.String       `tfsdk:"logo"`
+	LogoClickHref types.String       `tfsdk:"logo_click_href"`
+	Thumbnail     types.String       `tfsdk:"thumbnail"`
 }
 
 func (r *styleDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -65,6 +66,7 @@ func (r *styleDataSource) Schema(_ context.Context, _ datasource.SchemaRequest,
 			"path": schema.StringAttribute{
 				Description: "Folder path. This must be slash-delimited, but it must neither start nor end with a slash. Maximum of 5000 characters.",
 				Required:    true,
+				CustomType:  lib.NormalizedPathType{},
 			},
 			"id": schema.Int64Attribute{
 				Description: "Style ID",
@@ -118,7 +120,7 @@ func (r *styleDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 
 func (r *styleDataSource) populateDataSourceModel(ctx context.Context, style files_sdk.Style, state *styleDataSourceModel) (diags diag.Diagnostics) {
 	state.Id = types.Int64Value(style.Id)
-	state.Path = types.StringValue(style.Path)
+	state.Path = lib.NormalizedPathValue(style.Path)
 	respLogo, err := json.Marshal(style.Logo)
 	if err != nil {
 		diags.AddError(
@@ -8,10 +8,12 @@ import (
 
 	files_sdk "github.com/Files-com/files-sdk-go/v3"
 	style "github.com/Files-com/files-sdk-go/v3/style"
+	"github.com/Files-com/terraform-provider-files/lib"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -23,6 +25,7 @@ var (
 	_ resource.Resource                = &styleResource{}
 	_ resource.ResourceWithConfigure   = &styleResource{}
 	_ resource.ResourceWithImportState = &styleResource{}
+	_ resource.ResourceWithIdentity    = &styleResource{}
 )
 
 func NewStyleResource() resource.Resource {
@@ -34,11 +37,11 @@ type styleResource struct {
 }
 
 type styleResourceModel struct {
-	Path          types.String `tfsdk:"path"`
-	LogoClickHref types.String `tfsdk:"logo_click_href"`
-	Id            types.Int64  `tfsdk:"id"`
-	Logo          types.String `tfsdk:"logo"`
-	Thumbnail     types.String `tfsdk:"thumbnail"`
+	Path          lib.NormalizedPath `tfsdk:"path"`
+	LogoClickHref types.String       `tfsdk:"logo_click_href"`
+	Id            types.Int64        `tfsdk:"id"`
+	Logo          types.String       `tfsdk:"logo"`
+	Thumbnail     types.String       `tfsdk:"thumbnail"`
 }
 
 func (r *styleResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -46,22 +49,24 @@ func (r *styleResource) Configure(_ context.Context, req resource.ConfigureReque
 		return
 	}
 
-	sdk_config, ok := req.ProviderData.(files_sdk.Config)
+	provider_data, ok := req.ProviderData.(providerResourceData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected files_sdk.Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected providerResourceData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.client = &style.Client{Config: sdk_config}
+	r.client = &style.Client{Config: provider_data.Config}
 }
 
 func (r *styleResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_style"
+	// Changing path moves the style to another folder, so its identity follows.
+	resp.ResourceBehavior.MutableIdentity = true
 }
 
 func (r *styleResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
@@ -74,6 +79,7 @@ func (r *styleResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
 				},
+				CustomType: lib.NormalizedPathType{},
 			},
 			"logo_click_href": schema.StringAttribute{
 				Description: "URL to open when a public visitor clicks the logo",
@@ -99,6 +105,17 @@ func (r *styleResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 	}
 }
 
+func (r *styleResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"path": identityschema.StringAttribute{
+				Description:       "Folder path",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
 func (r *styleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	resp.Diagnostics.AddError(
 		"Resource Create Not Implemented",
@@ -139,6 +156,12 @@ func (r *styleResource) Read(ctx context.Context, req resource.ReadRequest, resp
 
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.Identity.SetAttribute(ctx, path.Root("path"), state.Path.ValueString())
+	resp.Diagnostics.Append(diags...)
 }
 
 func (r *styleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -184,6 +207,12 @@ func (r *styleResource) Update(ctx context.Context, req resource.UpdateRequest,
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.Identity.SetAttribute(ctx, path.Root("path"), plan.Path.ValueString())
+	resp.Diagnostics.Append(diags...)
 }
 
 func (r *styleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -207,6 +236,13 @@ func (r *styleResource) Delete(ctx context.Context, req resource.DeleteRequest,
 }
 
 func (r *styleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID == "" {
+		var identityPath types.String
+		resp.Diagnostics.Append(req.Identity.GetAttribute(ctx, path.Root("path"), &identityPath)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("path"), identityPath)...)
+		return
+	}
+
 	idParts := strings.SplitN(req.ID, ",", 1)
 
 	if len(idParts) != 1 || idParts[0] == "" {
@@ -223,7 +259,7 @@ func (r *styleResource) ImportState(ctx context.Context, req resource.ImportStat
 
 func (r *styleResource) populateResourceModel(ctx context.Context, style files_sdk.Style, state *styleResourceModel) (diags diag.Diagnostics) {
 	state.Id = types.Int64Value(style.Id)
-	state.Path = types.StringValue(style.Path)
+	state.Path = lib.NormalizedPathValue(style.Path)
 	respLogo, err := json.Marshal(style.Logo)
 	if err != nil {
 		diags.AddError(
@@ -9,6 +9,7 @@ import (
 	sync "github.com/Files-com/files-sdk-go/v3/sync"
 	"github.com/Files-com/terraform-provider-files/lib"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -17,8 +18,9 @@ import (
 )
 
 var (
-	_ datasource.DataSource              = &syncDataSource{}
-	_ datasource.DataSourceWithConfigure = &syncDataSource{}
+	_ datasource.DataSource                     = &syncDataSource{}
+	_ datasource.DataSourceWithConfigure        = &syncDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &syncDataSource{}
 )
 
 func NewSyncDataSource() datasource.DataSource {
@@ -90,14 +92,16 @@ func (r *syncDataSource) Metadata(_ context.Context, req datasource.MetadataRequ
 
 func (r *syncDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Description: "A Sync represents a file synchronization job between two locations (local-remote, remote-remote, local-child_site, etc). \n\nIt can be scheduled, run manually, or triggered by custom logic. \n\nSyncs track their runs, status, and configuration.",
+		Description: "A Sync represents a file synchronization job between two locations (local-remote, remote-remote, local-child_site, etc). \n\nIt can be scheduled, run manually, or triggered by custom logic. \n\nSyncs track their runs, status, and configuration.\n\nLook up a sync either by `id` or by `name`. A `name` lookup fails if no sync, or more than one sync, has that name.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.Int64Attribute{
 				Description: "Sync ID",
-				Required:    true,
+				Optional:    true,
+				Computed:    true,
 			},
 			"name": schema.StringAttribute{
 				Description: "Name for this sync job",
+				Optional:    true,
 				Computed:    true,
 			},
 			"description": schema.StringAttribute{
@@ -233,6 +237,15 @@ func (r *syncDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, r
 	}
 }
 
+func (r *syncDataSource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("id"),
+			path.MatchRoot("name"),
+		),
+	}
+}
+
 func (r *syncDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	var data syncDataSourceModel
 	diags := req.Config.Get(ctx, &data)
@@ -241,6 +254,29 @@ func (r *syncDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 		return
 	}
 
+	if data.Id.IsNull() {
+		paramsSyncList := files_sdk.SyncListParams{}
+
+		syncIt, err := r.client.List(paramsSyncList, files_sdk.WithContext(ctx))
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Reading Files Sync",
+				"Could not list syncs: "+err.Error(),
+			)
+			return
+		}
+
+		match, diags := lookupByKey(syncIt, "Error Reading Files Sync", "sync", "name", data.Name.ValueString(),
+			func(s files_sdk.Sync) string { return s.Name },
+			func(s files_sdk.Sync) int64 { return s.Id },
+		)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		data.Id = types.Int64Value(match.Id)
+	}
+
 	paramsSyncFind := files_sdk.SyncFindParams{}
 	paramsSyncFind.Id = data.Id.ValueInt64()
 
@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
@@ -30,6 +31,8 @@ var (
 	_ resource.Resource                = &syncResource{}
 	_ resource.ResourceWithConfigure   = &syncResource{}
 	_ resource.ResourceWithImportState = &syncResource{}
+	_ resource.ResourceWithIdentity    = &syncResource{}
+	_ resource.ResourceWithModifyPlan  = &syncResource{}
 )
 
 func NewSyncResource() resource.Resource {
@@ -37,7 +40,8 @@ func NewSyncResource() resource.Resource {
 }
 
 type syncResource struct {
-	client *sync.Client
+	client             *sync.Client
+	defaultWorkspaceId types.Int64
 }
 
 type syncResourceModel struct {
@@ -81,18 +85,19 @@ func (r *syncResource) Configure(_ context.Context, req resource.ConfigureReques
 		return
 	}
 
-	sdk_config, ok := req.ProviderData.(files_sdk.Config)
+	provider_data, ok := req.ProviderData.(providerResourceData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected files_sdk.Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected providerResourceData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.client = &sync.Client{Config: sdk_config}
+	r.client = &sync.Client{Config: provider_data.Config}
+	r.defaultWorkspaceId = provider_data.DefaultWorkspaceId
 }
 
 func (r *syncResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -128,6 +133,9 @@ func (r *syncResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 					int64planmodifier.RequiresReplace(),
 				},
 			},
+			// src_path and dest_path are compared exactly rather than with
+			// lib.NormalizedPathType, because one of them is a path on the remote server
+			// when syncing with one, and its file system may be case sensitive.
 			"src_path": schema.StringAttribute{
 				Description: "Absolute source path for the sync",
 				Computed:    true,
@@ -347,6 +355,21 @@ func (r *syncResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 	}
 }
 
+func (r *syncResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.Int64Attribute{
+				Description:       "Sync ID",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+func (r *syncResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyPlanDefaultWorkspaceId(ctx, r.defaultWorkspaceId, req, resp)
+}
+
 func (r *syncResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan syncResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -431,6 +454,12 @@ func (r *syncResource) Create(ctx context.Context, req resource.CreateRequest, r
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.Identity.SetAttribute(ctx, path.Root("id"), plan.Id)
+	resp.Diagnostics.Append(diags...)
 }
 
 func (r *syncResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -466,6 +495,12 @@ func (r *syncResource) Read(ctx context.Context, req resource.ReadRequest, resp
 
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.Identity.SetAttribute(ctx, path.Root("id"), state.Id)
+	resp.Diagnostics.Append(diags...)
 }
 
 func (r *syncResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -592,6 +627,12 @@ func (r *syncResource) Update(ctx context.Context, req resource.UpdateRequest, r
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.Identity.SetAttribute(ctx, path.Root("id"), plan.Id)
+	resp.Diagnostics.Append(diags...)
 }
 
 func (r *syncResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -615,6 +656,13 @@ func (r *syncResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 }
 
 func (r *syncResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID == "" {
+		var id types.Int64
+		resp.Diagnostics.Append(req.Identity.GetAttribute(ctx, path.Root("id"), &id)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+		return
+	}
+
 	idParts := strings.SplitN(req.ID, ",", 1)
 
 	if len(idParts) != 1 || idParts[0] == "" {
@@ -0,0 +1,244 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	files_sdk "github.com/Files-com/files-sdk-go/v3"
+	sync "github.com/Files-com/files-sdk-go/v3/sync"
+	sync_log "github.com/Files-com/files-sdk-go/v3/synclog"
+	sync_run "github.com/Files-com/files-sdk-go/v3/syncrun"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ action.Action              = &syncRunAction{}
+	_ action.ActionWithConfigure = &syncRunAction{}
+)
+
+func NewSyncRunAction() action.Action {
+	return &syncRunAction{}
+}
+
+type syncRunAction struct {
+	client    *sync.Client
+	runClient *sync_run.Client
+	logClient *sync_log.Client
+}
+
+type syncRunActionModel struct {
+	SyncId  types.Int64 `tfsdk:"sync_id"`
+	Wait    types.Bool  `tfsdk:"wait"`
+	Timeout types.Int64 `tfsdk:"timeout"`
+}
+
+func (a *syncRunAction) Configure(_ context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	sdk_config, ok := req.ProviderData.(files_sdk.Config)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Action Configure Type",
+			fmt.Sprintf("Expected files_sdk.Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	a.client = &sync.Client{Config: sdk_config}
+	a.runClient = &sync_run.Client{Config: sdk_config}
+	a.logClient = &sync_log.Client{Config: sdk_config}
+}
+
+func (a *syncRunAction) Metadata(_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_sync_run"
+}
+
+func (a *syncRunAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Runs a Sync immediately instead of waiting for its next scheduled run.\n\n\n\nSet `wait` to block until the resulting Sync Run finishes. A run that ends in `failure` is reported as an error, and a `partial_failure` or `skipped` run as a warning. Event errors, the first 10 log messages of the files that failed to sync and the run's log URL are included in the diagnostic.",
+		Attributes: map[string]schema.Attribute{
+			"sync_id": schema.Int64Attribute{
+				Description: "ID of the Sync to run.",
+				Required:    true,
+			},
+			"wait": schema.BoolAttribute{
+				Description: "Wait for the Sync Run to finish and report its status. Defaults to `false`.",
+				Optional:    true,
+			},
+			"timeout": schema.Int64Attribute{
+				Description: "The maximum number of seconds to wait for the Sync Run to finish when `wait` is set. The run carries on in Files.com if the timeout passes. Defaults to `3600`.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+func (a *syncRunAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config syncRunActionModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	syncId := config.SyncId.ValueInt64()
+
+	// Manual runs don't return the run they start, so the newest run from
+	// before the request is remembered to recognize the new one.
+	var previousRun files_sdk.SyncRun
+	if config.Wait.ValueBool() {
+		var err error
+		previousRun, err = a.latestRun(ctx, syncId)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Running Files Sync",
+				fmt.Sprintf("Could not list runs for sync id %d: %s", syncId, err),
+			)
+			return
+		}
+	}
+
+	paramsSyncManualRun := files_sdk.SyncManualRunParams{}
+	paramsSyncManualRun.Id = syncId
+
+	err := a.client.ManualRun(paramsSyncManualRun, files_sdk.WithContext(ctx))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Running Files Sync",
+			fmt.Sprintf("Could not run sync id %d: %s", syncId, err),
+		)
+		return
+	}
+
+	if !config.Wait.ValueBool() {
+		return
+	}
+
+	sendProgress(resp, fmt.Sprintf("Started sync %d, waiting for it to finish", syncId))
+
+	var run files_sdk.SyncRun
+	err = waitForRun(ctx, runTimeout(config.Timeout), func(ctx context.Context) (bool, error) {
+		status := run.Status
+		if run.Id == 0 {
+			latestRun, err := a.latestRun(ctx, syncId)
+			if err != nil || latestRun.Id <= previousRun.Id {
+				return false, err
+			}
+			run = latestRun
+		} else {
+			paramsSyncRunFind := files_sdk.SyncRunFindParams{}
+			paramsSyncRunFind.Id = run.Id
+
+			var err error
+			run, err = a.runClient.Find(paramsSyncRunFind, files_sdk.WithContext(ctx))
+			if err != nil {
+				return false, err
+			}
+		}
+
+		if run.Status != status {
+			sendProgress(resp, fmt.Sprintf("Sync run %d is %s", run.Id, run.Status))
+		}
+		return syncRunFinished(run.Status), nil
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Waiting for Files Sync Run",
+			fmt.Sprintf("Could not wait for sync id %d to finish: %s", syncId, err),
+		)
+		return
+	}
+
+	detail := fmt.Sprintf("Sync run %d for sync id %d finished with status %s: %d files synced and %d files errored.", run.Id, syncId, run.Status, run.SuccessfulFiles, run.ErroredFiles)
+	if len(run.EventErrors) > 0 {
+		detail += "\n\nErrors:\n" + strings.Join(run.EventErrors, "\n")
+	}
+	if run.Status == "failure" || run.Status == "partial_failure" {
+		lines, err := a.failedLogLines(ctx, run.Id)
+		if err != nil {
+			tflog.Warn(ctx, "Could not read sync run logs", map[string]interface{}{"sync_run_id": run.Id, "error": err.Error()})
+		}
+		detail += runLogDetail(lines)
+	}
+	if run.LogUrl != "" {
+		detail += "\n\nLog: " + run.LogUrl
+	}
+
+	switch run.Status {
+	case "failure":
+		resp.Diagnostics.AddError("Files Sync Run Failed", detail)
+	case "partial_failure":
+		resp.Diagnostics.AddWarning("Files Sync Run Partially Failed", detail)
+	case "skipped":
+		resp.Diagnostics.AddWarning("Files Sync Run Skipped", detail)
+	}
+}
+
+// latestRun returns the most recently created run of the sync, or an empty
+// run if it has never run.
+func (a *syncRunAction) latestRun(ctx context.Context, syncId int64) (files_sdk.SyncRun, error) {
+	paramsSyncRunList := files_sdk.SyncRunListParams{}
+	paramsSyncRunList.Filter = map[string]interface{}{"sync_id": syncId}
+	paramsSyncRunList.SortBy = map[string]interface{}{"created_at": "desc"}
+	paramsSyncRunList.PerPage = 1
+	paramsSyncRunList.MaxPages = 1
+
+	it, err := a.runClient.List(paramsSyncRunList, files_sdk.WithContext(ctx))
+	if err != nil {
+		return files_sdk.SyncRun{}, err
+	}
+	runs, err := listAll[files_sdk.SyncRun](it)
+	if err != nil || len(runs) == 0 {
+		return files_sdk.SyncRun{}, err
+	}
+	return runs[0], nil
+}
+
+// failedLogLines returns the log messages of the files that didn't sync in
+// the first page of the run's logs.
+func (a *syncRunAction) failedLogLines(ctx context.Context, runId int64) ([]string, error) {
+	paramsSyncLogList := files_sdk.SyncLogListParams{}
+	paramsSyncLogList.Filter = map[string]interface{}{"sync_run_id": runId}
+	paramsSyncLogList.PerPage = runLogPageSize
+	paramsSyncLogList.MaxPages = 1
+
+	it, err := a.logClient.List(paramsSyncLogList, files_sdk.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	logs, err := listAll[files_sdk.SyncLog](it)
+	if err != nil {
+		return nil, err
+	}
+
+	lines := []string{}
+	for _, log := range logs {
+		if log.Status == "success" || log.Message == "" {
+			continue
+		}
+		lines = append(lines, runLogLine(log.Path, log.Message))
+	}
+	return lines, nil
+}
+
+func syncRunFinished(status string) bool {
+	switch status {
+	case "success", "partial_failure", "failure", "skipped":
+		return true
+	}
+	return false
+}
@@ -0,0 +1,128 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	files_sdk "github.com/Files-com/files-sdk-go/v3"
+	sync "github.com/Files-com/files-sdk-go/v3/sync"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &syncsDataSource{}
+	_ datasource.DataSourceWithConfigure = &syncsDataSource{}
+)
+
+func NewSyncsDataSource() datasource.DataSource {
+	return &syncsDataSource{}
+}
+
+type syncsDataSource struct {
+	client *sync.Client
+}
+
+type syncsDataSourceModel struct {
+	SortBy types.Map  `tfsdk:"sort_by"`
+	Filter types.Map  `tfsdk:"filter"`
+	Syncs  types.List `tfsdk:"syncs"`
+}
+
+func (r *syncsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	sdk_config, ok := req.ProviderData.(files_sdk.Config)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected files_sdk.Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = &sync.Client{Config: sdk_config}
+}
+
+func (r *syncsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_syncs"
+}
+
+func (r *syncsDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists every Sync matching the given arguments. Filtering and sorting are performed by the Files.com API and all pages of results are returned.",
+		Attributes: map[string]schema.Attribute{
+			"sort_by": schema.MapAttribute{
+				Description: "If set, sort records by the specified field in either `asc` or `desc` direction.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"filter": schema.MapAttribute{
+				Description: "If set, return records where the specified field is equal to the supplied value.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"syncs": schema.ListNestedAttribute{
+				Description: "Syncs matching the given arguments.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: listItemAttributes(dataSourceSchema(ctx, NewSyncDataSource()).Attributes),
+				},
+			},
+		},
+	}
+}
+
+func (r *syncsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data syncsDataSourceModel
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var propDiags diag.Diagnostics
+	paramsSyncList := files_sdk.SyncListParams{}
+	paramsSyncList.SortBy, propDiags = listFilterValue(ctx, path.Root("sort_by"), data.SortBy)
+	resp.Diagnostics.Append(propDiags...)
+	paramsSyncList.Filter, propDiags = listFilterValue(ctx, path.Root("filter"), data.Filter)
+	resp.Diagnostics.Append(propDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	syncIt, err := r.client.List(paramsSyncList, files_sdk.WithContext(ctx))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Listing Files Syncs",
+			"Could not list syncs: "+err.Error(),
+		)
+		return
+	}
+
+	syncs, err := listAll[files_sdk.Sync](syncIt)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Listing Files Syncs",
+			"Could not list syncs: "+err.Error(),
+		)
+		return
+	}
+
+	data.Syncs, diags = listItems(ctx, dataSourceSchema(ctx, NewSyncDataSource()).Attributes, syncs, (&syncDataSource{}).populateDataSourceModel)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, data)
+	resp.Diagnostics.Append(diags...)
+}
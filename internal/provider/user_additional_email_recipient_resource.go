@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 
@@ -24,6 +25,7 @@ var (
 	_ resource.Resource                = &userAdditionalEmailRecipientResource{}
 	_ resource.ResourceWithConfigure   = &userAdditionalEmailRecipientResource{}
 	_ resource.ResourceWithImportState = &userAdditionalEmailRecipientResource{}
+	_ resource.ResourceWithIdentity    = &userAdditionalEmailRecipientResource{}
 )
 
 func NewUserAdditionalEmailRecipientResource() resource.Resource {
@@ -47,18 +49,18 @@ func (r *userAdditionalEmailRecipientResource) Configure(_ context.Context, req
 		return
 	}
 
-	sdk_config, ok := req.ProviderData.(files_sdk.Config)
+	provider_data, ok := req.ProviderData.(providerResourceData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected files_sdk.Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected providerResourceData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.client = &user_additional_email_recipient.Client{Config: sdk_config}
+	r.client = &user_additional_email_recipient.Client{Config: provider_data.Config}
 }
 
 func (r *userAdditionalEmailRecipientResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -101,6 +103,17 @@ func (r *userAdditionalEmailRecipientResource) Schema(_ context.Context, _ resou
 	}
 }
 
+func (r *userAdditionalEmailRecipientResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.Int64Attribute{
+				Description:       "User additional email recipient ID",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
 func (r *userAdditionalEmailRecipientResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan userAdditionalEmailRecipientResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -140,6 +153,12 @@ func (r *userAdditionalEmailRecipientResource) Create(ctx context.Context, req r
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.Identity.SetAttribute(ctx, path.Root("id"), plan.Id)
+	resp.Diagnostics.Append(diags...)
 }
 
 func (r *userAdditionalEmailRecipientResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -175,6 +194,12 @@ func (r *userAdditionalEmailRecipientResource) Read(ctx context.Context, req res
 
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.Identity.SetAttribute(ctx, path.Root("id"), state.Id)
+	resp.Diagnostics.Append(diags...)
 }
 
 func (r *userAdditionalEmailRecipientResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -220,6 +245,12 @@ func (r *userAdditionalEmailRecipientResource) Update(ctx context.Context, req r
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.Identity.SetAttribute(ctx, path.Root("id"), plan.Id)
+	resp.Diagnostics.Append(diags...)
 }
 
 func (r *userAdditionalEmailRecipientResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -243,6 +274,13 @@ func (r *userAdditionalEmailRecipientResource) Delete(ctx context.Context, req r
 }
 
 func (r *userAdditionalEmailRecipientResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID == "" {
+		var id types.Int64
+		resp.Diagnostics.Append(req.Identity.GetAttribute(ctx, path.Root("id"), &id)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+		return
+	}
+
 	idParts := strings.SplitN(req.ID, ",", 1)
 
 	if len(idParts) != 1 || idParts[0] == "" {
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
@@ -28,6 +29,8 @@ var (
 	_ resource.Resource                = &userLifecycleRuleResource{}
 	_ resource.ResourceWithConfigure   = &userLifecycleRuleResource{}
 	_ resource.ResourceWithImportState = &userLifecycleRuleResource{}
+	_ resource.ResourceWithIdentity    = &userLifecycleRuleResource{}
+	_ resource.ResourceWithModifyPlan  = &userLifecycleRuleResource{}
 )
 
 func NewUserLifecycleRuleResource() resource.Resource {
@@ -35,7 +38,8 @@ func NewUserLifecycleRuleResource() resource.Resource {
 }
 
 type userLifecycleRuleResource struct {
-	client *user_lifecycle_rule.Client
+	client             *user_lifecycle_rule.Client
+	defaultWorkspaceId types.Int64
 }
 
 type userLifecycleRuleResourceModel struct {
@@ -61,18 +65,19 @@ func (r *userLifecycleRuleResource) Configure(_ context.Context, req resource.Co
 		return
 	}
 
-	sdk_config, ok := req.ProviderData.(files_sdk.Config)
+	provider_data, ok := req.ProviderData.(providerResourceData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected files_sdk.Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected providerResourceData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.client = &user_lifecycle_rule.Client{Config: sdk_config}
+	r.client = &user_lifecycle_rule.Client{Config: provider_data.Config}
+	r.defaultWorkspaceId = provider_data.DefaultWorkspaceId
 }
 
 func (r *userLifecycleRuleResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -212,6 +217,21 @@ func (r *userLifecycleRuleResource) Schema(_ context.Context, _ resource.SchemaR
 	}
 }
 
+func (r *userLifecycleRuleResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.Int64Attribute{
+				Description:       "User Lifecycle Rule ID",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+func (r *userLifecycleRuleResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyPlanDefaultWorkspaceId(ctx, r.defaultWorkspaceId, req, resp)
+}
+
 func (r *userLifecycleRuleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan userLifecycleRuleResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -273,6 +293,12 @@ func (r *userLifecycleRuleResource) Create(ctx context.Context, req resource.Cre
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.Identity.SetAttribute(ctx, path.Root("id"), plan.Id)
+	resp.Diagnostics.Append(diags...)
 }
 
 func (r *userLifecycleRuleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -308,6 +334,12 @@ func (r *userLifecycleRuleResource) Read(ctx context.Context, req resource.ReadR
 
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.Identity.SetAttribute(ctx, path.Root("id"), state.Id)
+	resp.Diagnostics.Append(diags...)
 }
 
 func (r *userLifecycleRuleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -392,6 +424,12 @@ func (r *userLifecycleRuleResource) Update(ctx context.Context, req resource.Upd
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.Identity.SetAttribute(ctx, path.Root("id"), plan.Id)
+	resp.Diagnostics.Append(diags...)
 }
 
 func (r *userLifecycleRuleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -415,6 +453,13 @@ func (r *userLifecycleRuleResource) Delete(ctx context.Context, req resource.Del
 }
 
 func (r *userLifecycleRuleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID == "" {
+		var id types.Int64
+		resp.Diagnostics.Append(req.Identity.GetAttribute(ctx, path.Root("id"), &id)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+		return
+	}
+
 	idParts := strings.SplitN(req.ID, ",", 1)
 
 	if len(idParts) != 1 || idParts[0] == "" {
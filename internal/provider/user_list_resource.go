@@ -0,0 +1,162 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	files_sdk "github.com/Files-com/files-sdk-go/v3"
+	user "github.com/Files-com/files-sdk-go/v3/user"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ list.ListResource              = &userListResource{}
+	_ list.ListResourceWithConfigure = &userListResource{}
+)
+
+func NewUserListResource() list.ListResource {
+	return &userListResource{}
+}
+
+type userListResource struct {
+	client *user.Client
+}
+
+type userListResourceModel struct {
+	Filter                 types.Map    `tfsdk:"filter"`
+	FilterGt               types.Map    `tfsdk:"filter_gt"`
+	FilterGteq             types.Map    `tfsdk:"filter_gteq"`
+	FilterPrefix           types.Map    `tfsdk:"filter_prefix"`
+	FilterLt               types.Map    `tfsdk:"filter_lt"`
+	FilterLteq             types.Map    `tfsdk:"filter_lteq"`
+	Ids                    types.String `tfsdk:"ids"`
+	IncludeParentSiteUsers types.Bool   `tfsdk:"include_parent_site_users"`
+	Search                 types.String `tfsdk:"search"`
+}
+
+func (r *userListResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	sdk_config, ok := req.ProviderData.(files_sdk.Config)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected List Resource Configure Type",
+			fmt.Sprintf("Expected files_sdk.Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = &user.Client{Config: sdk_config}
+}
+
+func (r *userListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user"
+}
+
+func (r *userListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists every User matching the given arguments so `terraform query` can import them.",
+		Attributes: map[string]schema.Attribute{
+			"filter": schema.MapAttribute{
+				Description: "If set, return records where the specified field is equal to the supplied value.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"filter_gt": schema.MapAttribute{
+				Description: "If set, return records where the specified field is greater than the supplied value.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"filter_gteq": schema.MapAttribute{
+				Description: "If set, return records where the specified field is greater than or equal the supplied value.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"filter_prefix": schema.MapAttribute{
+				Description: "If set, return records where the specified field is prefixed by the supplied value.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"filter_lt": schema.MapAttribute{
+				Description: "If set, return records where the specified field is less than the supplied value.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"filter_lteq": schema.MapAttribute{
+				Description: "If set, return records where the specified field is less than or equal the supplied value.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"ids": schema.StringAttribute{
+				Description: "Comma-separated list of User IDs to include in the results.",
+				Optional:    true,
+			},
+			"include_parent_site_users": schema.BoolAttribute{
+				Description: "If true, include users from the parent site.",
+				Optional:    true,
+			},
+			"search": schema.StringAttribute{
+				Description: "Searches for partial matches of name, username, or email.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func (r *userListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var data userListResourceModel
+	diags := req.Config.Get(ctx, &data)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	var propDiags diag.Diagnostics
+	paramsUserList := files_sdk.UserListParams{}
+	paramsUserList.Filter, propDiags = listFilterValue(ctx, path.Root("filter"), data.Filter)
+	diags.Append(propDiags...)
+	paramsUserList.FilterGt, propDiags = listFilterValue(ctx, path.Root("filter_gt"), data.FilterGt)
+	diags.Append(propDiags...)
+	paramsUserList.FilterGteq, propDiags = listFilterValue(ctx, path.Root("filter_gteq"), data.FilterGteq)
+	diags.Append(propDiags...)
+	paramsUserList.FilterPrefix, propDiags = listFilterValue(ctx, path.Root("filter_prefix"), data.FilterPrefix)
+	diags.Append(propDiags...)
+	paramsUserList.FilterLt, propDiags = listFilterValue(ctx, path.Root("filter_lt"), data.FilterLt)
+	diags.Append(propDiags...)
+	paramsUserList.FilterLteq, propDiags = listFilterValue(ctx, path.Root("filter_lteq"), data.FilterLteq)
+	diags.Append(propDiags...)
+	paramsUserList.Ids = data.Ids.ValueString()
+	if !data.IncludeParentSiteUsers.IsNull() && !data.IncludeParentSiteUsers.IsUnknown() {
+		paramsUserList.IncludeParentSiteUsers = data.IncludeParentSiteUsers.ValueBoolPointer()
+	}
+	paramsUserList.Search = data.Search.ValueString()
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	userIt, err := r.client.List(paramsUserList, files_sdk.WithContext(ctx))
+	if err != nil {
+		diags.AddError(
+			"Error Listing Files Users",
+			"Could not list users: "+err.Error(),
+		)
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	stream.Results = listResults(ctx, req, userIt, "Error Listing Files Users", "Could not list users: ", func(user files_sdk.User, result *list.ListResult) {
+		result.DisplayName = user.Username
+		result.Diagnostics.Append(result.Identity.SetAttribute(ctx, path.Root("id"), user.Id)...)
+	}, (&userResource{}).populateResourceModel)
+}
@@ -0,0 +1,188 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	files_sdk "github.com/Files-com/files-sdk-go/v3"
+	user "github.com/Files-com/files-sdk-go/v3/user"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &usersDataSource{}
+	_ datasource.DataSourceWithConfigure = &usersDataSource{}
+)
+
+func NewUsersDataSource() datasource.DataSource {
+	return &usersDataSource{}
+}
+
+type usersDataSource struct {
+	client *user.Client
+}
+
+type usersDataSourceModel struct {
+	SortBy                 types.Map    `tfsdk:"sort_by"`
+	Filter                 types.Map    `tfsdk:"filter"`
+	FilterGt               types.Map    `tfsdk:"filter_gt"`
+	FilterGteq             types.Map    `tfsdk:"filter_gteq"`
+	FilterPrefix           types.Map    `tfsdk:"filter_prefix"`
+	FilterLt               types.Map    `tfsdk:"filter_lt"`
+	FilterLteq             types.Map    `tfsdk:"filter_lteq"`
+	Ids                    types.String `tfsdk:"ids"`
+	IncludeParentSiteUsers types.Bool   `tfsdk:"include_parent_site_users"`
+	Search                 types.String `tfsdk:"search"`
+	Users                  types.List   `tfsdk:"users"`
+}
+
+func (r *usersDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	sdk_config, ok := req.ProviderData.(files_sdk.Config)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected files_sdk.Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = &user.Client{Config: sdk_config}
+}
+
+func (r *usersDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_users"
+}
+
+func (r *usersDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists every User matching the given arguments. Filtering and sorting are performed by the Files.com API and all pages of results are returned.",
+		Attributes: map[string]schema.Attribute{
+			"sort_by": schema.MapAttribute{
+				Description: "If set, sort records by the specified field in either `asc` or `desc` direction.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"filter": schema.MapAttribute{
+				Description: "If set, return records where the specified field is equal to the supplied value.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"filter_gt": schema.MapAttribute{
+				Description: "If set, return records where the specified field is greater than the supplied value.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"filter_gteq": schema.MapAttribute{
+				Description: "If set, return records where the specified field is greater than or equal the supplied value.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"filter_prefix": schema.MapAttribute{
+				Description: "If set, return records where the specified field is prefixed by the supplied value.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"filter_lt": schema.MapAttribute{
+				Description: "If set, return records where the specified field is less than the supplied value.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"filter_lteq": schema.MapAttribute{
+				Description: "If set, return records where the specified field is less than or equal the supplied value.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"ids": schema.StringAttribute{
+				Description: "Comma-separated list of User IDs to include in the results.",
+				Optional:    true,
+			},
+			"include_parent_site_users": schema.BoolAttribute{
+				Description: "If true, include users from the parent site.",
+				Optional:    true,
+			},
+			"search": schema.StringAttribute{
+				Description: "Searches for partial matches of name, username, or email.",
+				Optional:    true,
+			},
+			"users": schema.ListNestedAttribute{
+				Description: "Users matching the given arguments.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: listItemAttributes(dataSourceSchema(ctx, NewUserDataSource()).Attributes),
+				},
+			},
+		},
+	}
+}
+
+func (r *usersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data usersDataSourceModel
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var propDiags diag.Diagnostics
+	paramsUserList := files_sdk.UserListParams{}
+	paramsUserList.SortBy, propDiags = listFilterValue(ctx, path.Root("sort_by"), data.SortBy)
+	resp.Diagnostics.Append(propDiags...)
+	paramsUserList.Filter, propDiags = listFilterValue(ctx, path.Root("filter"), data.Filter)
+	resp.Diagnostics.Append(propDiags...)
+	paramsUserList.FilterGt, propDiags = listFilterValue(ctx, path.Root("filter_gt"), data.FilterGt)
+	resp.Diagnostics.Append(propDiags...)
+	paramsUserList.FilterGteq, propDiags = listFilterValue(ctx, path.Root("filter_gteq"), data.FilterGteq)
+	resp.Diagnostics.Append(propDiags...)
+	paramsUserList.FilterPrefix, propDiags = listFilterValue(ctx, path.Root("filter_prefix"), data.FilterPrefix)
+	resp.Diagnostics.Append(propDiags...)
+	paramsUserList.FilterLt, propDiags = listFilterValue(ctx, path.Root("filter_lt"), data.FilterLt)
+	resp.Diagnostics.Append(propDiags...)
+	paramsUserList.FilterLteq, propDiags = listFilterValue(ctx, path.Root("filter_lteq"), data.FilterLteq)
+	resp.Diagnostics.Append(propDiags...)
+	paramsUserList.Ids = data.Ids.ValueString()
+	if !data.IncludeParentSiteUsers.IsNull() && !data.IncludeParentSiteUsers.IsUnknown() {
+		paramsUserList.IncludeParentSiteUsers = data.IncludeParentSiteUsers.ValueBoolPointer()
+	}
+	paramsUserList.Search = data.Search.ValueString()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	userIt, err := r.client.List(paramsUserList, files_sdk.WithContext(ctx))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Listing Files Users",
+			"Could not list users: "+err.Error(),
+		)
+		return
+	}
+
+	users, err := listAll[files_sdk.User](userIt)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Listing Files Users",
+			"Could not list users: "+err.Error(),
+		)
+		return
+	}
+
+	data.Users, diags = listItems(ctx, dataSourceSchema(ctx, NewUserDataSource()).Attributes, users, (&userDataSource{}).populateDataSourceModel)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, data)
+	resp.Diagnostics.Append(diags...)
+}
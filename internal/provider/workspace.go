@@ -0,0 +1,45 @@
+package provider
+
+import (
+	"context"
+
+	files_sdk "github.com/Files-com/files-sdk-go/v3"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// providerResourceData is passed to every resource's Configure. It carries
+// the provider settings that only apply to resources alongside the SDK config.
+type providerResourceData struct {
+	files_sdk.Config
+	// DefaultWorkspaceId is null unless default_workspace_id is set.
+	DefaultWorkspaceId types.Int64
+}
+
+// modifyPlanDefaultWorkspaceId plans defaultWorkspaceId for a resource that is
+// being created without a workspace_id. Existing resources keep the workspace
+// they are in, so setting or changing the default never moves or replaces
+// them, and resources that set apply_to_all_workspaces are left alone.
+func modifyPlanDefaultWorkspaceId(ctx context.Context, defaultWorkspaceId types.Int64, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if defaultWorkspaceId.IsNull() || req.Plan.Raw.IsNull() || !req.State.Raw.IsNull() {
+		return
+	}
+
+	var workspaceId types.Int64
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("workspace_id"), &workspaceId)...)
+	if resp.Diagnostics.HasError() || !workspaceId.IsNull() {
+		return
+	}
+
+	if _, diags := req.Config.Schema.AttributeAtPath(ctx, path.Root("apply_to_all_workspaces")); !diags.HasError() {
+		var applyToAllWorkspaces types.Bool
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("apply_to_all_workspaces"), &applyToAllWorkspaces)...)
+		if resp.Diagnostics.HasError() || applyToAllWorkspaces.IsUnknown() || applyToAllWorkspaces.ValueBool() {
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("workspace_id"), defaultWorkspaceId)...)
+}
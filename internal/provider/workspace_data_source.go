@@ -7,15 +7,18 @@ import (
 	files_sdk "github.com/Files-com/files-sdk-go/v3"
 	workspace "github.com/Files-com/files-sdk-go/v3/workspace"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 var (
-	_ datasource.DataSource              = &workspaceDataSource{}
-	_ datasource.DataSourceWithConfigure = &workspaceDataSource{}
+	_ datasource.DataSource                     = &workspaceDataSource{}
+	_ datasource.DataSourceWithConfigure        = &workspaceDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &workspaceDataSource{}
 )
 
 func NewWorkspaceDataSource() datasource.DataSource {
@@ -56,20 +59,31 @@ func (r *workspaceDataSource) Metadata(_ context.Context, req datasource.Metadat
 
 func (r *workspaceDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Description: "A Workspace is a lightweight way to organize related resources inside a single Files.com Site.\n\n\n\nCustomers commonly group resources by project, department, client, or region. Workspaces provide a built-in structure for that grouping, so the UI can operate within a clear “workspace context” and admins can delegate management for a subset of resources without requiring full site-level isolation.\n\n\n\nEvery Site has an implicit Default workspace (ID 0). Resources that are not explicitly assigned to a named workspace are considered part of the Default workspace.",
+		Description: "A Workspace is a lightweight way to organize related resources inside a single Files.com Site.\n\n\n\nCustomers commonly group resources by project, department, client, or region. Workspaces provide a built-in structure for that grouping, so the UI can operate within a clear “workspace context” and admins can delegate management for a subset of resources without requiring full site-level isolation.\n\n\n\nEvery Site has an implicit Default workspace (ID 0). Resources that are not explicitly assigned to a named workspace are considered part of the Default workspace.\n\nLook up a workspace either by `id` or by `name`. A `name` lookup fails if no workspace, or more than one workspace, has that name.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.Int64Attribute{
 				Description: "Workspace ID",
-				Required:    true,
+				Optional:    true,
+				Computed:    true,
 			},
 			"name": schema.StringAttribute{
 				Description: "Workspace name",
+				Optional:    true,
 				Computed:    true,
 			},
 		},
 	}
 }
 
+func (r *workspaceDataSource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("id"),
+			path.MatchRoot("name"),
+		),
+	}
+}
+
 func (r *workspaceDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	var data workspaceDataSourceModel
 	diags := req.Config.Get(ctx, &data)
@@ -78,6 +92,30 @@ func (r *workspaceDataSource) Read(ctx context.Context, req datasource.ReadReque
 		return
 	}
 
+	if data.Id.IsNull() {
+		paramsWorkspaceList := files_sdk.WorkspaceListParams{}
+		paramsWorkspaceList.Filter = map[string]interface{}{"name": data.Name.ValueString()}
+
+		workspaceIt, err := r.client.List(paramsWorkspaceList, files_sdk.WithContext(ctx))
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Reading Files Workspace",
+				"Could not list workspaces: "+err.Error(),
+			)
+			return
+		}
+
+		match, diags := lookupByKey(workspaceIt, "Error Reading Files Workspace", "workspace", "name", data.Name.ValueString(),
+			func(w files_sdk.Workspace) string { return w.Name },
+			func(w files_sdk.Workspace) int64 { return w.Id },
+		)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		data.Id = types.Int64Value(match.Id)
+	}
+
 	paramsWorkspaceFind := files_sdk.WorkspaceFindParams{}
 	paramsWorkspaceFind.Id = data.Id.ValueInt64()
 
@@ -2,6 +2,7 @@ package lib
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"maps"
 	"math"
@@ -187,6 +188,10 @@ func attributeToInterface(ctx context.Context, path path.Path, source attr.Value
 		dest, diags = elementsToInterfaces(ctx, path, actualValue.Elements(), false)
 	case types.Dynamic:
 		dest, diags = attributeToInterface(ctx, path, actualValue.UnderlyingValue(), false)
+	case basetypes.StringValuable:
+		tflog.Info(ctx, "Converting custom StringValue to string")
+		stringValue, valueDiags := actualValue.ToStringValue(ctx)
+		dest, diags = stringValue.ValueString(), valueDiags
 	default:
 		diags.AddAttributeError(
 			path,
@@ -223,38 +228,63 @@ func UnwrapDiscriminatedUnionAtPath(ctx context.Context, attributePath path.Path
 		}
 		result := make([]interface{}, 0, len(items))
 		for index, item := range items {
-			itemPath := valuePath.AtListIndex(index)
-			wrapper, ok := item.(map[string]interface{})
-			if !ok {
-				return jsonSchemaTransformError(itemPath, "expected an object")
-			}
-			var selected *JSONSchemaVariant
-			var inner map[string]interface{}
-			for variantIndex := range variants {
-				candidate, exists := wrapper[variants[variantIndex].Name]
-				if !exists || candidate == nil {
-					continue
-				}
-				if selected != nil {
-					return jsonSchemaTransformError(itemPath, "expected exactly one variant")
-				}
-				selected = &variants[variantIndex]
-				inner, ok = candidate.(map[string]interface{})
-				if !ok {
-					return jsonSchemaTransformError(itemPath.AtName(variants[variantIndex].Name), "expected an object")
-				}
-			}
-			if selected == nil {
-				return jsonSchemaTransformError(itemPath, "expected exactly one variant")
+			variant, inner, diags := unwrapDiscriminatedUnionItem(valuePath.AtListIndex(index), item, variants)
+			if diags.HasError() {
+				return nil, diags
 			}
 			unwrapped := maps.Clone(inner)
-			unwrapped[discriminator] = selected.Value
+			unwrapped[discriminator] = variant.Value
 			result = append(result, unwrapped)
 		}
 		return result, nil
 	})
 }
 
+// UnwrapDiscriminatedUnion unwraps a single object whose discriminator is
+// kept outside of it, such as in a sibling attribute. It returns the
+// discriminator value of the variant that is set, along with its fields.
+func UnwrapDiscriminatedUnion(ctx context.Context, attributePath path.Path, source any, variants []JSONSchemaVariant) (string, any, diag.Diagnostics) {
+	if source == nil {
+		return "", nil, nil
+	}
+	variant, inner, diags := unwrapDiscriminatedUnionItem(attributePath, source, variants)
+	if diags.HasError() {
+		return "", nil, diags
+	}
+	return variant.Value, maps.Clone(inner), nil
+}
+
+func unwrapDiscriminatedUnionItem(itemPath path.Path, item any, variants []JSONSchemaVariant) (JSONSchemaVariant, map[string]interface{}, diag.Diagnostics) {
+	wrapper, ok := item.(map[string]interface{})
+	if !ok {
+		_, diags := jsonSchemaTransformError(itemPath, "expected an object")
+		return JSONSchemaVariant{}, nil, diags
+	}
+	var selected *JSONSchemaVariant
+	var inner map[string]interface{}
+	for variantIndex := range variants {
+		candidate, exists := wrapper[variants[variantIndex].Name]
+		if !exists || candidate == nil {
+			continue
+		}
+		if selected != nil {
+			_, diags := jsonSchemaTransformError(itemPath, "expected exactly one variant")
+			return JSONSchemaVariant{}, nil, diags
+		}
+		selected = &variants[variantIndex]
+		inner, ok = candidate.(map[string]interface{})
+		if !ok {
+			_, diags := jsonSchemaTransformError(itemPath.AtName(variants[variantIndex].Name), "expected an object")
+			return JSONSchemaVariant{}, nil, diags
+		}
+	}
+	if selected == nil {
+		_, diags := jsonSchemaTransformError(itemPath, "expected exactly one variant")
+		return JSONSchemaVariant{}, nil, diags
+	}
+	return *selected, inner, nil
+}
+
 func WrapDiscriminatedUnionAtPath(ctx context.Context, attributePath path.Path, source any, names []string, discriminator string, variants []JSONSchemaVariant) (any, diag.Diagnostics) {
 	return transformJSONSchemaAtPath(attributePath, source, names, func(valuePath path.Path, value any) (any, diag.Diagnostics) {
 		items, ok := value.([]interface{})
@@ -272,18 +302,39 @@ func WrapDiscriminatedUnionAtPath(ctx context.Context, attributePath path.Path,
 			if !ok {
 				return jsonSchemaTransformError(itemPath.AtName(discriminator), "expected a string discriminator")
 			}
-			variant, ok := findJSONSchemaVariant(variants, variantValue)
-			if !ok {
-				return jsonSchemaTransformError(itemPath.AtName(discriminator), "unknown variant "+variantValue)
-			}
 			wrapped := maps.Clone(object)
 			delete(wrapped, discriminator)
-			result = append(result, map[string]interface{}{variant.Name: wrapped})
+			item, diags := wrapDiscriminatedUnionItem(itemPath.AtName(discriminator), wrapped, variantValue, variants)
+			if diags.HasError() {
+				return nil, diags
+			}
+			result = append(result, item)
 		}
 		return result, nil
 	})
 }
 
+// WrapDiscriminatedUnion is the reverse of UnwrapDiscriminatedUnion. It nests
+// the fields in source under the name of the variant for value.
+func WrapDiscriminatedUnion(ctx context.Context, attributePath path.Path, source any, value string, variants []JSONSchemaVariant) (any, diag.Diagnostics) {
+	if source == nil {
+		return nil, nil
+	}
+	object, ok := source.(map[string]interface{})
+	if !ok {
+		return jsonSchemaTransformError(attributePath, "expected an object")
+	}
+	return wrapDiscriminatedUnionItem(attributePath, maps.Clone(object), value, variants)
+}
+
+func wrapDiscriminatedUnionItem(discriminatorPath path.Path, object map[string]interface{}, value string, variants []JSONSchemaVariant) (any, diag.Diagnostics) {
+	variant, ok := findJSONSchemaVariant(variants, value)
+	if !ok {
+		return jsonSchemaTransformError(discriminatorPath, "unknown variant "+value)
+	}
+	return map[string]interface{}{variant.Name: object}, nil
+}
+
 func UngroupStructuralUnionAtPath(ctx context.Context, attributePath path.Path, source any, names []string, variants []JSONSchemaVariant) (any, diag.Diagnostics) {
 	return transformJSONSchemaAtPath(attributePath, source, names, func(valuePath path.Path, value any) (any, diag.Diagnostics) {
 		entries, ok := value.(map[string]interface{})
@@ -491,6 +542,12 @@ func schemaValue(ctx context.Context, path path.Path, source any, target attr.Ty
 			return schemaConversionError(path, target, source)
 		}
 		return types.StringValue(value), nil
+	case basetypes.StringTypable:
+		value, ok := source.(string)
+		if !ok {
+			return schemaConversionError(path, target, source)
+		}
+		return targetType.ValueFromString(ctx, types.StringValue(value))
 	case basetypes.BoolType:
 		value, ok := source.(bool)
 		if !ok {
@@ -544,6 +601,9 @@ func nullSchemaValue(target attr.Type) attr.Value {
 		return types.Float64Null()
 	case basetypes.DynamicType:
 		return types.DynamicNull()
+	case basetypes.StringTypable:
+		value, _ := targetType.ValueFromString(context.Background(), types.StringNull())
+		return value
 	}
 	return nil
 }
@@ -630,6 +690,18 @@ func ToDynamic(ctx context.Context, path path.Path, source any, plan attr.Value)
 	case nil:
 		tflog.Info(ctx, "Skipping nil value")
 	default:
+		// SDK structs and typed slices, such as []files_sdk.BundlePath, are
+		// converted through their JSON form.
+		var generic any
+		data, err := json.Marshal(source)
+		if err == nil {
+			err = json.Unmarshal(data, &generic)
+		}
+		if err == nil {
+			tflog.Info(ctx, "Converting "+fmt.Sprintf("%T", source)+" through JSON")
+			return ToDynamic(ctx, path, generic, plan)
+		}
+
 		diags.AddError(
 			"Failed to convert Element",
 			"Unhandled type for "+path.String()+": "+fmt.Sprintf("%T", source),
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestTimeToStringType(t *testing.T) {
@@ -163,13 +164,34 @@ func TestNullScalarConversion(t *testing.T) {
 	assert.Nil(t, value)
 }
 
+func TestToDynamicTypedSlice(t *testing.T) {
+	type bundlePath struct {
+		Recursive bool   `json:"recursive"`
+		Path      string `json:"path"`
+	}
+
+	value, diags := ToDynamic(context.Background(), path.Root("bundlepaths"), []bundlePath{{Recursive: true, Path: "Reports"}}, nil)
+	assert.False(t, diags.HasError())
+	tuple, ok := value.UnderlyingValue().(types.Tuple)
+	require.True(t, ok)
+	require.Len(t, tuple.Elements(), 1)
+	object := tuple.Elements()[0].(types.Dynamic).UnderlyingValue().(types.Object)
+	assert.Equal(t, types.DynamicValue(types.StringValue("Reports")), object.Attributes()["path"])
+	assert.Equal(t, types.DynamicValue(types.BoolValue(true)), object.Attributes()["recursive"])
+
+	value, diags = ToDynamic(context.Background(), path.Root("bundlepaths"), []bundlePath(nil), nil)
+	assert.False(t, diags.HasError())
+	assert.True(t, value.IsNull())
+}
+
 func TestSchemaObjectRoundTrip(t *testing.T) {
-	stepTypes := map[string]attr.Type{"path": types.StringType, "delay": types.Int64Type}
+	stepTypes := map[string]attr.Type{"path": NormalizedPathType{}, "delay": types.Int64Type}
 	definitionTypes := map[string]attr.Type{
 		"version":  types.Int64Type,
 		"labels":   types.MapType{ElemType: types.StringType},
 		"steps":    types.ListType{ElemType: types.ObjectType{AttrTypes: stepTypes}},
 		"optional": types.StringType,
+		"archive":  NormalizedPathType{},
 	}
 	source := map[string]interface{}{
 		"version": 1,
@@ -179,6 +201,8 @@ func TestSchemaObjectRoundTrip(t *testing.T) {
 
 	value, diags := ToObject(context.Background(), path.Root("definition"), source, types.ObjectNull(definitionTypes))
 	assert.False(t, diags.HasError())
+	assert.Equal(t, NormalizedPathValue("incoming/"), value.Attributes()["steps"].(types.List).Elements()[0].(types.Object).Attributes()["path"])
+	assert.Equal(t, NormalizedPath{types.StringNull()}, value.Attributes()["archive"])
 	result, diags := SchemaAttributeToInterface(context.Background(), path.Root("definition"), value)
 	assert.False(t, diags.HasError())
 	assert.Equal(t, map[string]interface{}{
@@ -218,6 +242,31 @@ func TestDiscriminatedUnionRoundTrip(t *testing.T) {
 	assert.True(t, diags.HasError())
 }
 
+func TestDiscriminatedUnionObjectRoundTrip(t *testing.T) {
+	variants := []JSONSchemaVariant{{Name: "webhook", Value: "webhook"}, {Name: "google_pubsub", Value: "google_pubsub"}}
+	apiValue := map[string]interface{}{"url": "https://example.com/hook"}
+
+	terraformValue, diags := WrapDiscriminatedUnion(context.Background(), path.Root("config"), apiValue, "webhook", variants)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, map[string]interface{}{"webhook": map[string]interface{}{"url": "https://example.com/hook"}}, terraformValue)
+
+	value, result, diags := UnwrapDiscriminatedUnion(context.Background(), path.Root("config"), terraformValue, variants)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, "webhook", value)
+	assert.Equal(t, apiValue, result)
+
+	value, result, diags = UnwrapDiscriminatedUnion(context.Background(), path.Root("config"), nil, variants)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, "", value)
+	assert.Nil(t, result)
+
+	_, diags = WrapDiscriminatedUnion(context.Background(), path.Root("config"), apiValue, "email", variants)
+	assert.True(t, diags.HasError())
+
+	_, _, diags = UnwrapDiscriminatedUnion(context.Background(), path.Root("config"), map[string]interface{}{"webhook": map[string]interface{}{}, "google_pubsub": map[string]interface{}{}}, variants)
+	assert.True(t, diags.HasError())
+}
+
 func TestStructuralUnionRoundTrip(t *testing.T) {
 	variants := []JSONSchemaVariant{
 		{Name: "calculated_rules", Required: []string{"function"}, Allowed: []string{"name", "function"}},
@@ -0,0 +1,107 @@
+package lib
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+const DefaultProfile = "default"
+
+// ErrProfileNotFound is returned by ReadProfile when the credentials file has
+// no section for the requested profile.
+var ErrProfileNotFound = errors.New("profile not found")
+
+// Profile holds the settings read from one section of the credentials file.
+type Profile struct {
+	APIKey           string
+	EndpointOverride string
+	Environment      string
+}
+
+// DefaultCredentialsFile returns the path of the shared credentials file,
+// $XDG_CONFIG_HOME/files/credentials, or ~/.config/files/credentials when
+// XDG_CONFIG_HOME is not set.
+func DefaultCredentialsFile() (string, error) {
+	if dir := os.Getenv("XDG_CONFIG_HOME"); dir != "" {
+		return filepath.Join(dir, "files", "credentials"), nil
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, ".config", "files", "credentials"), nil
+}
+
+// ReadProfile reads the named profile from an INI style credentials file:
+//
+//	[default]
+//	api_key = YOUR_API_KEY
+//	endpoint_override = https://SUBDOMAIN.files.com
+//
+// Lines starting with # or ; are comments. Unknown keys are rejected so that
+// a typo does not silently fall back to another source.
+func ReadProfile(filename, name string) (Profile, error) {
+	file, err := os.Open(filename)
+	if err != nil {
+		return Profile{}, err
+	}
+	defer file.Close()
+
+	var profile Profile
+	found := false
+	section := ""
+	scanner := bufio.NewScanner(file)
+	for lineNum := 1; scanner.Scan(); lineNum++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, ";") {
+			continue
+		}
+		if strings.HasPrefix(line, "[") {
+			if !strings.HasSuffix(line, "]") {
+				return Profile{}, fmt.Errorf("%s:%d: invalid section header %q", filename, lineNum, line)
+			}
+			section = strings.TrimSpace(line[1 : len(line)-1])
+			if section == name {
+				found = true
+			}
+			continue
+		}
+
+		key, value, ok := strings.Cut(line, "=")
+		if !ok {
+			return Profile{}, fmt.Errorf("%s:%d: expected key = value", filename, lineNum)
+		}
+		key = strings.TrimSpace(key)
+		value = strings.Trim(strings.TrimSpace(value), `"`)
+		if section == "" {
+			return Profile{}, fmt.Errorf("%s:%d: %q must be inside a [profile] section", filename, lineNum, key)
+		}
+
+		var field *string
+		switch key {
+		case "api_key":
+			field = &profile.APIKey
+		case "endpoint_override":
+			field = &profile.EndpointOverride
+		case "environment":
+			field = &profile.Environment
+		default:
+			return Profile{}, fmt.Errorf("%s:%d: unknown key %q", filename, lineNum, key)
+		}
+		if section == name {
+			*field = value
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return Profile{}, err
+	}
+
+	if !found {
+		return Profile{}, fmt.Errorf("%w: %q in %s", ErrProfileNotFound, name, filename)
+	}
+	return profile, nil
+}
@@ -0,0 +1,64 @@
+package lib
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeCredentials(t *testing.T, content string) string {
+	filename := filepath.Join(t.TempDir(), "credentials")
+	require.NoError(t, os.WriteFile(filename, []byte(content), 0o600))
+	return filename
+}
+
+func TestReadProfile(t *testing.T) {
+	filename := writeCredentials(t, `
+# Shared Files.com credentials
+[default]
+api_key = default-key
+
+[staging]
+api_key           = "staging-key"
+endpoint_override = https://staging.files.com
+; environment is optional
+environment       = staging
+`)
+
+	profile, err := ReadProfile(filename, "default")
+	require.NoError(t, err)
+	assert.Equal(t, Profile{APIKey: "default-key"}, profile)
+
+	profile, err = ReadProfile(filename, "staging")
+	require.NoError(t, err)
+	assert.Equal(t, Profile{APIKey: "staging-key", EndpointOverride: "https://staging.files.com", Environment: "staging"}, profile)
+
+	_, err = ReadProfile(filename, "production")
+	assert.ErrorIs(t, err, ErrProfileNotFound)
+}
+
+func TestReadProfileInvalid(t *testing.T) {
+	tests := map[string]string{
+		"unknown key":       "[default]\napi_token = abc\n",
+		"missing section":   "api_key = abc\n",
+		"missing separator": "[default]\napi_key\n",
+		"unclosed section":  "[default\napi_key = abc\n",
+	}
+	for name, content := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := ReadProfile(writeCredentials(t, content), "default")
+			assert.Error(t, err)
+			assert.NotErrorIs(t, err, ErrProfileNotFound)
+		})
+	}
+}
+
+func TestDefaultCredentialsFile(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", "/etc/xdg")
+	filename, err := DefaultCredentialsFile()
+	require.NoError(t, err)
+	assert.Equal(t, "/etc/xdg/files/credentials", filename)
+}
@@ -0,0 +1,266 @@
+package lib
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// JSONSchema describes the expected shape of a free-form JSON value, such as
+// a dynamic attribute that the API accepts as raw JSON.
+type JSONSchema struct {
+	// Type is one of "object", "array", "string", "number" or "boolean". An
+	// empty Type accepts any value.
+	Type string
+	// Properties lists the keys an object may have. A nil map accepts any key.
+	Properties map[string]JSONSchema
+	// Items describes every element of an array.
+	Items *JSONSchema
+	// Enum lists the values a string may have.
+	Enum []string
+	// OneOf lists alternative schemas, chosen by the type of the value.
+	OneOf []JSONSchema
+}
+
+// unknownJSONSchemaValue stands in for a value that is not known until apply,
+// which can't be validated yet.
+type unknownJSONSchemaValue struct{}
+
+// ValidateJSONSchema checks a configured value against schema. The value may
+// also be a JSON-encoded string of a non-string value, and a string holding a
+// number is accepted where a number is expected, as Terraform turns numbers
+// into strings in maps of mixed values. Each diagnostic has the given summary
+// and points at the offending key or element. Null and unknown values are not
+// validated.
+func ValidateJSONSchema(ctx context.Context, attributePath path.Path, source attr.Value, schema JSONSchema, summary string) (diags diag.Diagnostics) {
+	value := jsonSchemaValueOf(source)
+	if value == nil {
+		return
+	}
+
+	if encoded, ok := value.(string); ok && !jsonSchemaAcceptsType(schema, "string") {
+		var decoded interface{}
+		if err := json.Unmarshal([]byte(encoded), &decoded); err != nil {
+			diags.AddAttributeError(
+				attributePath,
+				summary,
+				fmt.Sprintf("Expected %s, or a JSON-encoded string of one: %s", jsonSchemaDescription(schema), err),
+			)
+			return
+		}
+		value = decoded
+	}
+
+	validateJSONSchemaValue(attributePath, value, schema, summary, &diags)
+	return
+}
+
+func validateJSONSchemaValue(attributePath path.Path, value interface{}, schema JSONSchema, summary string, diags *diag.Diagnostics) {
+	if value == nil {
+		return
+	}
+	if _, ok := value.(unknownJSONSchemaValue); ok {
+		return
+	}
+
+	valueType := jsonSchemaTypeOf(value)
+	if valueType == "string" && !jsonSchemaAcceptsType(schema, "string") && jsonSchemaAcceptsType(schema, "number") {
+		if _, err := strconv.ParseFloat(value.(string), 64); err == nil {
+			return
+		}
+	}
+	if len(schema.OneOf) > 0 {
+		for _, variant := range schema.OneOf {
+			if variant.Type == "" || variant.Type == valueType {
+				validateJSONSchemaValue(attributePath, value, variant, summary, diags)
+				return
+			}
+		}
+		jsonSchemaTypeError(attributePath, schema, valueType, summary, diags)
+		return
+	}
+	if schema.Type == "" {
+		return
+	}
+	if schema.Type != valueType {
+		jsonSchemaTypeError(attributePath, schema, valueType, summary, diags)
+		return
+	}
+
+	switch typedValue := value.(type) {
+	case map[string]interface{}:
+		if schema.Properties == nil {
+			return
+		}
+		keys := make([]string, 0, len(typedValue))
+		for key := range typedValue {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			property, ok := schema.Properties[key]
+			if !ok {
+				diags.AddAttributeError(
+					attributePath.AtName(key),
+					summary,
+					fmt.Sprintf("Unexpected key %q. Expected one of: %s.", key, strings.Join(jsonSchemaPropertyNames(schema), ", ")),
+				)
+				continue
+			}
+			validateJSONSchemaValue(attributePath.AtName(key), typedValue[key], property, summary, diags)
+		}
+	case []interface{}:
+		if schema.Items == nil {
+			return
+		}
+		for index, item := range typedValue {
+			validateJSONSchemaValue(attributePath.AtListIndex(index), item, *schema.Items, summary, diags)
+		}
+	case string:
+		if len(schema.Enum) > 0 && !slices.Contains(schema.Enum, typedValue) {
+			diags.AddAttributeError(
+				attributePath,
+				summary,
+				fmt.Sprintf("Expected one of %q, got %q.", schema.Enum, typedValue),
+			)
+		}
+	}
+}
+
+func jsonSchemaTypeError(attributePath path.Path, schema JSONSchema, valueType string, summary string, diags *diag.Diagnostics) {
+	diags.AddAttributeError(
+		attributePath,
+		summary,
+		fmt.Sprintf("Expected %s, got %s.", jsonSchemaDescription(schema), jsonSchemaArticle(valueType)),
+	)
+}
+
+func jsonSchemaAcceptsType(schema JSONSchema, valueType string) bool {
+	if len(schema.OneOf) == 0 {
+		return schema.Type == "" || schema.Type == valueType
+	}
+	for _, variant := range schema.OneOf {
+		if jsonSchemaAcceptsType(variant, valueType) {
+			return true
+		}
+	}
+	return false
+}
+
+func jsonSchemaDescription(schema JSONSchema) string {
+	if len(schema.OneOf) == 0 {
+		if schema.Type == "" {
+			return "any value"
+		}
+		return jsonSchemaArticle(schema.Type)
+	}
+	descriptions := make([]string, 0, len(schema.OneOf))
+	for _, variant := range schema.OneOf {
+		descriptions = append(descriptions, jsonSchemaDescription(variant))
+	}
+	return strings.Join(descriptions, " or ")
+}
+
+func jsonSchemaArticle(valueType string) string {
+	if valueType == "array" || valueType == "object" {
+		return "an " + valueType
+	}
+	return "a " + valueType
+}
+
+func jsonSchemaPropertyNames(schema JSONSchema) []string {
+	names := make([]string, 0, len(schema.Properties))
+	for name := range schema.Properties {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func jsonSchemaTypeOf(value interface{}) string {
+	switch value.(type) {
+	case map[string]interface{}:
+		return "object"
+	case []interface{}:
+		return "array"
+	case string:
+		return "string"
+	case float64:
+		return "number"
+	case bool:
+		return "boolean"
+	}
+	return fmt.Sprintf("%T", value)
+}
+
+// jsonSchemaValueOf converts a configured value to the same shapes that
+// encoding/json decodes to, so both can be validated alike.
+func jsonSchemaValueOf(source attr.Value) interface{} {
+	if source == nil || source.IsNull() {
+		return nil
+	}
+	if source.IsUnknown() {
+		return unknownJSONSchemaValue{}
+	}
+
+	switch value := source.(type) {
+	case basetypes.DynamicValue:
+		if value.IsUnderlyingValueNull() {
+			return nil
+		}
+		if value.IsUnderlyingValueUnknown() {
+			return unknownJSONSchemaValue{}
+		}
+		return jsonSchemaValueOf(value.UnderlyingValue())
+	case basetypes.ObjectValue:
+		return jsonSchemaObjectOf(value.Attributes())
+	case basetypes.MapValue:
+		return jsonSchemaObjectOf(value.Elements())
+	case basetypes.TupleValue:
+		return jsonSchemaArrayOf(value.Elements())
+	case basetypes.ListValue:
+		return jsonSchemaArrayOf(value.Elements())
+	case basetypes.SetValue:
+		return jsonSchemaArrayOf(value.Elements())
+	case basetypes.StringValue:
+		return value.ValueString()
+	case basetypes.NumberValue:
+		number, _ := value.ValueBigFloat().Float64()
+		return number
+	case basetypes.Int64Value:
+		return float64(value.ValueInt64())
+	case basetypes.Float64Value:
+		return value.ValueFloat64()
+	case basetypes.BoolValue:
+		return value.ValueBool()
+	case basetypes.StringValuable:
+		stringValue, _ := value.ToStringValue(context.Background())
+		return stringValue.ValueString()
+	}
+	return unknownJSONSchemaValue{}
+}
+
+func jsonSchemaObjectOf(attributes map[string]attr.Value) map[string]interface{} {
+	object := make(map[string]interface{}, len(attributes))
+	for key, value := range attributes {
+		object[key] = jsonSchemaValueOf(value)
+	}
+	return object
+}
+
+func jsonSchemaArrayOf(elements []attr.Value) []interface{} {
+	array := make([]interface{}, 0, len(elements))
+	for _, element := range elements {
+		array = append(array, jsonSchemaValueOf(element))
+	}
+	return array
+}
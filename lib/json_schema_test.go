@@ -0,0 +1,75 @@
+package lib
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateJSONSchema(t *testing.T) {
+	schema := JSONSchema{OneOf: []JSONSchema{
+		{Type: "number"},
+		{Type: "object", Properties: map[string]JSONSchema{
+			"days":     {Type: "number"},
+			"triggers": {Type: "array", Items: &JSONSchema{Type: "string", Enum: []string{"create", "destroy"}}},
+			"headers":  {Type: "object"},
+		}},
+	}}
+	object := func(attributes map[string]attr.Value) types.Dynamic {
+		attributeTypes := map[string]attr.Type{}
+		for name, value := range attributes {
+			attributeTypes[name] = value.Type(context.Background())
+		}
+		return types.DynamicValue(types.ObjectValueMust(attributeTypes, attributes))
+	}
+	triggers := func(values ...string) attr.Value {
+		elements := []attr.Value{}
+		elementTypes := []attr.Type{}
+		for _, value := range values {
+			elements = append(elements, types.StringValue(value))
+			elementTypes = append(elementTypes, types.StringType)
+		}
+		return types.TupleValueMust(elementTypes, elements)
+	}
+
+	tests := []struct {
+		name  string
+		value types.Dynamic
+		path  path.Path
+	}{
+		{name: "number", value: types.DynamicValue(types.NumberValue(big.NewFloat(14)))},
+		{name: "null", value: types.DynamicNull()},
+		{name: "unknown", value: types.DynamicUnknown()},
+		{name: "object", value: object(map[string]attr.Value{"days": types.Int64Value(14), "triggers": triggers("create"), "headers": object(map[string]attr.Value{"X-Any": types.StringValue("1")})})},
+		{name: "null key", value: object(map[string]attr.Value{"days": types.StringNull()})},
+		{name: "unknown key", value: object(map[string]attr.Value{"days": types.StringUnknown()})},
+		{name: "json", value: types.DynamicValue(types.StringValue(`{"days": 14, "triggers": ["destroy"]}`))},
+		{name: "wrong type", value: types.DynamicValue(types.BoolValue(true)), path: path.Root("value")},
+		{name: "unexpected key", value: object(map[string]attr.Value{"day": types.Int64Value(14)}), path: path.Root("value").AtName("day")},
+		{name: "numeric string key", value: object(map[string]attr.Value{"days": types.StringValue("14")})},
+		{name: "json numeric string key", value: types.DynamicValue(types.StringValue(`{"days": "14"}`))},
+		{name: "wrong key type", value: object(map[string]attr.Value{"days": types.StringValue("two weeks")}), path: path.Root("value").AtName("days")},
+		{name: "wrong enum", value: object(map[string]attr.Value{"triggers": triggers("create", "delete")}), path: path.Root("value").AtName("triggers").AtListIndex(1)},
+		{name: "invalid json", value: types.DynamicValue(types.StringValue(`{"days":`)), path: path.Root("value")},
+		{name: "json unexpected key", value: types.DynamicValue(types.StringValue(`{"day": 14}`)), path: path.Root("value").AtName("day")},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			diags := ValidateJSONSchema(context.Background(), path.Root("value"), test.value, schema, "Invalid Value")
+			if len(test.path.Steps()) == 0 {
+				assert.False(t, diags.HasError(), diags)
+				return
+			}
+			assert.Len(t, diags, 1)
+			assert.Equal(t, "Invalid Value", diags[0].Summary())
+			assert.Equal(t, test.path, diags[0].(diag.DiagnosticWithPath).Path())
+		})
+	}
+}
@@ -0,0 +1,97 @@
+package lib
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var (
+	_ basetypes.StringTypable                    = NormalizedPathType{}
+	_ basetypes.StringValuableWithSemanticEquals = NormalizedPath{}
+)
+
+// NormalizedPathType is a string type for Files.com paths. Values that differ
+// only in ways Files.com ignores when comparing paths, such as capitalization,
+// accents or leading and trailing slashes, are semantically equal, so the path
+// canonicalized by the API does not cause a diff. It is only meant for paths on
+// Files.com; paths on remote servers may be case sensitive.
+type NormalizedPathType struct {
+	basetypes.StringType
+}
+
+func (t NormalizedPathType) ValueType(ctx context.Context) attr.Value {
+	return NormalizedPath{}
+}
+
+func (t NormalizedPathType) String() string {
+	return "NormalizedPathType"
+}
+
+func (t NormalizedPathType) Equal(o attr.Type) bool {
+	if other, ok := o.(NormalizedPathType); ok {
+		return t.StringType.Equal(other.StringType)
+	}
+
+	return false
+}
+
+func (t NormalizedPathType) ValueFromString(ctx context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return NormalizedPath{StringValue: in}, nil
+}
+
+func (t NormalizedPathType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
+
+func NormalizedPathValue(value string) NormalizedPath {
+	return NormalizedPath{basetypes.NewStringValue(value)}
+}
+
+type NormalizedPath struct {
+	basetypes.StringValue
+}
+
+func (v NormalizedPath) Type(ctx context.Context) attr.Type {
+	return NormalizedPathType{}
+}
+
+func (v NormalizedPath) StringSemanticEquals(ctx context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	// The framework should always pass the correct value type, but always check
+	newValue, ok := newValuable.(NormalizedPath)
+
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			"An unexpected value type was received while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\n"+
+				"Expected Value Type: "+fmt.Sprintf("%T", v)+"\n"+
+				"Got Value Type: "+fmt.Sprintf("%T", newValuable),
+		)
+
+		return false, diags
+	}
+
+	return NormalizePathForComparison(v.StringValue.ValueString()) == NormalizePathForComparison(newValue.StringValue.ValueString()), diags
+}
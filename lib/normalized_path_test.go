@@ -0,0 +1,28 @@
+package lib
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizedPathSemanticEquals(t *testing.T) {
+	ctx := context.Background()
+	tests := []struct {
+		old, new string
+		equal    bool
+	}{
+		{"Reports/Q1", "Reports/Q1", true},
+		{"Reports/Q1/", "Reports/Q1", true},
+		{"/reports//q1", "Reports/Q1", true},
+		{`Reports\Café`, "reports/cafe", true},
+		{"Reports/Q1", "Reports/Q2", false},
+		{"Reports/Q1", "Reports", false},
+	}
+	for _, test := range tests {
+		equal, diags := NormalizedPathValue(test.old).StringSemanticEquals(ctx, NormalizedPathValue(test.new))
+		assert.False(t, diags.HasError())
+		assert.Equal(t, test.equal, equal, "%q and %q", test.old, test.new)
+	}
+}
@@ -0,0 +1,38 @@
+package lib
+
+import (
+	"strings"
+
+	sdk_lib "github.com/Files-com/files-sdk-go/v3/lib"
+)
+
+// CleanPath returns path in the form Files.com expects in API requests. Null
+// bytes are removed, backslashes become slashes, and empty, `.` and `..`
+// segments are dropped, so the result has no leading or trailing slash.
+// Capitalization and accents are preserved.
+func CleanPath(path string) string {
+	return sdk_lib.NormalizeAPIPath(path)
+}
+
+// NormalizePathForComparison returns the key Files.com uses to decide whether
+// two paths refer to the same file or folder. On top of CleanPath, the path is
+// NFKC normalized, transliterated to remove accents, lowercased, and trailing
+// whitespace is trimmed.
+func NormalizePathForComparison(path string) string {
+	return sdk_lib.NormalizeForComparison(CleanPath(path))
+}
+
+// JoinPath joins the given path elements and cleans the result.
+func JoinPath(elements ...string) string {
+	return sdk_lib.NormalizeAPIPath(elements...)
+}
+
+// ParentPath returns the cleaned parent of path. The parent of a top level
+// file or folder is the root folder, "".
+func ParentPath(path string) string {
+	path = CleanPath(path)
+	if i := strings.LastIndex(path, "/"); i >= 0 {
+		return path[:i]
+	}
+	return ""
+}
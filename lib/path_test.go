@@ -0,0 +1,41 @@
+package lib
+
+import (
+	"encoding/json"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizePathForComparison(t *testing.T) {
+	data, err := os.ReadFile("../shared/normalization_for_comparison_test_data.json")
+	require.NoError(t, err)
+
+	var tests [][2]string
+	require.NoError(t, json.Unmarshal(data, &tests))
+
+	for _, test := range tests {
+		assert.Equal(t, test[1], NormalizePathForComparison(test[0]), test[0])
+	}
+}
+
+func TestCleanPath(t *testing.T) {
+	assert.Equal(t, "Reports/Q1.pdf", CleanPath("/Reports//Q1.pdf/"))
+	assert.Equal(t, "Reports/Q1.pdf", CleanPath(`Reports\.\Q1.pdf`))
+	assert.Equal(t, "", CleanPath("/"))
+}
+
+func TestJoinPath(t *testing.T) {
+	assert.Equal(t, "Partners/Acme/inbox", JoinPath("Partners/", "/Acme", "inbox/"))
+	assert.Equal(t, "Partners/inbox", JoinPath("", "Partners", "", "inbox"))
+	assert.Equal(t, "", JoinPath())
+}
+
+func TestParentPath(t *testing.T) {
+	assert.Equal(t, "Partners/Acme", ParentPath("Partners/Acme/inbox"))
+	assert.Equal(t, "Partners/Acme", ParentPath("/Partners/Acme/inbox/"))
+	assert.Equal(t, "", ParentPath("Partners"))
+	assert.Equal(t, "", ParentPath(""))
+}
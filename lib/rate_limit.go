@@ -0,0 +1,86 @@
+package lib
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// limitedTransport spaces requests evenly so that no more than
+// requestsPerSecond are started, and allows at most maxConcurrent requests to
+// wait for a response at once. Every SDK client created from the provider
+// configuration shares the same HTTP client, so the limits apply to the
+// provider as a whole rather than to each resource.
+type limitedTransport struct {
+	base     http.RoundTripper
+	interval time.Duration
+	slots    chan struct{}
+
+	mu   sync.Mutex
+	next time.Time
+}
+
+// NewLimitedTransport wraps base with a request rate limit and a concurrency
+// cap. A requestsPerSecond or maxConcurrent of zero disables that limit.
+func NewLimitedTransport(base http.RoundTripper, requestsPerSecond float64, maxConcurrent int) http.RoundTripper {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	t := &limitedTransport{base: base}
+	if requestsPerSecond > 0 {
+		t.interval = time.Duration(float64(time.Second) / requestsPerSecond)
+	}
+	if maxConcurrent > 0 {
+		t.slots = make(chan struct{}, maxConcurrent)
+	}
+	return t
+}
+
+func (t *limitedTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+
+	if t.slots != nil {
+		select {
+		case t.slots <- struct{}{}:
+			defer func() { <-t.slots }()
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+
+	if err := t.wait(ctx); err != nil {
+		return nil, err
+	}
+
+	return t.base.RoundTrip(req)
+}
+
+func (t *limitedTransport) wait(ctx context.Context) error {
+	if t.interval == 0 {
+		return nil
+	}
+
+	t.mu.Lock()
+	now := time.Now()
+	start := t.next
+	if start.Before(now) {
+		start = now
+	}
+	t.next = start.Add(t.interval)
+	t.mu.Unlock()
+
+	delay := time.Until(start)
+	if delay <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,99 @@
+package lib
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestLimitedTransportRequestsPerSecond(t *testing.T) {
+	var mu sync.Mutex
+	started := []time.Time{}
+	transport := NewLimitedTransport(roundTripFunc(func(*http.Request) (*http.Response, error) {
+		mu.Lock()
+		started = append(started, time.Now())
+		mu.Unlock()
+		return &http.Response{StatusCode: 200}, nil
+	}), 20, 0)
+
+	var wg sync.WaitGroup
+	for range 5 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			req, _ := http.NewRequest("GET", "https://app.files.com", nil)
+			_, err := transport.RoundTrip(req)
+			assert.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+
+	require.Len(t, started, 5)
+	first, last := started[0], started[0]
+	for _, s := range started {
+		if s.Before(first) {
+			first = s
+		}
+		if s.After(last) {
+			last = s
+		}
+	}
+	assert.GreaterOrEqual(t, last.Sub(first), 190*time.Millisecond)
+}
+
+func TestLimitedTransportMaxConcurrent(t *testing.T) {
+	var inFlight, peak int32
+	transport := NewLimitedTransport(roundTripFunc(func(*http.Request) (*http.Response, error) {
+		n := atomic.AddInt32(&inFlight, 1)
+		for {
+			p := atomic.LoadInt32(&peak)
+			if n <= p || atomic.CompareAndSwapInt32(&peak, p, n) {
+				break
+			}
+		}
+		time.Sleep(20 * time.Millisecond)
+		atomic.AddInt32(&inFlight, -1)
+		return &http.Response{StatusCode: 200}, nil
+	}), 0, 2)
+
+	var wg sync.WaitGroup
+	for range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			req, _ := http.NewRequest("GET", "https://app.files.com", nil)
+			_, err := transport.RoundTrip(req)
+			assert.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, int32(2), peak)
+}
+
+func TestLimitedTransportCanceled(t *testing.T) {
+	transport := NewLimitedTransport(roundTripFunc(func(*http.Request) (*http.Response, error) {
+		return &http.Response{StatusCode: 200}, nil
+	}), 1, 0)
+
+	req, _ := http.NewRequest("GET", "https://app.files.com", nil)
+	_, err := transport.RoundTrip(req)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = transport.RoundTrip(req.WithContext(ctx))
+	assert.ErrorIs(t, err, context.Canceled)
+}
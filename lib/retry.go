@@ -0,0 +1,83 @@
+package lib
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-retryablehttp"
+)
+
+const (
+	DefaultMaxRetries   = 3
+	DefaultRetryMaxWait = 30 * time.Second
+	retryMinWait        = time.Second
+)
+
+// RetryAfter returns how long the Retry-After header asks the client to wait.
+// Only the first value of the header is used. It may be given either in
+// seconds or as an HTTP date. Missing or malformed values, and waits longer
+// than maxWait, are ignored so that a misbehaving server cannot stall an apply.
+func RetryAfter(header http.Header, now time.Time, maxWait time.Duration) (time.Duration, bool) {
+	values := header.Values("Retry-After")
+	if len(values) == 0 {
+		return 0, false
+	}
+	value := strings.TrimSpace(values[0])
+
+	var wait time.Duration
+	if seconds, err := strconv.ParseInt(value, 10, 64); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		wait = time.Duration(seconds) * time.Second
+	} else if retryAt, err := http.ParseTime(value); err == nil {
+		wait = max(retryAt.Sub(now), 0)
+	} else {
+		return 0, false
+	}
+
+	if wait > maxWait {
+		return 0, false
+	}
+	return wait, true
+}
+
+// RetryBackoff waits for as long as the Retry-After header asks, up to maxWait,
+// and otherwise backs off exponentially, never waiting longer than maxWait.
+func RetryBackoff(maxWait time.Duration) retryablehttp.Backoff {
+	return func(_, _ time.Duration, attemptNum int, resp *http.Response) time.Duration {
+		if resp != nil {
+			if wait, ok := RetryAfter(resp.Header, time.Now(), math.MaxInt64); ok {
+				return min(wait, maxWait)
+			}
+		}
+
+		wait := time.Duration(math.Pow(2, float64(attemptNum)) * float64(retryMinWait))
+		if wait <= 0 || wait > maxWait {
+			return maxWait
+		}
+		return wait
+	}
+}
+
+// RetryOnStatus retries requests that failed to connect, and requests that
+// returned one of statuses. Everything else is passed to checkRetry.
+func RetryOnStatus(statuses []int, checkRetry retryablehttp.CheckRetry) retryablehttp.CheckRetry {
+	return func(ctx context.Context, resp *http.Response, err error) (bool, error) {
+		if ctx.Err() != nil {
+			return false, ctx.Err()
+		}
+		if err != nil || resp == nil {
+			return checkRetry(ctx, resp, err)
+		}
+		if slices.Contains(statuses, resp.StatusCode) {
+			return true, nil
+		}
+		return false, nil
+	}
+}
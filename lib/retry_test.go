@@ -0,0 +1,78 @@
+package lib
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRetryAfter(t *testing.T) {
+	data, err := os.ReadFile("../shared/header_test_data.json")
+	require.NoError(t, err)
+
+	var tests []struct {
+		Headers map[string][]string `json:"headers"`
+		Result  *int64              `json:"result"`
+	}
+	require.NoError(t, json.Unmarshal(data, &tests))
+
+	now := time.Now().Truncate(time.Second)
+	for i, test := range tests {
+		t.Run(fmt.Sprint(i), func(t *testing.T) {
+			header := http.Header{}
+			for name, values := range test.Headers {
+				for _, value := range values {
+					if value == "%s" {
+						value = now.Add(8 * time.Second).UTC().Format(http.TimeFormat)
+					}
+					header.Add(name, value)
+				}
+			}
+
+			wait, ok := RetryAfter(header, now, DefaultRetryMaxWait)
+			if test.Result == nil {
+				assert.False(t, ok, header)
+				return
+			}
+			assert.True(t, ok, header)
+			assert.Equal(t, time.Duration(*test.Result)*time.Second, wait)
+		})
+	}
+}
+
+func TestRetryBackoff(t *testing.T) {
+	backoff := RetryBackoff(10 * time.Second)
+
+	assert.Equal(t, time.Second, backoff(0, 0, 0, nil))
+	assert.Equal(t, 4*time.Second, backoff(0, 0, 2, nil))
+	assert.Equal(t, 10*time.Second, backoff(0, 0, 8, nil))
+	assert.Equal(t, 10*time.Second, backoff(0, 0, 100, nil))
+
+	resp := &http.Response{Header: http.Header{"Retry-After": []string{"3"}}}
+	assert.Equal(t, 3*time.Second, backoff(0, 0, 5, resp))
+	resp = &http.Response{Header: http.Header{"Retry-After": []string{"60"}}}
+	assert.Equal(t, 10*time.Second, backoff(0, 0, 0, resp))
+}
+
+func TestRetryOnStatus(t *testing.T) {
+	fallback := func(ctx context.Context, resp *http.Response, err error) (bool, error) {
+		return true, err
+	}
+	checkRetry := RetryOnStatus([]int{429, 503}, fallback)
+	ctx := context.Background()
+
+	retry, _ := checkRetry(ctx, &http.Response{StatusCode: 429}, nil)
+	assert.True(t, retry)
+	retry, _ = checkRetry(ctx, &http.Response{StatusCode: 500}, nil)
+	assert.False(t, retry)
+	retry, _ = checkRetry(ctx, nil, errors.New("connection reset"))
+	assert.True(t, retry)
+}
@@ -0,0 +1,63 @@
+package lib
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	sdk_lib "github.com/Files-com/files-sdk-go/v3/lib"
+)
+
+// TransportOptions customizes the transport used to reach the Files.com API.
+// Zero values keep the SDK defaults.
+type TransportOptions struct {
+	ProxyURL              *url.URL
+	CACertPEM             []byte
+	ClientCertPEM         []byte
+	ClientKeyPEM          []byte
+	ResponseHeaderTimeout time.Duration
+	InsecureSkipVerify    bool
+}
+
+// NewTransport returns a copy of the SDK's default transport with options
+// applied. Additional CA certificates are trusted alongside the system pool,
+// so a TLS inspecting proxy can be added without losing the public roots.
+func NewTransport(options TransportOptions) (http.RoundTripper, error) {
+	transport := sdk_lib.DefaultPooledTransport()
+
+	if options.ProxyURL != nil {
+		transport.Transport.Proxy = http.ProxyURL(options.ProxyURL)
+	}
+	if options.ResponseHeaderTimeout > 0 {
+		transport.Transport.ResponseHeaderTimeout = options.ResponseHeaderTimeout
+	}
+
+	tlsConfig := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: options.InsecureSkipVerify,
+	}
+	if len(options.CACertPEM) > 0 {
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM(options.CACertPEM) {
+			return nil, errors.New("no PEM encoded certificates were found in the CA certificate bundle")
+		}
+		tlsConfig.RootCAs = pool
+	}
+	if len(options.ClientCertPEM) > 0 || len(options.ClientKeyPEM) > 0 {
+		certificate, err := tls.X509KeyPair(options.ClientCertPEM, options.ClientKeyPEM)
+		if err != nil {
+			return nil, fmt.Errorf("invalid client certificate: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{certificate}
+	}
+	transport.Transport.TLSClientConfig = tlsConfig
+
+	return transport, nil
+}
@@ -0,0 +1,65 @@
+package lib
+
+import (
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewTransportCACert(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	transport, err := NewTransport(TransportOptions{})
+	require.NoError(t, err)
+	_, err = (&http.Client{Transport: transport}).Get(server.URL)
+	assert.Error(t, err, "the test server's certificate is not trusted by default")
+
+	caCertPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+	transport, err = NewTransport(TransportOptions{CACertPEM: caCertPEM})
+	require.NoError(t, err)
+	resp, err := (&http.Client{Transport: transport}).Get(server.URL)
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+
+	transport, err = NewTransport(TransportOptions{InsecureSkipVerify: true})
+	require.NoError(t, err)
+	resp, err = (&http.Client{Transport: transport}).Get(server.URL)
+	require.NoError(t, err)
+	resp.Body.Close()
+}
+
+func TestNewTransportProxy(t *testing.T) {
+	proxied := ""
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		proxied = r.URL.String()
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer proxy.Close()
+
+	proxyURL, err := url.Parse(proxy.URL)
+	require.NoError(t, err)
+	transport, err := NewTransport(TransportOptions{ProxyURL: proxyURL})
+	require.NoError(t, err)
+
+	resp, err := (&http.Client{Transport: transport}).Get("http://app.files.com/api/rest/v1/site")
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, "http://app.files.com/api/rest/v1/site", proxied)
+}
+
+func TestNewTransportInvalid(t *testing.T) {
+	_, err := NewTransport(TransportOptions{CACertPEM: []byte("not a certificate")})
+	assert.ErrorContains(t, err, "no PEM encoded certificates")
+
+	_, err = NewTransport(TransportOptions{ClientCertPEM: []byte("not a certificate")})
+	assert.ErrorContains(t, err, "invalid client certificate")
+}
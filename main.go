@@ -6,6 +6,7 @@ import (
 	"context"
 	"flag"
 	"log"
+	"time"
 
 	"github.com/Files-com/terraform-provider-files/internal/provider"
 
@@ -29,6 +30,11 @@ func main() {
 
 	err := providerserver.Serve(context.Background(), provider.New(version), opts)
 
+	// Terraform gives the provider a couple of seconds to exit once it is done.
+	ctx, cancel := context.WithTimeout(context.Background(), 1500*time.Millisecond)
+	provider.CloseSessions(ctx)
+	cancel()
+
 	if err != nil {
 		log.Fatal(err.Error())
 	}